@@ -187,6 +187,94 @@ func GenerateMiniBlogModels(g *gen.Generator) {
 			return tag
 		}),
 	)
+	g.GenerateModelAs(
+		"bookmark",
+		"BookmarkM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_bookmark_userID_postID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_bookmark_userID_postID,priority:2")
+			tag.Set("index", "idx_bookmark_postID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"comment",
+		"CommentM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("commentID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_comment_commentID")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_comment_postID_parentID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("parentID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_comment_postID_parentID,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"export_job",
+		"ExportJobM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("jobID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_export_job_jobID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_export_job_userID")
+			return tag
+		}),
+		gen.FieldGORMTag("status", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_export_job_status")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"follow",
+		"FollowM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("followerID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_follow_followerID_followeeID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("followeeID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_follow_followerID_followeeID,priority:2")
+			tag.Set("index", "idx_follow_followeeID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"import_mapping",
+		"ImportMappingM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("kind", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_import_mapping_kind_sourceID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("sourceID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_import_mapping_kind_sourceID,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"media",
+		"MediaM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("mediaID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_media_mediaID")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_media_postID")
+			return tag
+		}),
+	)
 	g.GenerateModelAs(
 		"post",
 		"PostM",
@@ -195,6 +283,163 @@ func GenerateMiniBlogModels(g *gen.Generator) {
 			tag.Set("uniqueIndex", "idx_post_postID")
 			return tag
 		}),
+		gen.FieldGORMTag("slug", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_slug")
+			return tag
+		}),
+		gen.FieldGORMTag("status", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_status_publishAt,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("publishAt", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_status_publishAt,priority:2")
+			return tag
+		}),
+		gen.FieldType("deletedAt", "gorm.DeletedAt"),
+		gen.FieldGORMTag("deletedAt", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_deletedAt")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_collaborator",
+		"PostCollaboratorM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_collaborator_postID_userID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_collaborator_postID_userID,priority:2")
+			tag.Set("index", "idx_post_collaborator_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_reaction",
+		"PostReactionM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_postID_reaction_userID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("reaction", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_postID_reaction_userID,priority:2")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_postID_reaction_userID,priority:3")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_reaction_count",
+		"PostReactionCountM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_count_postID_reaction,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("reaction", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_reaction_count_postID_reaction,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_revision",
+		"PostRevisionM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_revision_postID_revision,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("revision", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_revision_postID_revision,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_share",
+		"PostShareM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_share_postID_userID,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_share_postID_userID,priority:2")
+			tag.Set("index", "idx_post_share_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_slug",
+		"PostSlugM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("slug", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_slug_slug")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_post_slug_postID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_tag",
+		"PostTagM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_tag_postID_name,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("name", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_tag_postID_name,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"post_view",
+		"PostViewM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_view_postID_day,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("day", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_post_view_postID_day,priority:2")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"series",
+		"SeriesM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("seriesID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_series_seriesID")
+			return tag
+		}),
+		gen.FieldGORMTag("userID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_series_userID")
+			return tag
+		}),
+	)
+	g.GenerateModelAs(
+		"series_post",
+		"SeriesPostM",
+		gen.FieldIgnore("placeholder"),
+		gen.FieldGORMTag("seriesID", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_series_post_seriesID_position,priority:1")
+			return tag
+		}),
+		gen.FieldGORMTag("postID", func(tag field.GormTag) field.GormTag {
+			tag.Set("uniqueIndex", "idx_series_post_postID")
+			return tag
+		}),
+		gen.FieldGORMTag("position", func(tag field.GormTag) field.GormTag {
+			tag.Set("index", "idx_series_post_seriesID_position,priority:2")
+			return tag
+		}),
 	)
 	g.GenerateModelAs(
 		"casbin_rule",
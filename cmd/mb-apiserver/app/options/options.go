@@ -19,6 +19,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/jwcen/miniblog/internal/apiserver"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
 )
 
 // 定义支持的服务器模式集合.
@@ -46,6 +48,14 @@ type ServerOptions struct {
 	MySQLOptions *genericoptions.MySQLOptions `json:"mysql" mapstructure:"mysql"`
 	// EnableMemoryStore 指示是否启用内存数据库（用于测试或开发环境）.
 	EnableMemoryStore bool `json:"enable-memory-store" mapstructure:"enable-memory-store"`
+	// BlobOptions 包含媒体文件存储配置选项.
+	BlobOptions *blob.Options `json:"blob" mapstructure:"blob"`
+	// MaxMediaSize 定义单个媒体文件允许的最大字节数.
+	MaxMediaSize int64 `json:"max-media-size" mapstructure:"max-media-size"`
+	// TrashRetention 定义博文在回收站中的保留时长，超过保留时长的博文会被永久删除.
+	TrashRetention time.Duration `json:"trash-retention" mapstructure:"trash-retention"`
+	// TrustedProxies 定义可信代理的 IP 地址或 CIDR，只有来自本机或可信代理的请求才使用 X-Forwarded-For 中的客户端 IP.
+	TrustedProxies []string `json:"trusted-proxies" mapstructure:"trusted-proxies"`
 }
 
 // NewServerOptions 创建带有默认值的 ServerOptions 实例.
@@ -58,6 +68,9 @@ func NewServerOptions() *ServerOptions {
 		HTTPOptions:  genericoptions.NewHTTPOptions(),
 		TLSOptions: genericoptions.NewTLSOptions(),
 		MySQLOptions: genericoptions.NewMySQLOptions(),
+		BlobOptions:  blob.NewOptions(),
+		MaxMediaSize: 10 << 20,
+		TrashRetention: 30 * 24 * time.Hour,
 	}
 
 	opts.GRPCOptions.Addr = ":6666"
@@ -78,6 +91,10 @@ func (o *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	o.HTTPOptions.AddFlags(fs)
 	o.TLSOptions.AddFlags(fs)
 	o.MySQLOptions.AddFlags(fs)
+	o.BlobOptions.AddFlags(fs)
+	fs.Int64Var(&o.MaxMediaSize, "max-media-size", o.MaxMediaSize, "Maximum size in bytes of a single uploaded media file.")
+	fs.DurationVar(&o.TrashRetention, "trash-retention", o.TrashRetention, "How long deleted posts are kept in the trash before being permanently removed.")
+	fs.StringSliceVar(&o.TrustedProxies, "trusted-proxies", o.TrustedProxies, "IP addresses or CIDRs of reverse proxies whose X-Forwarded-For header is trusted. Loopback addresses are always trusted.")
 }
 
 // Validate 校验 ServerOptions 中的选项是否合法.
@@ -98,6 +115,22 @@ func (o *ServerOptions) Validate() error {
 	errs = append(errs, o.HTTPOptions.Validate()...)
 	errs = append(errs, o.TLSOptions.Validate()...)
 	errs = append(errs, o.MySQLOptions.Validate()...)
+	errs = append(errs, o.BlobOptions.Validate()...)
+
+	// 校验媒体文件大小限制
+	if o.MaxMediaSize <= 0 {
+		errs = append(errs, errors.New("max-media-size must be greater than 0"))
+	}
+
+	// 校验回收站保留时长
+	if o.TrashRetention <= 0 {
+		errs = append(errs, errors.New("trash-retention must be greater than 0"))
+	}
+
+	// 校验可信代理地址
+	if _, err := httpx.ParseTrustedProxies(o.TrustedProxies...); err != nil {
+		errs = append(errs, err)
+	}
 
 	// 如果是 gRPC 或 gRPC-Gateway 模式，校验 gRPC 配置
 	if stringsutil.StringIn(o.ServerMode, []string{apiserver.GRPCServerMode, apiserver.GRPCGatewayServerMode}) {
@@ -110,6 +143,11 @@ func (o *ServerOptions) Validate() error {
 
 // Config 基于 ServerOptions 创建新的 apiserver.Config。
 func (o *ServerOptions) Config() (*apiserver.Config, error) {
+	trustedProxies, err := httpx.ParseTrustedProxies(o.TrustedProxies...)
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiserver.Config{
 		ServerMode:   o.ServerMode,
 		JWTKey:       o.JWTKey,
@@ -119,5 +157,9 @@ func (o *ServerOptions) Config() (*apiserver.Config, error) {
 		TLSOptions:   o.TLSOptions,
 		MySQLOptions: o.MySQLOptions,
 		EnableMemoryStore: o.EnableMemoryStore,
+		BlobOptions:       o.BlobOptions,
+		MaxMediaSize:      o.MaxMediaSize,
+		TrashRetention:    o.TrashRetention,
+		TrustedProxies:    trustedProxies,
 	}, nil
 }
@@ -7,11 +7,27 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/jwcen/miniblog/cmd/mb-apiserver/app/options"
+	"github.com/jwcen/miniblog/internal/apiserver"
+	"github.com/jwcen/miniblog/internal/apiserver/wpimport"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
 	"github.com/jwcen/miniblog/internal/pkg/log"
+	"github.com/jwcen/miniblog/internal/pkg/wxr"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 	"github.com/onexstack/onexstack/pkg/version"
 )
 
@@ -75,6 +91,71 @@ func NewMiniBlogCommand() *cobra.Command {
 	// 添加 --version 标志
 	version.AddFlags(cmd.PersistentFlags())
 
+	// 添加子命令
+	cmd.AddCommand(newImportCommand(opts))
+	cmd.AddCommand(newImportWordPressCommand(opts))
+
+	return cmd
+}
+
+// newImportCommand 创建 import 子命令，用于将 Hugo、Jekyll 等静态博客的 Markdown 文件导入到指定用户.
+// 子命令直接读写配置文件中的数据库，不需要启动 API 服务器.
+func newImportCommand(opts *options.ServerOptions) *cobra.Command {
+	var username string
+
+	cmd := &cobra.Command{
+		Use:   "import --username USERNAME PATH...",
+		Short: "Import Markdown posts with YAML front matter",
+		Long: `Import Markdown posts with YAML front matter, e.g. the content of a Hugo or Jekyll site.
+
+Each PATH is either a Markdown file or a directory that is searched recursively for
+*.md and *.markdown files. The title, date, lastmod, tags, draft and slug fields of the
+front matter are imported, and the original timestamps are preserved.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImport(cmd.OutOrStdout(), opts, username, args)
+		},
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().StringVarP(&username, "username", "u", "", "Name of the user that the imported posts belong to.")
+	_ = cmd.MarkFlagRequired("username")
+
+	return cmd
+}
+
+// newImportWordPressCommand 创建 import-wordpress 子命令，用于导入 WordPress 导出的 WXR 文件.
+// 重复导入同一个文件时只导入新增的文章和评论，导入完成后输出对账报告.
+func newImportWordPressCommand(opts *options.ServerOptions) *cobra.Command {
+	var (
+		authors   map[string]string
+		guestUser string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import-wordpress FILE",
+		Short: "Import users, posts, tags and comments from a WordPress export file",
+		Long: `Import users, posts, tags and comments from a WordPress eXtended RSS (WXR) file,
+which is created by Tools > Export in the WordPress admin.
+
+Authors are mapped to existing users with --author, or to the user with the same name.
+Other authors are created as new users with a random password and a placeholder phone
+number, both printed in the report. Categories and tags become tags, HTML content is
+converted to Markdown, and approved comments are imported with their original time.
+Pages, attachments and other content types are reported as unsupported.
+
+Imported items are recorded by their WordPress GUID, so running the command again with
+the same or a newer export file only imports the posts and comments added since.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImportWordPress(cmd.OutOrStdout(), opts, args[0], wpimport.Options{Authors: authors, GuestUsername: guestUser})
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().StringToStringVar(&authors, "author", nil, "Map a WordPress author login to an existing user, e.g. --author admin=root.")
+	cmd.Flags().StringVar(&guestUser, "guest-user", known.AdminUsername, "Name of the user that comments of unregistered visitors are imported as. Empty to skip them.")
+
 	return cmd
 }
 
@@ -86,32 +167,205 @@ func run(opts *options.ServerOptions) error {
 	// 如果传入 --version，则打印版本信息并退出
 	version.PrintAndExitIfRequested()
 
-	// 将 viper 中的配置解析到 opts.
-	if err := viper.Unmarshal(opts); err != nil {
+	cfg, err := loadConfig(opts)
+	if err != nil {
 		return err
 	}
 
-	// 校验命令行选项
-	if err := opts.Validate(); err != nil {
+	// 创建服务器实例.
+	// 注意这里是联合服务器，因为可能同时启动多个不同类型的服务器.
+	server, err := cfg.NewUnionServer()
+	if err != nil {
 		return err
 	}
 
-	// 获取应用配置.
-	// 将命令行选项和应用配置分开，可以更加灵活的处理 2 种不同类型的配置.
-	cfg, err := opts.Config()
+	// 启动服务器
+	return server.Run()
+}
+
+// runImport 将 paths 中的 Markdown 文件导入到指定用户，并逐个输出每个文件的导入结果.
+func runImport(out io.Writer, opts *options.ServerOptions, username string, paths []string) error {
+	log.Init(logOptions())
+	defer log.Sync()
+
+	cfg, err := loadConfig(opts)
 	if err != nil {
 		return err
 	}
+	if cfg.EnableMemoryStore {
+		return errors.New("import requires a MySQL database, memory store is enabled")
+	}
 
-	// 创建服务器实例.
-	// 注意这里是联合服务器，因为可能同时启动多个不同类型的服务器.
-	server, err := cfg.NewUnionServer()
+	files, err := markdownFiles(paths)
 	if err != nil {
 		return err
 	}
 
-	// 启动服务器
-	return server.Run()
+	importer, err := cfg.NewPostImporter()
+	if err != nil {
+		return err
+	}
+
+	// 命令行工具直接访问数据库，以管理员身份导入
+	ctx := contextx.WithUsername(context.Background(), known.AdminUsername)
+
+	var failed int
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		resp, err := importer.Import(ctx, &apiv1.ImportPostsRequest{Username: username, Filename: file, Content: string(content)})
+		if err != nil {
+			return err
+		}
+		if !resp.GetSuccess() {
+			failed++
+			fmt.Fprintf(out, "FAILED   %s: %s\n", file, resp.GetError())
+			continue
+		}
+		fmt.Fprintf(out, "IMPORTED %s -> %s\n", file, resp.GetPostID())
+	}
+
+	fmt.Fprintf(out, "%d imported, %d failed\n", len(files)-failed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed to import", failed, len(files))
+	}
+	return nil
+}
+
+// runImportWordPress 导入 WXR 文件，并输出对账报告.
+func runImportWordPress(out io.Writer, opts *options.ServerOptions, file string, importOpts wpimport.Options) error {
+	log.Init(logOptions())
+	defer log.Sync()
+
+	cfg, err := loadConfig(opts)
+	if err != nil {
+		return err
+	}
+	if cfg.EnableMemoryStore {
+		return errors.New("import requires a MySQL database, memory store is enabled")
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	export, err := wxr.Parse(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	importer, err := cfg.NewWordPressImporter(importOpts)
+	if err != nil {
+		return err
+	}
+
+	// 命令行工具直接访问数据库，以管理员身份导入
+	ctx := contextx.WithUsername(context.Background(), known.AdminUsername)
+	report, err := importer.Import(ctx, export)
+	if err != nil {
+		return err
+	}
+
+	printReport(out, report)
+	if failed := report.Failed(); failed > 0 {
+		return fmt.Errorf("%d items failed to import", failed)
+	}
+	return nil
+}
+
+// printReport 输出 WordPress 导入的对账报告.
+func printReport(out io.Writer, report *wpimport.Report) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tTOTAL\tCREATED\tEXISTING\tMATCHED\tSKIPPED\tFAILED")
+	for _, row := range []struct {
+		kind   string
+		counts wpimport.Counts
+	}{{"users", report.Users}, {"posts", report.Posts}, {"comments", report.Comments}} {
+		c := row.counts
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\n", row.kind, c.Total, c.Created, c.Existing, c.Matched, c.Skipped, c.Failed)
+	}
+	_ = w.Flush()
+
+	if len(report.CreatedUsers) > 0 {
+		fmt.Fprintln(out, "\nCreated users (the phone number is a placeholder and should be changed):")
+		for _, user := range report.CreatedUsers {
+			fmt.Fprintf(out, "  %s -> %s, password: %s\n", user.Login, user.Username, user.Password)
+		}
+	}
+	if len(report.MatchedUsers) > 0 {
+		fmt.Fprintln(out, "\nMatched users:")
+		for _, user := range report.MatchedUsers {
+			fmt.Fprintf(out, "  %s -> %s\n", user.Login, user.Username)
+		}
+	}
+	for _, section := range []struct {
+		title   string
+		entries []*wpimport.Entry
+	}{
+		{"Unsupported", report.Unsupported},
+		{"Skipped", report.Skipped},
+		{"Warnings", report.Warnings},
+		{"Failures", report.Failures},
+	} {
+		if len(section.entries) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s:\n", section.title)
+		for _, entry := range section.entries {
+			line := fmt.Sprintf("  %s %s %q", entry.Kind, entry.SourceID, entry.Title)
+			if entry.Reason != "" {
+				line += ": " + entry.Reason
+			}
+			fmt.Fprintln(out, line)
+		}
+	}
+}
+
+// markdownFiles 返回 paths 中的 Markdown 文件. 目录会被递归查找，其中的文件按路径排序.
+func markdownFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			// 直接指定的文件不检查扩展名
+			if file == path && !d.IsDir() {
+				files = append(files, file)
+				return nil
+			}
+			if ext := strings.ToLower(filepath.Ext(file)); !d.IsDir() && (ext == ".md" || ext == ".markdown") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// loadConfig 解析并校验命令行选项，返回应用配置.
+func loadConfig(opts *options.ServerOptions) (*apiserver.Config, error) {
+	// 将 viper 中的配置解析到 opts.
+	if err := viper.Unmarshal(opts); err != nil {
+		return nil, err
+	}
+
+	// 校验命令行选项
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 获取应用配置.
+	// 将命令行选项和应用配置分开，可以更加灵活的处理 2 种不同类型的配置.
+	return opts.Config()
 }
 
 // logOptions 从 viper 中读取日志配置，构建 *log.Options 并返回.
@@ -39,6 +39,33 @@ CREATE DATABASE /*!32312 IF NOT EXISTS*/ `miniblog` /*!40100 DEFAULT CHARACTER S
 
 USE `miniblog`;
 
+--
+-- Table structure for table `bookmark`
+--
+
+DROP TABLE IF EXISTS `bookmark`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `bookmark` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '收藏者的用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '被收藏的博文唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '收藏时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `bookmark.userID_postID` (`userID`,`postID`),
+  KEY `idx.bookmark.postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='阅读列表表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `bookmark`
+--
+
+LOCK TABLES `bookmark` WRITE;
+/*!40000 ALTER TABLE `bookmark` DISABLE KEYS */;
+/*!40000 ALTER TABLE `bookmark` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `casbin_rule`
 --
@@ -72,10 +99,165 @@ INSERT INTO `casbin_rule` VALUES
 (7,'p','role::user','/v1.MiniBlog/DeleteUser','CALL','deny','',''),
 (8,'p','role::user','/v1.MiniBlog/ListUser','CALL','deny','',''),
 (9,'p','role::user','/v1/users','GET','deny','',''),
-(10,'p','role::user','/v1/users/*','DELETE','deny','','');
+(10,'p','role::user','/v1/users/*','DELETE','deny','',''),
+(22,'p','role::user','/v1/comments','MODERATE','deny','','');
 /*!40000 ALTER TABLE `casbin_rule` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `comment`
+--
+
+DROP TABLE IF EXISTS `comment`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `comment` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `commentID` varchar(38) NOT NULL DEFAULT '' COMMENT '评论唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '评论者的用户唯一 ID',
+  `parentID` varchar(38) NOT NULL DEFAULT '' COMMENT '被回复的评论 ID，顶级评论为空',
+  `content` text NOT NULL DEFAULT '' COMMENT '评论内容',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '评论创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '评论最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `comment.commentID` (`commentID`),
+  KEY `idx.comment.postID_parentID` (`postID`,`parentID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='评论表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `comment`
+--
+
+LOCK TABLES `comment` WRITE;
+/*!40000 ALTER TABLE `comment` DISABLE KEYS */;
+/*!40000 ALTER TABLE `comment` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `export_job`
+--
+
+DROP TABLE IF EXISTS `export_job`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `export_job` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `jobID` varchar(36) NOT NULL DEFAULT '' COMMENT '导出任务唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '导出博文的用户唯一 ID',
+  `format` tinyint(4) NOT NULL DEFAULT 0 COMMENT '导出文件格式：0-zip，1-tar.gz',
+  `status` tinyint(4) NOT NULL DEFAULT 0 COMMENT '任务状态：0-等待中，1-执行中，2-已完成，3-已失败',
+  `postCount` bigint(20) NOT NULL DEFAULT 0 COMMENT '导出的博文数量',
+  `size` bigint(20) NOT NULL DEFAULT 0 COMMENT '导出文件大小，单位为字节',
+  `storageKey` varchar(255) NOT NULL DEFAULT '' COMMENT '导出文件在对象存储中的键',
+  `error` varchar(1024) NOT NULL DEFAULT '' COMMENT '任务失败的原因',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '任务创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '任务最后修改时间',
+  `finishedAt` datetime DEFAULT NULL COMMENT '任务完成或失败的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `export_job.jobID` (`jobID`),
+  KEY `idx.export_job.userID` (`userID`),
+  KEY `idx.export_job.status` (`status`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文导出任务表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `export_job`
+--
+
+LOCK TABLES `export_job` WRITE;
+/*!40000 ALTER TABLE `export_job` DISABLE KEYS */;
+/*!40000 ALTER TABLE `export_job` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `follow`
+--
+
+DROP TABLE IF EXISTS `follow`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `follow` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `followerID` varchar(36) NOT NULL DEFAULT '' COMMENT '关注者的用户唯一 ID',
+  `followeeID` varchar(36) NOT NULL DEFAULT '' COMMENT '被关注者的用户唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '关注时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `follow.followerID_followeeID` (`followerID`,`followeeID`),
+  KEY `idx.follow.followeeID` (`followeeID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='用户关注关系表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `follow`
+--
+
+LOCK TABLES `follow` WRITE;
+/*!40000 ALTER TABLE `follow` DISABLE KEYS */;
+/*!40000 ALTER TABLE `follow` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `import_mapping`
+--
+
+DROP TABLE IF EXISTS `import_mapping`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `import_mapping` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `kind` varchar(16) NOT NULL DEFAULT '' COMMENT '导入的数据类型，例如 wp_post 表示 WordPress 文章',
+  `sourceID` varchar(255) NOT NULL DEFAULT '' COMMENT '数据在来源系统中的唯一标识',
+  `targetID` varchar(36) NOT NULL DEFAULT '' COMMENT '导入后生成的资源唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '导入时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `import_mapping.kind_sourceID` (`kind`,`sourceID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='外部数据导入映射表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `import_mapping`
+--
+
+LOCK TABLES `import_mapping` WRITE;
+/*!40000 ALTER TABLE `import_mapping` DISABLE KEYS */;
+/*!40000 ALTER TABLE `import_mapping` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `media`
+--
+
+DROP TABLE IF EXISTS `media`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `media` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `mediaID` varchar(36) NOT NULL DEFAULT '' COMMENT '媒体文件唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '上传者的用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '媒体文件所属的博文唯一 ID',
+  `filename` varchar(255) NOT NULL DEFAULT '' COMMENT '上传时的文件名',
+  `contentType` varchar(64) NOT NULL DEFAULT '' COMMENT '根据文件内容嗅探得到的媒体类型',
+  `size` bigint(20) NOT NULL DEFAULT 0 COMMENT '文件大小，单位为字节',
+  `storageKey` varchar(255) NOT NULL DEFAULT '' COMMENT '文件在对象存储中的键',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '媒体文件上传时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '媒体文件最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `media.mediaID` (`mediaID`),
+  KEY `idx.media.postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='媒体文件表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `media`
+--
+
+LOCK TABLES `media` WRITE;
+/*!40000 ALTER TABLE `media` DISABLE KEYS */;
+/*!40000 ALTER TABLE `media` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `post`
 --
@@ -88,12 +270,25 @@ CREATE TABLE `post` (
   `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
   `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
   `title` varchar(256) NOT NULL DEFAULT '' COMMENT '博文标题',
+  `slug` varchar(128) NOT NULL DEFAULT '' COMMENT '博文 URL 中使用的可读标识',
   `content` longtext NOT NULL DEFAULT '' COMMENT '博文内容',
+  `contentFormat` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文内容格式：0-Markdown，1-纯文本，2-HTML',
+  `revision` bigint(20) NOT NULL DEFAULT 0 COMMENT '博文当前的版本号',
+  `status` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文状态：0-草稿，1-已发布，2-已归档',
+  `visibility` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文可见范围：0-公开，1-不公开列出，2-私密，3-仅分享给指定用户',
+  `publishedAt` datetime DEFAULT NULL COMMENT '博文最近一次发布时间',
+  `publishAt` datetime DEFAULT NULL COMMENT '博文定时发布时间',
+  `version` bigint(20) NOT NULL DEFAULT 1 COMMENT '博文数据版本号，每次修改博文时递增，用于检测并发修改',
   `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '博文创建时间',
   `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '博文最后修改时间',
+  `deletedAt` datetime DEFAULT NULL COMMENT '博文移入回收站的时间，为空表示未删除',
   PRIMARY KEY (`id`),
   UNIQUE KEY `post.postID` (`postID`),
-  KEY `idx.post.userID` (`userID`)
+  UNIQUE KEY `post.slug` (`slug`),
+  KEY `idx.post.userID` (`userID`),
+  KEY `idx.post.status_publishAt` (`status`,`publishAt`),
+  KEY `idx.post.deletedAt` (`deletedAt`),
+  FULLTEXT KEY `idx.post.title_content` (`title`,`content`) WITH PARSER ngram
 ) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文表';
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -106,6 +301,288 @@ LOCK TABLES `post` WRITE;
 /*!40000 ALTER TABLE `post` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `post_collaborator`
+--
+
+DROP TABLE IF EXISTS `post_collaborator`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_collaborator` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '协作者的用户唯一 ID',
+  `role` tinyint(4) NOT NULL DEFAULT 0 COMMENT '协作者角色：0-只读，1-编辑',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '邀请时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_collaborator.postID_userID` (`postID`,`userID`),
+  KEY `idx.post_collaborator.userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文协作者表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_collaborator`
+--
+
+LOCK TABLES `post_collaborator` WRITE;
+/*!40000 ALTER TABLE `post_collaborator` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_collaborator` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_reaction`
+--
+
+DROP TABLE IF EXISTS `post_reaction`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_reaction` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '回应者的用户唯一 ID',
+  `reaction` varchar(32) NOT NULL DEFAULT '' COMMENT '回应类型：like 表示点赞，其他值为表情名称',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '回应时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_reaction.postID_reaction_userID` (`postID`,`reaction`,`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文点赞和表情回应表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_reaction`
+--
+
+LOCK TABLES `post_reaction` WRITE;
+/*!40000 ALTER TABLE `post_reaction` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_reaction` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_reaction_count`
+--
+
+DROP TABLE IF EXISTS `post_reaction_count`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_reaction_count` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `reaction` varchar(32) NOT NULL DEFAULT '' COMMENT '回应类型：like 表示点赞，其他值为表情名称',
+  `total` bigint(20) NOT NULL DEFAULT 0 COMMENT '回应总数',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '计数最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_reaction_count.postID_reaction` (`postID`,`reaction`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文回应计数表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_reaction_count`
+--
+
+LOCK TABLES `post_reaction_count` WRITE;
+/*!40000 ALTER TABLE `post_reaction_count` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_reaction_count` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_revision`
+--
+
+DROP TABLE IF EXISTS `post_revision`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_revision` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `revision` bigint(20) NOT NULL DEFAULT 0 COMMENT '版本号，从 1 开始递增',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '博文标题',
+  `content` longtext NOT NULL DEFAULT '' COMMENT '博文内容',
+  `contentFormat` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文内容格式：0-Markdown，1-纯文本，2-HTML',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '版本创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_revision.postID_revision` (`postID`,`revision`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文历史版本表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_revision`
+--
+
+LOCK TABLES `post_revision` WRITE;
+/*!40000 ALTER TABLE `post_revision` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_revision` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_share`
+--
+
+DROP TABLE IF EXISTS `post_share`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_share` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '被分享用户的用户唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '分享时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_share.postID_userID` (`postID`,`userID`),
+  KEY `idx.post_share.userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文分享表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_share`
+--
+
+LOCK TABLES `post_share` WRITE;
+/*!40000 ALTER TABLE `post_share` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_share` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_slug`
+--
+
+DROP TABLE IF EXISTS `post_slug`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_slug` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `slug` varchar(128) NOT NULL DEFAULT '' COMMENT '博文曾经使用过的 slug',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '记录创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_slug.slug` (`slug`),
+  KEY `idx.post_slug.postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文历史 slug 表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_slug`
+--
+
+LOCK TABLES `post_slug` WRITE;
+/*!40000 ALTER TABLE `post_slug` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_slug` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_tag`
+--
+
+DROP TABLE IF EXISTS `post_tag`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_tag` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '标签名称',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '标签创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_tag.postID_name` (`postID`,`name`),
+  KEY `idx.post_tag.userID_name` (`userID`,`name`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文标签表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_tag`
+--
+
+LOCK TABLES `post_tag` WRITE;
+/*!40000 ALTER TABLE `post_tag` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_tag` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_view`
+--
+
+DROP TABLE IF EXISTS `post_view`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_view` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `day` varchar(10) NOT NULL DEFAULT '' COMMENT '统计日期（UTC），格式为 YYYY-MM-DD',
+  `views` bigint(20) NOT NULL DEFAULT 0 COMMENT '当日浏览次数',
+  `visitors` bigint(20) NOT NULL DEFAULT 0 COMMENT '当日独立访客数的估算值',
+  `visitorSketch` blob DEFAULT NULL COMMENT '当日访客的 HyperLogLog 草图，用于合并多日的独立访客数',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '统计最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_view.postID_day` (`postID`,`day`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文每日浏览统计表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_view`
+--
+
+LOCK TABLES `post_view` WRITE;
+/*!40000 ALTER TABLE `post_view` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_view` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `series`
+--
+
+DROP TABLE IF EXISTS `series`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `series` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `seriesID` varchar(36) NOT NULL DEFAULT '' COMMENT '系列唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '系列所有者的用户唯一 ID',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '系列标题',
+  `description` varchar(1024) NOT NULL DEFAULT '' COMMENT '系列简介',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '系列创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '系列最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `series.seriesID` (`seriesID`),
+  KEY `idx.series.userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='系列表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `series`
+--
+
+LOCK TABLES `series` WRITE;
+/*!40000 ALTER TABLE `series` DISABLE KEYS */;
+/*!40000 ALTER TABLE `series` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `series_post`
+--
+
+DROP TABLE IF EXISTS `series_post`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `series_post` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `seriesID` varchar(36) NOT NULL DEFAULT '' COMMENT '系列唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID，一篇博文最多属于一个系列',
+  `position` bigint(20) NOT NULL DEFAULT 0 COMMENT '博文在系列中的位置，从 0 开始',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '博文加入系列的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `series_post.postID` (`postID`),
+  KEY `idx.series_post.seriesID_position` (`seriesID`,`position`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='系列博文表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `series_post`
+--
+
+LOCK TABLES `series_post` WRITE;
+/*!40000 ALTER TABLE `series_post` DISABLE KEYS */;
+/*!40000 ALTER TABLE `series_post` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `user`
 --
@@ -121,6 +598,7 @@ CREATE TABLE `user` (
   `nickname` varchar(30) NOT NULL DEFAULT '' COMMENT '用户昵称',
   `email` varchar(256) NOT NULL DEFAULT '' COMMENT '用户电子邮箱地址',
   `phone` varchar(16) NOT NULL DEFAULT '' COMMENT '用户手机号',
+  `version` bigint(20) NOT NULL DEFAULT 1 COMMENT '用户信息版本号，每次修改用户信息时递增，用于检测并发修改',
   `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '用户创建时间',
   `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '用户最后修改时间',
   PRIMARY KEY (`id`),
@@ -16,8 +16,17 @@ package biz
 
 import (
 	"github.com/google/wire"
+	commentV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/comment"
+	exportV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/export"
+	mediaV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/media"
 	postV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/post"
+	reactionV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/reaction"
+	seriesV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/series"
 	userV1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/user"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/search"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/jwcen/miniblog/internal/apiserver/store"
 	auth "github.com/onexstack/onexstack/pkg/authz"
 )
@@ -29,19 +38,37 @@ type IBiz interface {
 	UserV1() userV1.UserBiz
 	// 获取帖子业务接口.
 	PostV1() postV1.PostBiz
+	// 获取评论业务接口.
+	CommentV1() commentV1.CommentBiz
+	// 获取媒体文件业务接口.
+	MediaV1() mediaV1.MediaBiz
+	// 获取博文点赞和表情回应业务接口.
+	ReactionV1() reactionV1.ReactionBiz
+	// 获取博文系列业务接口.
+	SeriesV1() seriesV1.SeriesBiz
+	// 获取博文导出业务接口.
+	ExportV1() exportV1.ExportBiz
 }
 
 type biz struct {
-	store store.IStore
-	authz *auth.Authz
+	store    store.IStore
+	authz    *auth.Authz
+	search   search.Searcher
+	renderer *render.Renderer
+	blobs    blob.BlobStore
+	views    *viewcount.Counter
 }
 
 var _ IBiz = (*biz)(nil)
 
-func NewBiz(store store.IStore, authz *auth.Authz) *biz {
+func NewBiz(store store.IStore, authz *auth.Authz, search search.Searcher, renderer *render.Renderer, blobs blob.BlobStore, views *viewcount.Counter) *biz {
 	return &biz{
-		store: store,
-		authz: authz,
+		store:    store,
+		authz:    authz,
+		search:   search,
+		renderer: renderer,
+		blobs:    blobs,
+		views:    views,
 	}
 }
 
@@ -50,5 +77,25 @@ func (b *biz) UserV1() userV1.UserBiz {
 }
 
 func (b *biz) PostV1() postV1.PostBiz {
-	return postV1.New(b.store)
+	return postV1.New(b.store, b.search, b.renderer, b.views)
+}
+
+func (b *biz) CommentV1() commentV1.CommentBiz {
+	return commentV1.New(b.store, b.authz)
+}
+
+func (b *biz) MediaV1() mediaV1.MediaBiz {
+	return mediaV1.New(b.store, b.blobs)
+}
+
+func (b *biz) ReactionV1() reactionV1.ReactionBiz {
+	return reactionV1.New(b.store)
+}
+
+func (b *biz) SeriesV1() seriesV1.SeriesBiz {
+	return seriesV1.New(b.store)
+}
+
+func (b *biz) ExportV1() exportV1.ExportBiz {
+	return exportV1.New(b.store, b.blobs)
 }
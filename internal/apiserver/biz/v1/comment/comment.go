@@ -0,0 +1,189 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/authz"
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// CommentBiz 定义处理评论请求所需的方法.
+type CommentBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error)
+	List(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error)
+
+	CommentExpansion
+}
+
+// CommentExpansion 定义额外的评论操作方法.
+type CommentExpansion interface{}
+
+type commentBiz struct {
+	store store.IStore
+	authz *authz.Authz
+}
+
+var _ CommentBiz = (*commentBiz)(nil)
+
+func New(store store.IStore, authz *authz.Authz) *commentBiz {
+	return &commentBiz{store: store, authz: authz}
+}
+
+func (b *commentBiz) Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	now := time.Now()
+	commentM := model.CommentM{
+		PostID:    rq.GetPostID(),
+		UserID:    contextx.UserID(ctx),
+		ParentID:  rq.GetParentID(),
+		Content:   rq.GetContent(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := b.store.Comment().Create(ctx, &commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateCommentResponse{CommentID: commentM.CommentID}, nil
+}
+
+func (b *commentBiz) Update(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error) {
+	whr := where.T(ctx).F("postID", rq.GetPostID(), "commentID", rq.GetCommentID())
+	commentM, err := b.store.Comment().Get(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	commentM.Content = rq.GetContent()
+	if err := b.store.Comment().Update(ctx, commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateCommentResponse{}, nil
+}
+
+// Delete 删除评论. 评论者本人、博文作者以及拥有评论管理权限的用户可以删除评论.
+// 删除顶级评论时会同时删除其下的所有回复.
+func (b *commentBiz) Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	commentM, err := b.store.Comment().Get(ctx, where.F("postID", rq.GetPostID(), "commentID", rq.GetCommentID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.authorizeDelete(ctx, commentM); err != nil {
+		return nil, err
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Comment().Delete(ctx, where.F("commentID", commentM.CommentID)); err != nil {
+			return err
+		}
+		return b.store.Comment().Delete(ctx, where.F("parentID", commentM.CommentID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeleteCommentResponse{}, nil
+}
+
+// List 分页列出博文的顶级评论，并附带每条顶级评论下的全部回复.
+func (b *commentBiz) List(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error) {
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit())).F("postID", rq.GetPostID(), "parentID", "")
+	count, commentList, err := b.store.Comment().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	parentIDs := make([]string, 0, len(commentList))
+	for _, comment := range commentList {
+		parentIDs = append(parentIDs, comment.CommentID)
+	}
+
+	replies, err := b.listReplies(ctx, parentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*apiv1.Comment, 0, len(commentList))
+	for _, comment := range commentList {
+		converted := conversion.CommentModelToCommentV1(comment)
+		converted.Replies = replies[comment.CommentID]
+		comments = append(comments, converted)
+	}
+
+	return &apiv1.ListCommentResponse{TotalCount: count, Comments: comments}, nil
+}
+
+// authorizeDelete 校验当前用户是否有权限删除指定评论.
+func (b *commentBiz) authorizeDelete(ctx context.Context, commentM *model.CommentM) error {
+	userID := contextx.UserID(ctx)
+	if commentM.UserID == userID {
+		return nil
+	}
+
+	postM, err := b.store.Post().Get(ctx, where.F("postID", commentM.PostID))
+	if err != nil {
+		return err
+	}
+	if postM.UserID == userID {
+		return nil
+	}
+
+	allowed, err := b.authz.Authorize(userID, known.CommentObject, known.ActionModerate)
+	if err != nil {
+		log.W(ctx).Errorw("Failed to authorize comment moderation", "err", err)
+		return errno.ErrPermissionDenied
+	}
+	if !allowed {
+		return errno.ErrPermissionDenied.WithMessage("only the comment author or the post author can delete the comment")
+	}
+	return nil
+}
+
+// listReplies 查询指定顶级评论下的回复，返回以 parentID 为键、按创建时间正序排列的回复列表.
+func (b *commentBiz) listReplies(ctx context.Context, parentIDs []string) (map[string][]*apiv1.Comment, error) {
+	ret := make(map[string][]*apiv1.Comment, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return ret, nil
+	}
+
+	_, replyList, err := b.store.Comment().List(ctx, where.F("parentID", parentIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	// List 按 id 倒序返回，倒序遍历即可得到按创建时间正序排列的回复
+	for i := len(replyList) - 1; i >= 0; i-- {
+		reply := replyList[i]
+		ret[reply.ParentID] = append(ret[reply.ParentID], conversion.CommentModelToCommentV1(reply))
+	}
+
+	return ret, nil
+}
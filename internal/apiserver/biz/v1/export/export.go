@@ -0,0 +1,190 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/export"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxDirectExportPosts 是不创建导出任务、直接导出时允许的最大博文数量.
+// 博文较多时生成导出文件的时间较长，需要通过后台导出任务生成.
+const maxDirectExportPosts = 100
+
+// ExportBiz 定义处理博文导出请求所需的方法.
+type ExportBiz interface {
+	CreateJob(ctx context.Context, rq *apiv1.CreateExportJobRequest) (*apiv1.CreateExportJobResponse, error)
+	GetJob(ctx context.Context, rq *apiv1.GetExportJobRequest) (*apiv1.GetExportJobResponse, error)
+	Export(ctx context.Context, rq *apiv1.ExportPostsRequest) (*Download, error)
+
+	ExportExpansion
+}
+
+// ExportExpansion 定义额外的博文导出操作方法.
+type ExportExpansion interface{}
+
+// Download 表示一个待下载的导出文件.
+type Download struct {
+	// Filename 是建议客户端保存导出文件时使用的文件名.
+	Filename string
+	// ContentType 是导出文件的媒体类型.
+	ContentType string
+
+	copy func(w io.Writer) error
+}
+
+// Copy 将导出文件的内容写入 w. 出错时 w 中可能已经写入了部分内容.
+func (d *Download) Copy(w io.Writer) error {
+	return d.copy(w)
+}
+
+type exportBiz struct {
+	store    store.IStore
+	blobs    blob.BlobStore
+	exporter *export.Exporter
+}
+
+var _ ExportBiz = (*exportBiz)(nil)
+
+func New(store store.IStore, blobs blob.BlobStore) *exportBiz {
+	return &exportBiz{store: store, blobs: blobs, exporter: export.NewExporter(store)}
+}
+
+// CreateJob 创建后台导出任务. 当前用户已有相同格式的任务在等待或执行时直接返回该任务，避免重复导出.
+func (b *exportBiz) CreateJob(ctx context.Context, rq *apiv1.CreateExportJobRequest) (*apiv1.CreateExportJobResponse, error) {
+	whr := where.T(ctx).L(1).F("format", int32(rq.GetFormat())).
+		Q("status IN ?", []int32{int32(apiv1.ExportJobStatus_Pending), int32(apiv1.ExportJobStatus_Running)})
+	_, jobList, err := b.store.ExportJob().List(ctx, whr)
+	if err != nil {
+		return nil, errno.ErrDBRead
+	}
+	if len(jobList) > 0 {
+		return &apiv1.CreateExportJobResponse{Job: conversion.ExportJobModelToExportJobV1(jobList[0])}, nil
+	}
+
+	now := time.Now()
+	jobM := model.ExportJobM{
+		UserID:    contextx.UserID(ctx),
+		Format:    int32(rq.GetFormat()),
+		Status:    int32(apiv1.ExportJobStatus_Pending),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := b.store.ExportJob().Create(ctx, &jobM); err != nil {
+		log.W(ctx).Errorw("Failed to create export job", "err", err)
+		return nil, errno.ErrDBWrite
+	}
+
+	return &apiv1.CreateExportJobResponse{Job: conversion.ExportJobModelToExportJobV1(&jobM)}, nil
+}
+
+// GetJob 查询当前用户的导出任务.
+func (b *exportBiz) GetJob(ctx context.Context, rq *apiv1.GetExportJobRequest) (*apiv1.GetExportJobResponse, error) {
+	jobM, err := b.getJob(ctx, rq.GetJobID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetExportJobResponse{Job: conversion.ExportJobModelToExportJobV1(jobM)}, nil
+}
+
+// Export 返回待下载的导出文件. 指定了 jobID 时下载导出任务生成的文件，否则直接导出当前用户的博文.
+func (b *exportBiz) Export(ctx context.Context, rq *apiv1.ExportPostsRequest) (*Download, error) {
+	if rq.GetJobID() != "" {
+		return b.exportJob(ctx, rq.GetJobID())
+	}
+
+	userID := contextx.UserID(ctx)
+	count, err := b.exporter.Count(ctx, userID)
+	if err != nil {
+		return nil, errno.ErrDBRead
+	}
+	if count > maxDirectExportPosts {
+		return nil, errno.ErrExportTooLarge.WithMessage("accounts with more than %d posts must create an export job", maxDirectExportPosts)
+	}
+
+	format := rq.GetFormat()
+	return &Download{
+		Filename:    filename(time.Now(), format),
+		ContentType: export.ContentType(format),
+		copy: func(w io.Writer) error {
+			_, err := b.exporter.Export(ctx, userID, format, w)
+			return err
+		},
+	}, nil
+}
+
+// exportJob 返回导出任务生成的文件. 任务尚未完成时返回 errno.ErrExportJobNotReady.
+func (b *exportBiz) exportJob(ctx context.Context, jobID string) (*Download, error) {
+	jobM, err := b.getJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if jobM.Status != int32(apiv1.ExportJobStatus_Succeeded) {
+		return nil, errno.ErrExportJobNotReady.WithMessage("export job is %s", apiv1.ExportJobStatus(jobM.Status))
+	}
+
+	format := apiv1.ExportFormat(jobM.Format)
+	return &Download{
+		Filename:    filename(jobM.CreatedAt, format),
+		ContentType: export.ContentType(format),
+		copy: func(w io.Writer) error {
+			rc, err := b.blobs.Get(ctx, jobM.StorageKey)
+			if err != nil {
+				if errors.Is(err, blob.ErrNotFound) {
+					log.W(ctx).Errorw("Export file is missing from blob store", "jobID", jobM.JobID, "key", jobM.StorageKey)
+					return errno.ErrExportJobNotFound
+				}
+				return err
+			}
+			defer rc.Close()
+
+			_, err = io.Copy(w, rc)
+			return err
+		},
+	}, nil
+}
+
+// getJob 查询当前用户的导出任务，任务不存在时返回 errno.ErrExportJobNotFound.
+func (b *exportBiz) getJob(ctx context.Context, jobID string) (*model.ExportJobM, error) {
+	jobM, err := b.store.ExportJob().Get(ctx, where.T(ctx).F("jobID", jobID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrExportJobNotFound
+		}
+		return nil, errno.ErrDBRead
+	}
+	return jobM, nil
+}
+
+// filename 返回导出文件的文件名，例如 posts-20240501.zip.
+func filename(date time.Time, format apiv1.ExportFormat) string {
+	return "posts-" + date.Format("20060102") + export.Extension(format)
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// MediaBiz 定义处理媒体文件请求所需的方法.
+type MediaBiz interface {
+	Upload(ctx context.Context, rq *apiv1.UploadMediaRequest) (*apiv1.UploadMediaResponse, error)
+	Get(ctx context.Context, rq *apiv1.GetMediaRequest) (*httpbody.HttpBody, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteMediaRequest) (*apiv1.DeleteMediaResponse, error)
+
+	MediaExpansion
+}
+
+// MediaExpansion 定义额外的媒体文件操作方法.
+type MediaExpansion interface {
+	GetPublic(ctx context.Context, rq *apiv1.GetPublicMediaRequest) (*httpbody.HttpBody, error)
+}
+
+type mediaBiz struct {
+	store store.IStore
+	blobs blob.BlobStore
+}
+
+var _ MediaBiz = (*mediaBiz)(nil)
+
+func New(store store.IStore, blobs blob.BlobStore) *mediaBiz {
+	return &mediaBiz{store: store, blobs: blobs}
+}
+
+// Upload 保存媒体文件. 数据库记录和文件在同一个事务中写入，文件写入失败时回滚数据库记录.
+func (b *mediaBiz) Upload(ctx context.Context, rq *apiv1.UploadMediaRequest) (*apiv1.UploadMediaResponse, error) {
+	data := rq.GetData()
+	now := time.Now()
+	mediaM := model.MediaM{
+		UserID:      contextx.UserID(ctx),
+		PostID:      rq.GetPostID(),
+		Filename:    rq.GetFilename(),
+		ContentType: http.DetectContentType(data),
+		Size:        int64(len(data)),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Media().Create(ctx, &mediaM); err != nil {
+			return err
+		}
+
+		mediaM.StorageKey = mediaM.UserID + "/" + mediaM.MediaID
+		if err := b.store.Media().Update(ctx, &mediaM); err != nil {
+			return err
+		}
+		return b.blobs.Put(ctx, mediaM.StorageKey, bytes.NewReader(data), mediaM.Size, mediaM.ContentType)
+	})
+	if err != nil {
+		// 事务提交失败时文件可能已经写入，需要删除以免产生孤立文件
+		if mediaM.StorageKey != "" {
+			b.deleteBlobs(ctx, mediaM.StorageKey)
+		}
+		log.W(ctx).Errorw("Failed to upload media", "postID", rq.GetPostID(), "err", err)
+		return nil, err
+	}
+
+	return &apiv1.UploadMediaResponse{Media: conversion.MediaModelToMediaV1(&mediaM)}, nil
+}
+
+// Get 下载当前用户上传的媒体文件.
+func (b *mediaBiz) Get(ctx context.Context, rq *apiv1.GetMediaRequest) (*httpbody.HttpBody, error) {
+	mediaM, err := b.getMedia(ctx, where.T(ctx).F("mediaID", rq.GetMediaID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return b.read(ctx, mediaM)
+}
+
+// Delete 删除当前用户上传的媒体文件. 数据库记录删除后文件删除失败只记录日志，不影响请求结果.
+func (b *mediaBiz) Delete(ctx context.Context, rq *apiv1.DeleteMediaRequest) (*apiv1.DeleteMediaResponse, error) {
+	whr := where.T(ctx).F("mediaID", rq.GetMediaID())
+	mediaM, err := b.getMedia(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.store.Media().Delete(ctx, whr); err != nil {
+		return nil, err
+	}
+	b.deleteBlobs(ctx, mediaM.StorageKey)
+
+	return &apiv1.DeleteMediaResponse{}, nil
+}
+
+// GetPublic 下载已发布的公开或不公开列出博文中的媒体文件. 所属博文无法通过链接访问时与媒体文件不存在返回相同的错误.
+func (b *mediaBiz) GetPublic(ctx context.Context, rq *apiv1.GetPublicMediaRequest) (*httpbody.HttpBody, error) {
+	mediaM, err := b.getMedia(ctx, where.F("mediaID", rq.GetMediaID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := b.store.Post().Get(ctx, visibility.LinkableWhere().F("postID", mediaM.PostID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrMediaNotFound
+		}
+		return nil, err
+	}
+
+	return b.read(ctx, mediaM)
+}
+
+// getMedia 查询媒体文件记录，记录不存在时返回 errno.ErrMediaNotFound.
+func (b *mediaBiz) getMedia(ctx context.Context, whr *where.Options) (*model.MediaM, error) {
+	mediaM, err := b.store.Media().Get(ctx, whr)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrMediaNotFound
+		}
+		return nil, err
+	}
+	return mediaM, nil
+}
+
+// read 读取媒体文件的内容.
+func (b *mediaBiz) read(ctx context.Context, mediaM *model.MediaM) (*httpbody.HttpBody, error) {
+	rc, err := b.blobs.Get(ctx, mediaM.StorageKey)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			log.W(ctx).Errorw("Media file is missing from blob store", "mediaID", mediaM.MediaID, "key", mediaM.StorageKey)
+			return nil, errno.ErrMediaNotFound
+		}
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{ContentType: mediaM.ContentType, Data: data}, nil
+}
+
+// deleteBlobs 删除媒体文件的内容，删除失败只记录日志.
+func (b *mediaBiz) deleteBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := b.blobs.Delete(ctx, key); err != nil {
+			log.W(ctx).Errorw("Failed to delete media file", "key", key, "err", err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/cursor"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// defaultBookmarkLimit 定义未指定每页数量时，阅读列表每页返回的收藏数量.
+const defaultBookmarkLimit = 20
+
+// AddBookmark 将其他用户已发布的文章加入当前用户的阅读列表. 重复收藏时保留最初的收藏时间.
+func (b *postBiz) AddBookmark(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error) {
+	bookmarkM := model.BookmarkM{
+		UserID:    contextx.UserID(ctx),
+		PostID:    rq.GetPostID(),
+		CreatedAt: time.Now(),
+	}
+	if err := b.store.Bookmark().Add(ctx, &bookmarkM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.AddBookmarkResponse{}, nil
+}
+
+// RemoveBookmark 将文章从当前用户的阅读列表中移除.
+func (b *postBiz) RemoveBookmark(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error) {
+	if err := b.store.Bookmark().Delete(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RemoveBookmarkResponse{}, nil
+}
+
+// ListBookmarks 按收藏时间倒序列出当前用户的阅读列表. 已撤回、删除或改为私密的文章不会出现在列表中.
+// 列表使用游标分页，每次多查询一条记录来判断是否还有下一页.
+func (b *postBiz) ListBookmarks(ctx context.Context, rq *apiv1.ListBookmarksRequest) (*apiv1.ListBookmarksResponse, error) {
+	limit := int(rq.GetLimit())
+	if limit == 0 {
+		limit = defaultBookmarkLimit
+	}
+
+	// 游标的合法性已由 validation 层校验
+	after, _ := cursor.Decode(rq.GetCursor())
+
+	linkable := visibility.LinkableWhere().Where(b.store.DB(ctx).Model(&model.PostM{}).Select("postID"))
+	whr := where.T(ctx).L(limit+1).Q("postID IN (?)", linkable)
+	if after > 0 {
+		whr.Q("id < ?", after)
+	}
+
+	_, bookmarkList, err := b.store.Bookmark().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(bookmarkList) > limit {
+		bookmarkList = bookmarkList[:limit]
+		nextCursor = cursor.Encode(bookmarkList[limit-1].ID)
+	}
+
+	bookmarks, err := b.toBookmarks(ctx, bookmarkList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListBookmarksResponse{Bookmarks: bookmarks, NextCursor: nextCursor}, nil
+}
+
+// toBookmarks 将收藏记录转换为 apiv1.Bookmark 列表，并附带收藏的文章.
+func (b *postBiz) toBookmarks(ctx context.Context, bookmarkList []*model.BookmarkM) ([]*apiv1.Bookmark, error) {
+	if len(bookmarkList) == 0 {
+		return []*apiv1.Bookmark{}, nil
+	}
+
+	postIDs := make([]string, 0, len(bookmarkList))
+	for _, bookmark := range bookmarkList {
+		postIDs = append(postIDs, bookmark.PostID)
+	}
+
+	_, postList, err := b.store.Post().List(ctx, visibility.LinkableWhere().F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+	posts, err := b.toPublicPosts(ctx, postList)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make(map[string]*apiv1.PublicPost, len(posts))
+	for _, post := range posts {
+		converted[post.PostID] = post
+	}
+
+	bookmarks := make([]*apiv1.Bookmark, 0, len(bookmarkList))
+	for _, bookmark := range bookmarkList {
+		// 查询收藏记录和文章之间文章可能被撤回，跳过已不可见的文章
+		post, ok := converted[bookmark.PostID]
+		if !ok {
+			continue
+		}
+		bookmarks = append(bookmarks, &apiv1.Bookmark{Post: post, CreatedAt: timestamppb.New(bookmark.CreatedAt)})
+	}
+
+	return bookmarks, nil
+}
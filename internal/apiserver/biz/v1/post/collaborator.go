@@ -0,0 +1,173 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm/clause"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// AddCollaborator 邀请用户成为当前用户文章的协作者，用户已是协作者时修改其角色.
+func (b *postBiz) AddCollaborator(ctx context.Context, rq *apiv1.AddCollaboratorRequest) (*apiv1.AddCollaboratorResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	collaboratorM := model.PostCollaboratorM{
+		PostID:    postM.PostID,
+		UserID:    rq.GetUserID(),
+		Role:      int32(rq.GetRole()),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := b.store.PostCollaborator().Upsert(ctx, &collaboratorM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.AddCollaboratorResponse{}, nil
+}
+
+// RemoveCollaborator 移除文章的协作者. 文章作者可以移除任意协作者，协作者只能移除自己.
+func (b *postBiz) RemoveCollaborator(ctx context.Context, rq *apiv1.RemoveCollaboratorRequest) (*apiv1.RemoveCollaboratorResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+	if userID := contextx.UserID(ctx); postM.UserID != userID && rq.GetUserID() != userID {
+		return nil, errno.ErrPostPermissionDenied
+	}
+
+	if err := b.store.PostCollaborator().Delete(ctx, where.F("postID", postM.PostID, "userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RemoveCollaboratorResponse{}, nil
+}
+
+// ListCollaborators 列出文章的协作者. 只有文章作者和协作者可以查看.
+func (b *postBiz) ListCollaborators(ctx context.Context, rq *apiv1.ListCollaboratorsRequest) (*apiv1.ListCollaboratorsResponse, error) {
+	postM, _, err := b.getCollaborative(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+
+	whr := where.F("postID", postM.PostID).C(clause.OrderBy{Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "id"}}}})
+	_, collaboratorList, err := b.store.PostCollaborator().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(collaboratorList))
+	for _, collaborator := range collaboratorList {
+		userIDs = append(userIDs, collaborator.UserID)
+	}
+	users := make(map[string]*model.UserM, len(userIDs))
+	if len(userIDs) > 0 {
+		_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range userList {
+			users[user.UserID] = user
+		}
+	}
+
+	collaborators := make([]*apiv1.Collaborator, 0, len(collaboratorList))
+	for _, collaborator := range collaboratorList {
+		// 协作者的账号可能已被删除
+		user, ok := users[collaborator.UserID]
+		if !ok {
+			continue
+		}
+		collaborators = append(collaborators, &apiv1.Collaborator{
+			User:      conversion.UserModelToPublicAuthorV1(user),
+			Role:      apiv1.CollaboratorRole(collaborator.Role),
+			CreatedAt: timestamppb.New(collaborator.CreatedAt),
+			UpdatedAt: timestamppb.New(collaborator.UpdatedAt),
+		})
+	}
+
+	return &apiv1.ListCollaboratorsResponse{Collaborators: collaborators}, nil
+}
+
+// getCollaborative 获取当前用户作为作者或协作者的文章，同时返回当前用户的协作角色，当前用户是作者时角色为 nil.
+// 当前用户有权查看但不是作者或协作者时返回 errno.ErrPostPermissionDenied，无权查看时返回 errno.ErrPostNotFound.
+func (b *postBiz) getCollaborative(ctx context.Context, postID string) (*model.PostM, *apiv1.CollaboratorRole, error) {
+	postM, err := b.getViewable(ctx, postID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userID := contextx.UserID(ctx)
+	if postM.UserID == userID {
+		return postM, nil, nil
+	}
+
+	roles, err := b.store.PostCollaborator().Roles(ctx, userID, postM.PostID)
+	if err != nil {
+		return nil, nil, err
+	}
+	role, ok := roles[postM.PostID]
+	if !ok {
+		return nil, nil, errno.ErrPostPermissionDenied
+	}
+	return postM, apiv1.CollaboratorRole(role).Enum(), nil
+}
+
+// getEditable 获取当前用户可以修改的文章，即当前用户自己的文章或当前用户是编辑者的文章.
+// 只读协作者返回 errno.ErrPostPermissionDenied.
+func (b *postBiz) getEditable(ctx context.Context, postID string) (*model.PostM, bool, error) {
+	postM, role, err := b.getCollaborative(ctx, postID)
+	if err != nil {
+		return nil, false, err
+	}
+	if role != nil && *role != apiv1.CollaboratorRole_Editor {
+		return nil, false, errno.ErrPostPermissionDenied
+	}
+	return postM, role == nil, nil
+}
+
+// listRoles 查询当前用户在其他用户文章中的协作角色，返回以 postID 为键的角色.
+func (b *postBiz) listRoles(ctx context.Context, postList ...*model.PostM) (map[string]*apiv1.CollaboratorRole, error) {
+	userID := contextx.UserID(ctx)
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		if post.UserID != userID {
+			postIDs = append(postIDs, post.PostID)
+		}
+	}
+
+	roles, err := b.store.PostCollaborator().Roles(ctx, userID, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*apiv1.CollaboratorRole, len(roles))
+	for postID, role := range roles {
+		ret[postID] = apiv1.CollaboratorRole(role).Enum()
+	}
+	return ret, nil
+}
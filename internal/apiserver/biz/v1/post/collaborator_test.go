@@ -0,0 +1,329 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	editorID = "user-editor"
+	viewerID = "user-viewer"
+)
+
+// addCollaborators 将 editorID 和 viewerID 分别以编辑者和只读协作者的身份加入 postIDs 中的每篇文章.
+func addCollaborators(t *testing.T, b *postBiz, postIDs []string) {
+	t.Helper()
+
+	ctx := contextx.WithUserID(context.Background(), ownerID)
+	for _, postID := range postIDs {
+		_, err := b.AddCollaborator(ctx, &apiv1.AddCollaboratorRequest{PostID: postID, UserID: editorID, Role: apiv1.CollaboratorRole_Editor})
+		require.NoError(t, err)
+		_, err = b.AddCollaborator(ctx, &apiv1.AddCollaboratorRequest{PostID: postID, UserID: viewerID, Role: apiv1.CollaboratorRole_Viewer})
+		require.NoError(t, err)
+	}
+}
+
+func TestPostBiz_CollaboratorGetAndUpdate(t *testing.T) {
+	b, s := newTestBiz(t)
+	postIDs := createPosts(t, s)
+	addCollaborators(t, b, postIDs)
+
+	tests := []struct {
+		userID  string
+		role    *apiv1.CollaboratorRole
+		editErr error
+	}{
+		{ownerID, nil, nil},
+		{editorID, apiv1.CollaboratorRole_Editor.Enum(), nil},
+		{viewerID, apiv1.CollaboratorRole_Viewer.Enum(), errno.ErrPostPermissionDenied},
+	}
+
+	for i, vc := range visibilityCases {
+		t.Run(vc.visibility.String()+"/"+vc.status.String(), func(t *testing.T) {
+			postID := postIDs[i]
+			for _, tt := range tests {
+				ctx := contextx.WithUserID(context.Background(), tt.userID)
+
+				// 协作者可以查看任意可见范围和状态的文章
+				resp, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postID})
+				require.NoError(t, err, tt.userID)
+				assert.Equal(t, tt.role, resp.GetPost().Role, tt.userID)
+
+				title := "edited by " + tt.userID
+				_, err = b.Update(ctx, &apiv1.UpdatePostRequest{PostID: postID, Title: ptr.To(title), Version: ptr.To(resp.GetPost().GetVersion())})
+				if tt.editErr != nil {
+					assert.ErrorIs(t, err, tt.editErr, tt.userID)
+					continue
+				}
+				require.NoError(t, err, tt.userID)
+				postM, err := s.Post().Get(context.Background(), where.F("postID", postID))
+				require.NoError(t, err)
+				assert.Equal(t, title, postM.Title, tt.userID)
+				// 编辑者修改文章后，文章仍属于作者
+				assert.Equal(t, ownerID, postM.UserID, tt.userID)
+			}
+
+			// 只有作者可以修改可见范围
+			editorCtx := contextx.WithIfMatch(contextx.WithUserID(context.Background(), editorID), etag.Any)
+			_, err := b.Update(editorCtx, &apiv1.UpdatePostRequest{PostID: postID, Visibility: ptr.To(apiv1.PostVisibility_Public)})
+			assert.ErrorIs(t, err, errno.ErrPostPermissionDenied)
+		})
+	}
+}
+
+func TestPostBiz_CollaboratorList(t *testing.T) {
+	b, s := newTestBiz(t)
+	postIDs := createPosts(t, s)
+	addCollaborators(t, b, postIDs[:len(postIDs)/2])
+
+	tests := []struct {
+		name   string
+		userID string
+		rq     *apiv1.ListPostRequest
+		want   []string
+		role   *apiv1.CollaboratorRole
+	}{
+		{
+			name:   "editor lists collaborating posts",
+			userID: editorID,
+			rq:     &apiv1.ListPostRequest{Collaborating: true},
+			want:   postIDs[:len(postIDs)/2],
+			role:   apiv1.CollaboratorRole_Editor.Enum(),
+		},
+		{
+			name:   "viewer lists collaborating posts by owner",
+			userID: viewerID,
+			rq:     &apiv1.ListPostRequest{Collaborating: true, UserID: ptr.To(ownerID)},
+			want:   postIDs[:len(postIDs)/2],
+			role:   apiv1.CollaboratorRole_Viewer.Enum(),
+		},
+		{
+			name:   "other lists collaborating posts",
+			userID: otherID,
+			rq:     &apiv1.ListPostRequest{Collaborating: true},
+			want:   []string{},
+		},
+		{
+			name:   "owner lists collaborating posts",
+			userID: ownerID,
+			rq:     &apiv1.ListPostRequest{Collaborating: true},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contextx.WithUserID(context.Background(), tt.userID)
+			resp, err := b.List(ctx, tt.rq)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(resp.GetPosts()))
+			for _, post := range resp.GetPosts() {
+				got = append(got, post.GetPostID())
+				assert.Equal(t, tt.role, post.Role, post.GetPostID())
+			}
+			assert.ElementsMatch(t, tt.want, got)
+			assert.Equal(t, int64(len(tt.want)), resp.GetTotalCount())
+		})
+	}
+
+	// 列出作者的文章时，协作者能看到参与协作的文章和公开列出的文章
+	ctx := contextx.WithUserID(context.Background(), viewerID)
+	resp, err := b.List(ctx, &apiv1.ListPostRequest{UserID: ptr.To(ownerID)})
+	require.NoError(t, err)
+	want := append([]string{}, postIDs[:len(postIDs)/2]...)
+	for i := len(postIDs) / 2; i < len(postIDs); i++ {
+		if visibilityCases[i].visibility == apiv1.PostVisibility_Public && visibilityCases[i].status == apiv1.PostStatus_Published {
+			want = append(want, postIDs[i])
+		}
+	}
+	got := make([]string, 0, len(resp.GetPosts()))
+	for _, post := range resp.GetPosts() {
+		got = append(got, post.GetPostID())
+	}
+	assert.ElementsMatch(t, want, got)
+}
+
+func TestPostBiz_AddAndRemoveCollaborator(t *testing.T) {
+	b, s := newTestBiz(t)
+	ownerCtx := contextx.WithUserID(context.Background(), ownerID)
+
+	postM := model.PostM{UserID: ownerID, Title: "title", Content: "content", Visibility: int32(apiv1.PostVisibility_Private)}
+	require.NoError(t, s.Post().Create(context.Background(), &postM))
+	addCollaborators(t, b, []string{postM.PostID})
+
+	// 再次邀请时修改角色
+	_, err := b.AddCollaborator(ownerCtx, &apiv1.AddCollaboratorRequest{PostID: postM.PostID, UserID: viewerID, Role: apiv1.CollaboratorRole_Editor})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{postM.PostID: int32(apiv1.CollaboratorRole_Editor)}, roles(t, s, viewerID, postM.PostID))
+	_, err = b.AddCollaborator(ownerCtx, &apiv1.AddCollaboratorRequest{PostID: postM.PostID, UserID: viewerID, Role: apiv1.CollaboratorRole_Viewer})
+	require.NoError(t, err)
+
+	// 编辑者不能邀请其他协作者
+	editorCtx := contextx.WithUserID(context.Background(), editorID)
+	_, err = b.AddCollaborator(editorCtx, &apiv1.AddCollaboratorRequest{PostID: postM.PostID, UserID: otherID, Role: apiv1.CollaboratorRole_Editor})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	tests := []struct {
+		name    string
+		userID  string
+		target  string
+		wantErr error
+	}{
+		{"editor removes viewer", editorID, viewerID, errno.ErrPostPermissionDenied},
+		{"other removes editor", otherID, editorID, errno.ErrPostPermissionDenied},
+		{"viewer leaves", viewerID, viewerID, nil},
+		{"owner removes editor", ownerID, editorID, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contextx.WithUserID(context.Background(), tt.userID)
+			_, err := b.RemoveCollaborator(ctx, &apiv1.RemoveCollaboratorRequest{PostID: postM.PostID, UserID: tt.target})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Len(t, roles(t, s, tt.target, postM.PostID), 1)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, roles(t, s, tt.target, postM.PostID))
+
+			// 被移除的协作者不能再查看私密文章
+			_, err = b.Get(contextx.WithUserID(context.Background(), tt.target), &apiv1.GetPostRequest{PostID: postM.PostID})
+			assert.ErrorIs(t, err, errno.ErrPostNotFound)
+		})
+	}
+}
+
+func TestPostBiz_ListCollaborators(t *testing.T) {
+	b, s := newTestBiz(t)
+	// 协作者需要是已存在的用户，用户 ID 在创建时生成
+	userIDs := make([]string, 0, 3)
+	for i, username := range []string{"editor", "viewer", "other"} {
+		userM := model.UserM{Username: username, Password: "miniblog1234", Nickname: username, Email: username + "@miniblog.com", Phone: "1881111111" + strconv.Itoa(i)}
+		require.NoError(t, s.User().Create(context.Background(), &userM))
+		userIDs = append(userIDs, userM.UserID)
+	}
+	editor, viewer, other := userIDs[0], userIDs[1], userIDs[2]
+
+	postM := model.PostM{UserID: ownerID, Title: "title", Content: "content", Visibility: int32(apiv1.PostVisibility_Public), Status: int32(apiv1.PostStatus_Published)}
+	require.NoError(t, s.Post().Create(context.Background(), &postM))
+	ownerCtx := contextx.WithUserID(context.Background(), ownerID)
+	_, err := b.AddCollaborator(ownerCtx, &apiv1.AddCollaboratorRequest{PostID: postM.PostID, UserID: editor, Role: apiv1.CollaboratorRole_Editor})
+	require.NoError(t, err)
+	_, err = b.AddCollaborator(ownerCtx, &apiv1.AddCollaboratorRequest{PostID: postM.PostID, UserID: viewer, Role: apiv1.CollaboratorRole_Viewer})
+	require.NoError(t, err)
+
+	tests := []struct {
+		userID  string
+		wantErr error
+	}{
+		{ownerID, nil},
+		{editor, nil},
+		{viewer, nil},
+		// 公开文章的其他读者不能查看协作者列表
+		{other, errno.ErrPostPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		ctx := contextx.WithUserID(context.Background(), tt.userID)
+		resp, err := b.ListCollaborators(ctx, &apiv1.ListCollaboratorsRequest{PostID: postM.PostID})
+		if tt.wantErr != nil {
+			assert.ErrorIs(t, err, tt.wantErr, tt.userID)
+			continue
+		}
+		require.NoError(t, err, tt.userID)
+		require.Len(t, resp.GetCollaborators(), 2, tt.userID)
+		assert.Equal(t, "editor", resp.GetCollaborators()[0].GetUser().GetUsername())
+		assert.Equal(t, apiv1.CollaboratorRole_Editor, resp.GetCollaborators()[0].GetRole())
+		assert.Equal(t, "viewer", resp.GetCollaborators()[1].GetUser().GetUsername())
+		assert.Equal(t, apiv1.CollaboratorRole_Viewer, resp.GetCollaborators()[1].GetRole())
+	}
+}
+
+func TestPostBiz_CollaboratorRevisions(t *testing.T) {
+	b, _ := newTestBiz(t)
+	ownerCtx := contextx.WithUserID(context.Background(), ownerID)
+	rsp, err := b.Create(ownerCtx, &apiv1.CreatePostRequest{Title: "title", Content: "content", Visibility: apiv1.PostVisibility_Private})
+	require.NoError(t, err)
+	postID := rsp.GetPostID()
+	addCollaborators(t, b, []string{postID})
+
+	// 编辑者修改文章生成的历史版本，编辑者自己也可以查看和回滚
+	editorCtx := contextx.WithIfMatch(contextx.WithUserID(context.Background(), editorID), etag.Any)
+	_, err = b.Update(editorCtx, &apiv1.UpdatePostRequest{PostID: postID, Content: ptr.To("edited")})
+	require.NoError(t, err)
+
+	tests := []struct {
+		userID     string
+		readErr    error
+		restoreErr error
+	}{
+		{ownerID, nil, nil},
+		{editorID, nil, nil},
+		{viewerID, nil, errno.ErrPostPermissionDenied},
+		{otherID, errno.ErrPostNotFound, errno.ErrPostNotFound},
+	}
+
+	for _, tt := range tests {
+		ctx := contextx.WithIfMatch(contextx.WithUserID(context.Background(), tt.userID), etag.Any)
+
+		listResp, err := b.ListRevisions(ctx, &apiv1.ListPostRevisionsRequest{PostID: postID})
+		_, getErr := b.GetRevision(ctx, &apiv1.GetPostRevisionRequest{PostID: postID, Revision: 1})
+		_, diffErr := b.DiffRevisions(ctx, &apiv1.DiffPostRevisionsRequest{PostID: postID, FromRevision: 1, ToRevision: 2})
+		if tt.readErr != nil {
+			assert.ErrorIs(t, err, tt.readErr, tt.userID)
+			assert.ErrorIs(t, getErr, tt.readErr, tt.userID)
+			assert.ErrorIs(t, diffErr, tt.readErr, tt.userID)
+		} else {
+			require.NoError(t, err, tt.userID)
+			assert.GreaterOrEqual(t, listResp.GetTotalCount(), int64(2), tt.userID)
+			assert.NoError(t, getErr, tt.userID)
+			assert.NoError(t, diffErr, tt.userID)
+		}
+
+		_, err = b.RestoreRevision(ctx, &apiv1.RestorePostRevisionRequest{PostID: postID, Revision: 1})
+		if tt.restoreErr != nil {
+			assert.ErrorIs(t, err, tt.restoreErr, tt.userID)
+			continue
+		}
+		assert.NoError(t, err, tt.userID)
+	}
+}
+
+// roles 返回 userID 在 postID 中的协作角色.
+func roles(t *testing.T, s store.IStore, userID string, postID string) map[string]int32 {
+	t.Helper()
+
+	ret, err := s.PostCollaborator().Roles(context.Background(), userID, postID)
+	require.NoError(t, err)
+	return ret
+}
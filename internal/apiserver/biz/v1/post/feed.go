@@ -0,0 +1,109 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/feed"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+)
+
+// feedSize 为订阅源中包含的最新文章数.
+const feedSize = 20
+
+// Feed 生成已发布文章的订阅源. username 为空时生成全站订阅源，否则只包含该作者的文章.
+func (b *postBiz) Feed(ctx context.Context, username string) (*feed.Feed, error) {
+	whr := publishedWhere().L(feedSize).C(clause.OrderBy{
+		Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "publishedAt"}, Desc: true}},
+	})
+
+	f := &feed.Feed{
+		Title:       "miniblog",
+		Description: "miniblog 最新发布的文章",
+		Path:        "/v1/public/posts",
+	}
+	// 统计所有文章（包括草稿、私密文章和回收站中的文章）的修改时间，文章离开订阅源时该时间同样会变晚
+	modifiedWhere := where.NewWhere()
+	if username != "" {
+		userM, err := b.store.User().Get(ctx, where.F("username", username))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errno.ErrUserNotFound
+			}
+			return nil, err
+		}
+
+		whr.F("userID", userM.UserID)
+		modifiedWhere.F("userID", userM.UserID)
+		f.Title = authorName(userM) + " - miniblog"
+		f.Description = authorName(userM) + " 最新发布的文章"
+		f.Path = "/v1/public/users/" + url.PathEscape(userM.Username) + "/posts"
+	}
+
+	_, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	if f.LastModified, err = b.store.Post().LastModified(ctx, modifiedWhere); err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPublicPosts(ctx, postList)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Entries = make([]*feed.Entry, 0, len(posts))
+	for _, post := range posts {
+		entry := &feed.Entry{
+			ID:         post.GetPostID(),
+			Title:      post.GetTitle(),
+			Path:       "/v1/public/posts/slug/" + url.PathEscape(post.GetSlug()),
+			Summary:    post.GetExcerpt(),
+			Content:    post.GetContentHtml(),
+			Categories: post.GetTags(),
+			Published:  post.GetPublishedAt().AsTime(),
+			Updated:    post.GetUpdatedAt().AsTime(),
+		}
+		if post.GetAuthor() != nil {
+			entry.Author = post.GetAuthor().GetNickname()
+			if entry.Author == "" {
+				entry.Author = post.GetAuthor().GetUsername()
+			}
+		}
+		if entry.Updated.After(f.Updated) {
+			f.Updated = entry.Updated
+		}
+		f.Entries = append(f.Entries, entry)
+	}
+
+	return f, nil
+}
+
+// authorName 返回作者在订阅源中展示的名称，优先使用昵称.
+func authorName(userM *model.UserM) string {
+	if userM.Nickname != "" {
+		return userM.Nickname
+	}
+	return userM.Username
+}
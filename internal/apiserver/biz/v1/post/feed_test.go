@@ -0,0 +1,63 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestPostBiz_FeedLastModified(t *testing.T) {
+	b, s := newTestBiz(t)
+	ctx := context.Background()
+
+	postIDs := make([]string, 0, 2)
+	for i, updatedAt := range []time.Time{time.Now().Add(-2 * time.Hour), time.Now().Add(-time.Hour)} {
+		postM := model.PostM{
+			UserID:     ownerID,
+			Title:      "title",
+			Content:    "content",
+			Status:     int32(apiv1.PostStatus_Published),
+			Visibility: int32(apiv1.PostVisibility_Public),
+		}
+		require.NoError(t, s.Post().Create(ctx, &postM))
+		require.NoError(t, s.DB(ctx).Model(&postM).UpdateColumn("updatedAt", updatedAt).Error, i)
+		postIDs = append(postIDs, postM.PostID)
+	}
+
+	f, err := b.Feed(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, f.Entries, 2)
+	lastModified := f.LastModified
+	assert.True(t, f.Updated.Equal(lastModified))
+
+	// 最新的文章移入回收站后，条目的最近更新时间变早，但 Last-Modified 仍然变晚
+	_, err = b.Delete(contextx.WithUserID(ctx, ownerID), &apiv1.DeletePostRequest{PostIDs: postIDs[1:]})
+	require.NoError(t, err)
+
+	f, err = b.Feed(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, f.Entries, 1)
+	assert.True(t, f.Updated.Before(lastModified))
+	assert.True(t, f.LastModified.After(lastModified))
+}
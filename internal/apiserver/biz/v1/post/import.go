@@ -0,0 +1,188 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/frontmatter"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	"github.com/jwcen/miniblog/internal/pkg/slug"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// 导入的文章与通过接口创建的文章使用相同的标签限制.
+	maxImportTags      = 10
+	maxImportTagLength = 32
+)
+
+// Import 将一个带 YAML front matter 的 Markdown 文件导入为文章，并保留文件中记录的发布时间和修改时间.
+// 单个文件导入失败不会返回错误，而是在响应中返回失败原因，以便调用方继续导入其它文件.
+func (b *postBiz) Import(ctx context.Context, rq *apiv1.ImportPostsRequest) (*apiv1.ImportPostsResponse, error) {
+	resp := &apiv1.ImportPostsResponse{Filename: rq.GetFilename()}
+
+	postID, err := b.importPost(ctx, rq)
+	if err != nil {
+		// 请求被取消时停止导入
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		log.W(ctx).Warnw("Failed to import post", "filename", rq.GetFilename(), "err", err)
+		resp.Error = errorsx.FromError(err).Message
+		return resp, nil
+	}
+
+	resp.Success = true
+	resp.PostID = postID
+	return resp, nil
+}
+
+func (b *postBiz) importPost(ctx context.Context, rq *apiv1.ImportPostsRequest) (string, error) {
+	userID, err := b.importUserID(ctx, rq.GetUsername())
+	if err != nil {
+		return "", err
+	}
+
+	doc, err := frontmatter.Parse(rq.GetContent())
+	if err != nil {
+		return "", errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+	if err := validateImportDocument(doc); err != nil {
+		return "", err
+	}
+
+	// 发布时间依次取 front matter 中的 date、Jekyll 风格文件名中的日期和当前时间
+	date := doc.Date
+	if date.IsZero() {
+		date = frontmatter.FilenameDate(rq.GetFilename())
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	lastmod := doc.Lastmod
+	if lastmod.Before(date) {
+		lastmod = date
+	}
+
+	postM := model.PostM{
+		UserID:        userID,
+		Title:         doc.Title,
+		Content:       doc.Body,
+		ContentFormat: int32(apiv1.ContentFormat_Markdown),
+		Status:        int32(apiv1.PostStatus_Published),
+		PublishedAt:   ptr.To(date),
+		CreatedAt:     date,
+		UpdatedAt:     lastmod,
+	}
+	if doc.Draft {
+		postM.Status = int32(apiv1.PostStatus_Draft)
+		postM.PublishedAt = nil
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		postM.Slug = doc.Slug
+		if postM.Slug == "" {
+			generated, err := b.generateSlug(ctx, postM.Title, "")
+			if err != nil {
+				return err
+			}
+			postM.Slug = generated
+		} else {
+			exists, err := b.store.Post().SlugExists(ctx, postM.Slug, "")
+			if err != nil {
+				return err
+			}
+			if exists {
+				return errno.ErrPostSlugAlreadyExists
+			}
+		}
+
+		postM.Revision = 1
+		if err := b.store.Post().Create(ctx, &postM); err != nil {
+			return err
+		}
+		if _, err := b.createRevision(ctx, &postM); err != nil {
+			return err
+		}
+		if err := b.createTags(ctx, &postM, doc.Tags); err != nil {
+			return err
+		}
+
+		// 保存文章时会自动将修改时间更新为当前时间，最后使用 UpdateColumn 恢复导入的修改时间
+		return b.store.DB(ctx).Model(&postM).UpdateColumn("updatedAt", lastmod).Error
+	})
+	if err != nil {
+		return "", err
+	}
+
+	b.indexPost(ctx, &postM)
+
+	return postM.PostID, nil
+}
+
+// importUserID 返回文章导入到的用户 ID. 未指定用户名时导入到当前用户，只有管理员可以导入到其他用户.
+func (b *postBiz) importUserID(ctx context.Context, username string) (string, error) {
+	if username == "" {
+		return contextx.UserID(ctx), nil
+	}
+	if username != contextx.Username(ctx) && contextx.Username(ctx) != known.AdminUsername {
+		return "", errno.ErrPermissionDenied.WithMessage("only administrators can import posts for other users")
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("username", username))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", errno.ErrUserNotFound
+		}
+		return "", err
+	}
+	return userM.UserID, nil
+}
+
+// validateImportDocument 校验导入的文件是否满足创建文章的要求.
+func validateImportDocument(doc *frontmatter.Document) error {
+	if doc.Title == "" {
+		return errno.ErrInvalidArgument.WithMessage("title cannot be empty")
+	}
+	if doc.Body == "" {
+		return errno.ErrInvalidArgument.WithMessage("content cannot be empty")
+	}
+	if doc.Slug != "" && !slug.Valid(doc.Slug) {
+		return errno.ErrInvalidArgument.WithMessage("slug must consist of lowercase letters, digits and single hyphens, and be at most %d characters", slug.MaxLength)
+	}
+	tags := normalizeTags(doc.Tags)
+	if len(tags) > maxImportTags {
+		return errno.ErrInvalidArgument.WithMessage("a post can have at most %d tags", maxImportTags)
+	}
+	for _, tag := range tags {
+		if utf8.RuneCountInString(tag) > maxImportTagLength {
+			return errno.ErrInvalidArgument.WithMessage("tag must be at most %d characters", maxImportTagLength)
+		}
+	}
+	return nil
+}
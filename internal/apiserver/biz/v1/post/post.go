@@ -16,15 +16,26 @@ package post
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/jwcen/miniblog/internal/apiserver/model"
-	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/feed"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/search"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/sitemap"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
 	"github.com/jwcen/miniblog/internal/apiserver/store"
 	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+	"github.com/jwcen/miniblog/internal/pkg/fieldmask"
 	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 	"github.com/onexstack/onexstack/pkg/store/where"
+	"k8s.io/utils/ptr"
 )
 
 type PostBiz interface {
@@ -38,87 +49,478 @@ type PostBiz interface {
 }
 
 // PostExpansion 定义额外的帖子操作方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	ListTags(ctx context.Context, rq *apiv1.ListTagsRequest) (*apiv1.ListTagsResponse, error)
+	Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error)
+	Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error)
+	Archive(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error)
+	Schedule(ctx context.Context, rq *apiv1.SchedulePostRequest) (*apiv1.SchedulePostResponse, error)
+	ListRevisions(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) (*apiv1.ListPostRevisionsResponse, error)
+	GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error)
+	DiffRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) (*apiv1.DiffPostRevisionsResponse, error)
+	RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error)
+	GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error)
+	ListPublic(ctx context.Context, rq *apiv1.ListPublicPostsRequest) (*apiv1.ListPublicPostsResponse, error)
+	ListAuthorPublic(ctx context.Context, rq *apiv1.ListAuthorPublicPostsRequest) (*apiv1.ListAuthorPublicPostsResponse, error)
+	Search(ctx context.Context, rq *apiv1.SearchPostsRequest) (*apiv1.SearchPostsResponse, error)
+	GetBySlug(ctx context.Context, rq *apiv1.GetPostBySlugRequest) (*apiv1.GetPostBySlugResponse, error)
+	Feed(ctx context.Context, username string) (*feed.Feed, error)
+	Sitemap(ctx context.Context, page int) (*sitemap.Sitemap, error)
+	AddBookmark(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error)
+	RemoveBookmark(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error)
+	ListBookmarks(ctx context.Context, rq *apiv1.ListBookmarksRequest) (*apiv1.ListBookmarksResponse, error)
+	Timeline(ctx context.Context, rq *apiv1.GetTimelineRequest) (*apiv1.GetTimelineResponse, error)
+	ListTrashed(ctx context.Context, rq *apiv1.ListTrashedPostsRequest) (*apiv1.ListTrashedPostsResponse, error)
+	Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error)
+	GetStats(ctx context.Context, rq *apiv1.GetPostStatsRequest) (*apiv1.GetPostStatsResponse, error)
+	Import(ctx context.Context, rq *apiv1.ImportPostsRequest) (*apiv1.ImportPostsResponse, error)
+	Share(ctx context.Context, rq *apiv1.SharePostRequest) (*apiv1.SharePostResponse, error)
+	Unshare(ctx context.Context, rq *apiv1.UnsharePostRequest) (*apiv1.UnsharePostResponse, error)
+	AddCollaborator(ctx context.Context, rq *apiv1.AddCollaboratorRequest) (*apiv1.AddCollaboratorResponse, error)
+	RemoveCollaborator(ctx context.Context, rq *apiv1.RemoveCollaboratorRequest) (*apiv1.RemoveCollaboratorResponse, error)
+	ListCollaborators(ctx context.Context, rq *apiv1.ListCollaboratorsRequest) (*apiv1.ListCollaboratorsResponse, error)
+}
 
 type postBiz struct {
-	store store.IStore
+	store    store.IStore
+	search   search.Searcher
+	renderer *render.Renderer
+	views    *viewcount.Counter
 }
 
 var _ PostBiz = (*postBiz)(nil)
 
-func New(store store.IStore) *postBiz {
-	return &postBiz{store: store}
+func New(store store.IStore, search search.Searcher, renderer *render.Renderer, views *viewcount.Counter) *postBiz {
+	return &postBiz{store: store, search: search, renderer: renderer, views: views}
 }
 
 func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*apiv1.CreatePostResponse, error) {
 	var postM model.PostM
 	_ = copier.Copy(&postM, rq)
 	postM.UserID = contextx.UserID(ctx)
+	postM.ContentFormat = int32(rq.GetContentFormat())
+	postM.Visibility = int32(rq.GetVisibility())
+	if rq.PublishAt != nil {
+		postM.PublishAt = ptr.To(rq.GetPublishAt().AsTime())
+	}
 
 	now := time.Now()
 	postM.CreatedAt = now
 	postM.UpdatedAt = now
 
-	if err := b.store.Post().Create(ctx, &postM); err != nil {
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		postM.Slug = rq.GetSlug()
+		if rq.Slug == nil {
+			generated, err := b.generateSlug(ctx, postM.Title, "")
+			if err != nil {
+				return err
+			}
+			postM.Slug = generated
+		}
+
+		// 新文章的当前版本号为 1. 文章 ID 在创建后才生成，因此需要在创建文章后再创建第一个历史版本
+		postM.Revision = 1
+		if err := b.store.Post().Create(ctx, &postM); err != nil {
+			return err
+		}
+		if _, err := b.createRevision(ctx, &postM); err != nil {
+			return err
+		}
+		if err := b.createTags(ctx, &postM, rq.GetTags()); err != nil {
+			return err
+		}
+		return b.createShares(ctx, &postM, rq.GetSharedWith())
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	b.indexPost(ctx, &postM)
+
 	return &apiv1.CreatePostResponse{PostID: postM.PostID}, nil
 }
 
+// Update 更新文章. 文章作者和编辑者可以更新文章，但只有作者可以修改文章的可见范围.
+// 请求需要带上客户端读取到的文章版本号，文章在此之后已被修改时返回 errno.ErrVersionConflict，避免覆盖其他人的修改.
+// 指定 updateMask 时只更新其中列出的字段，列出但未设置的字段会被清空.
 func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
-	whr := where.T(ctx).F("postID", rq.GetPostID())
-	postM, err := b.store.Post().Get(ctx, whr)
+	expected, err := etag.Expected(ctx, rq.Version)
 	if err != nil {
 		return nil, err
 	}
 
-	if rq.Title != nil {
-		postM.Title = rq.GetTitle()
+	postM, isOwner, err := b.getEditable(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
 	}
-
-	if rq.Content != nil {
-		postM.Content = rq.GetContent()
+	mask := fieldmask.New(rq.GetUpdateMask())
+	if mask.Covers("visibility", rq.Visibility != nil) && !isOwner {
+		return nil, errno.ErrPostPermissionDenied
+	}
+	if expected != 0 && postM.Version != expected {
+		return nil, errno.ErrVersionConflict
 	}
 
-	if err := b.store.Post().Update(ctx, postM); err != nil {
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 早于历史版本功能创建的文章没有历史版本，更新前先保存当前版本，避免原有内容丢失
+		latest, err := b.store.PostRevision().LatestRevision(ctx, postM.PostID)
+		if err != nil {
+			return err
+		}
+		if latest == 0 {
+			if _, err := b.createRevision(ctx, postM); err != nil {
+				return err
+			}
+		}
+
+		oldTitle := postM.Title
+		if mask.Covers("title", rq.Title != nil) {
+			postM.Title = rq.GetTitle()
+		}
+		if mask.Covers("content", rq.Content != nil) {
+			postM.Content = rq.GetContent()
+		}
+		// 枚举字段只在设置时更新，避免清空时回退为零值（Markdown、公开）
+		if rq.ContentFormat != nil && mask.Covers("contentFormat", true) {
+			postM.ContentFormat = int32(rq.GetContentFormat())
+		}
+		if rq.Visibility != nil && mask.Covers("visibility", true) {
+			postM.Visibility = int32(rq.GetVisibility())
+		}
+
+		newSlug := rq.Slug
+		switch {
+		case !mask.Covers("slug", rq.Slug != nil):
+			newSlug = nil
+		case rq.Slug == nil:
+			// 清空 slug 时根据当前标题重新生成，标题无法生成 slug 时使用文章 ID
+			generated, err := b.generateSlug(ctx, postM.Title, postM.PostID)
+			if err != nil {
+				return err
+			}
+			if generated == "" {
+				generated = postM.PostID
+			}
+			newSlug = &generated
+		}
+		if err := b.updateSlug(ctx, postM, oldTitle, newSlug); err != nil {
+			return err
+		}
+
+		if _, err := b.createRevision(ctx, postM); err != nil {
+			return err
+		}
+		if err := b.store.Post().Update(ctx, postM); err != nil {
+			return err
+		}
+
+		// 未指定 updateMask 且标签列表为空时保留原有标签
+		if !mask.Covers("tags", len(rq.GetTags()) > 0) {
+			return nil
+		}
+
+		if err := b.store.PostTag().Delete(ctx, where.F("postID", postM.PostID)); err != nil {
+			return err
+		}
+		return b.createTags(ctx, postM, rq.GetTags())
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	b.indexPost(ctx, postM)
+
 	return &apiv1.UpdatePostResponse{}, nil
 }
 
+// Delete 将文章移入回收站. 文章的评论、标签、历史版本等数据保留到文章被永久删除时，以便恢复文章.
 func (b *postBiz) Delete(ctx context.Context, rq *apiv1.DeletePostRequest) (*apiv1.DeletePostResponse, error) {
-	whr := where.T(ctx).F("postID", rq.GetPostIDs())
-	if err := b.store.Post().Delete(ctx, whr); err != nil {
+	_, postList, err := b.store.Post().List(ctx, where.T(ctx).F("postID", rq.GetPostIDs()))
+	if err != nil {
 		return nil, err
 	}
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	if err := b.store.Post().Delete(ctx, where.T(ctx).F("postID", rq.GetPostIDs())); err != nil {
+		return nil, err
+	}
+
+	// 回收站中的文章不能被搜索到，恢复时重新建立索引
+	b.deleteIndex(ctx, postIDs...)
 
 	return &apiv1.DeletePostResponse{}, nil
 }
 
+// Get 获取文章. 作者本人和协作者可以获取文章，其他用户只能获取按可见范围有权查看的文章.
 func (b *postBiz) Get(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.GetPostResponse, error) {
-	whr := where.T(ctx).F("postID", rq.GetPostID())
-	postM, err := b.store.Post().Get(ctx, whr)
+	postM, err := b.getViewable(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := b.listTags(ctx, postM.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	likes, reactions, err := b.listReactions(ctx, postM.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedWith, err := b.listSharedWith(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := b.listRoles(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := b.toPost(postM)
+	if err != nil {
+		return nil, err
+	}
+	post.Tags = tags[postM.PostID]
+	post.LikeCount = likes[postM.PostID]
+	post.Reactions = reactions[postM.PostID]
+	post.SharedWith = sharedWith[postM.PostID]
+	post.Role = roles[postM.PostID]
+
+	series, err := b.seriesNavigation(ctx, postM.PostID)
 	if err != nil {
 		return nil, err
 	}
+	b.recordView(ctx, postM.PostID)
 
-	return &apiv1.GetPostResponse{Post: conversion.PostModelToPostV1(postM)}, nil
+	return &apiv1.GetPostResponse{Post: post, Series: series}, nil
 }
 
+// List 列出文章. 默认列出当前用户自己的全部文章；指定其他用户时只列出当前用户有权在列表中看到的该用户的文章，
+// 不公开列出的文章不会出现在其他用户的列表中；指定 sharedWithMe 时列出其他用户分享给当前用户的文章；
+// 指定 collaborating 时列出当前用户作为协作者参与的其他用户的文章.
 func (b *postBiz) List(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
-	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	userID := contextx.UserID(ctx)
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit()))
+	switch {
+	case rq.GetSharedWithMe():
+		visibility.SharedWith(ctx, b.store, whr, userID)
+		if rq.UserID != nil {
+			whr.F("userID", rq.GetUserID())
+		}
+	case rq.GetCollaborating():
+		visibility.Collaborating(ctx, b.store, whr, userID)
+		if rq.UserID != nil {
+			whr.F("userID", rq.GetUserID())
+		}
+	case rq.UserID != nil && rq.GetUserID() != userID:
+		visibility.ViewableBy(ctx, b.store, whr.F("userID", rq.GetUserID()), userID)
+	default:
+		whr.T(ctx)
+	}
+	b.filterByTags(ctx, whr, rq.GetTags())
+	if rq.Title != nil {
+		whr.F("title", rq.GetTitle())
+	}
+	if rq.Status != nil {
+		whr.F("status", int32(rq.GetStatus()))
+	}
+
 	count, postList, err := b.store.Post().List(ctx, whr)
 	if err != nil {
 		return nil, err
 	}
 
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	tags, err := b.listTags(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	likes, reactions, err := b.listReactions(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedWith, err := b.listSharedWith(ctx, postList...)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := b.listRoles(ctx, postList...)
+	if err != nil {
+		return nil, err
+	}
+
 	posts := make([]*apiv1.Post, 0, len(postList))
 	for _, post := range postList {
-		converted := conversion.PostModelToPostV1(post)
+		converted, err := b.toPost(post)
+		if err != nil {
+			return nil, err
+		}
+		converted.Tags = tags[post.PostID]
+		converted.LikeCount = likes[post.PostID]
+		converted.Reactions = reactions[post.PostID]
+		converted.SharedWith = sharedWith[post.PostID]
+		converted.Role = roles[post.PostID]
 		posts = append(posts, converted)
 	}
 
 	return &apiv1.ListPostResponse{TotalCount: count, Posts: posts}, nil
 }
+
+// ListTags 列出当前用户使用过的所有标签，以及每个标签下的文章数量.
+func (b *postBiz) ListTags(ctx context.Context, rq *apiv1.ListTagsRequest) (*apiv1.ListTagsResponse, error) {
+	// 回收站中的文章保留了标签记录，统计时需要排除
+	posts := b.store.DB(ctx).Model(&model.PostM{}).Select("postID")
+	counts, err := b.store.PostTag().CountByName(ctx, where.T(ctx).Q("postID IN (?)", posts))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*apiv1.Tag, 0, len(counts))
+	for _, count := range counts {
+		tags = append(tags, &apiv1.Tag{Name: count.Name, PostCount: count.PostCount})
+	}
+
+	return &apiv1.ListTagsResponse{Tags: tags}, nil
+}
+
+// Publish 发布文章，并记录发布时间.
+func (b *postBiz) Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	now := time.Now()
+	if err := b.changeStatus(ctx, rq.GetPostID(), apiv1.PostStatus_Published, &now); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PublishPostResponse{}, nil
+}
+
+// Unpublish 撤回已发布的文章，文章重新变为草稿.
+func (b *postBiz) Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	if err := b.changeStatus(ctx, rq.GetPostID(), apiv1.PostStatus_Draft, nil); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnpublishPostResponse{}, nil
+}
+
+// Archive 归档文章，归档时保留最近一次的发布时间.
+func (b *postBiz) Archive(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.changeStatus(ctx, postM.PostID, apiv1.PostStatus_Archived, postM.PublishedAt); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ArchivePostResponse{}, nil
+}
+
+// Schedule 设置或取消文章的定时发布，到期后由后台任务自动发布.
+func (b *postBiz) Schedule(ctx context.Context, rq *apiv1.SchedulePostRequest) (*apiv1.SchedulePostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	postM.PublishAt = nil
+	if rq.PublishAt != nil {
+		postM.PublishAt = ptr.To(rq.GetPublishAt().AsTime())
+	}
+
+	if err := b.store.Post().Update(ctx, postM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SchedulePostResponse{}, nil
+}
+
+// changeStatus 修改文章状态和发布时间. 状态转换的合法性由 validation 层校验.
+func (b *postBiz) changeStatus(ctx context.Context, postID string, status apiv1.PostStatus, publishedAt *time.Time) error {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", postID))
+	if err != nil {
+		return err
+	}
+
+	postM.Status = int32(status)
+	postM.PublishedAt = publishedAt
+	// 手动修改状态后，取消尚未执行的定时发布
+	postM.PublishAt = nil
+
+	return b.store.Post().Update(ctx, postM)
+}
+
+// filterByTags 为查询条件添加标签过滤，筛选出包含任意一个指定标签的文章.
+func (b *postBiz) filterByTags(ctx context.Context, whr *where.Options, tags []string) {
+	if tags = normalizeTags(tags); len(tags) > 0 {
+		subQuery := b.store.DB(ctx).Model(&model.PostTagM{}).Select("postID").Where("name IN ?", tags)
+		whr.Q("postID IN (?)", subQuery)
+	}
+}
+
+// createTags 为指定文章创建标签记录.
+func (b *postBiz) createTags(ctx context.Context, postM *model.PostM, tags []string) error {
+	for _, name := range normalizeTags(tags) {
+		tagM := model.PostTagM{
+			UserID:    postM.UserID,
+			PostID:    postM.PostID,
+			Name:      name,
+			CreatedAt: time.Now(),
+		}
+		if err := b.store.PostTag().Create(ctx, &tagM); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listTags 查询指定文章的标签，返回以 postID 为键的标签列表.
+func (b *postBiz) listTags(ctx context.Context, postIDs ...string) (map[string][]string, error) {
+	ret := make(map[string][]string, len(postIDs))
+	if len(postIDs) == 0 {
+		return ret, nil
+	}
+
+	_, tagList, err := b.store.PostTag().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tagList {
+		ret[tag.PostID] = append(ret[tag.PostID], tag.Name)
+	}
+	for _, names := range ret {
+		sort.Strings(names)
+	}
+
+	return ret, nil
+}
+
+// normalizeTags 去除标签两端空白，并过滤空标签和重复标签.
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	ret := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		ret = append(ret, tag)
+	}
+	return ret
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// GetPublic 获取已发布的文章. 公开接口不按租户过滤，但只返回已发布的公开或不公开列出的文章.
+func (b *postBiz) GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, visibility.LinkableWhere().F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPublicPosts(ctx, []*model.PostM{postM})
+	if err != nil {
+		return nil, err
+	}
+	b.recordView(ctx, postM.PostID)
+
+	return &apiv1.GetPublicPostResponse{Post: posts[0]}, nil
+}
+
+// ListPublic 列出所有已发布的公开文章.
+func (b *postBiz) ListPublic(ctx context.Context, rq *apiv1.ListPublicPostsRequest) (*apiv1.ListPublicPostsResponse, error) {
+	whr := publishedWhere().P(int(rq.GetOffset()), int(rq.GetLimit()))
+	b.filterByTags(ctx, whr, rq.GetTags())
+
+	count, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPublicPosts(ctx, postList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListPublicPostsResponse{TotalCount: count, Posts: posts}, nil
+}
+
+// ListAuthorPublic 列出指定作者已发布的公开文章.
+func (b *postBiz) ListAuthorPublic(ctx context.Context, rq *apiv1.ListAuthorPublicPostsRequest) (*apiv1.ListAuthorPublicPostsResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("username", rq.GetUsername()))
+	if err != nil {
+		return nil, err
+	}
+
+	whr := publishedWhere().P(int(rq.GetOffset()), int(rq.GetLimit())).F("userID", userM.UserID)
+	count, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPublicPosts(ctx, postList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListAuthorPublicPostsResponse{
+		Author:     conversion.UserModelToPublicAuthorV1(userM),
+		TotalCount: count,
+		Posts:      posts,
+	}, nil
+}
+
+// toPublicPosts 将文章列表转换为公开文章列表，并附带作者信息、标签和回应计数.
+func (b *postBiz) toPublicPosts(ctx context.Context, postList []*model.PostM) ([]*apiv1.PublicPost, error) {
+	postIDs := make([]string, 0, len(postList))
+	userIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+		userIDs = append(userIDs, post.UserID)
+	}
+
+	tags, err := b.listTags(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	likes, reactions, err := b.listReactions(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	authors := make(map[string]*model.UserM, len(userIDs))
+	if len(userIDs) > 0 {
+		_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range userList {
+			authors[user.UserID] = user
+		}
+	}
+
+	posts := make([]*apiv1.PublicPost, 0, len(postList))
+	for _, post := range postList {
+		converted, err := b.toPublicPost(post, authors[post.UserID])
+		if err != nil {
+			return nil, err
+		}
+		converted.Tags = tags[post.PostID]
+		converted.LikeCount = likes[post.PostID]
+		converted.Reactions = reactions[post.PostID]
+		posts = append(posts, converted)
+	}
+
+	return posts, nil
+}
+
+// publishedWhere 返回只匹配可以出现在列表中的文章的查询条件，即已发布的公开文章.
+func publishedWhere() *where.Options {
+	return visibility.ListedWhere()
+}
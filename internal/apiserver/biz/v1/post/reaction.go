@@ -0,0 +1,53 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/pkg/known"
+)
+
+// listReactions 查询指定文章的回应计数，分别返回以 postID 为键的点赞数和各表情的回应数.
+// 计数由点赞和回应接口在事务中维护，这里只读取计数表，不需要统计回应记录.
+func (b *postBiz) listReactions(ctx context.Context, postIDs ...string) (map[string]int64, map[string]map[string]int64, error) {
+	likes := make(map[string]int64, len(postIDs))
+	reactions := make(map[string]map[string]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return likes, reactions, nil
+	}
+
+	_, countList, err := b.store.PostReactionCount().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, count := range countList {
+		switch {
+		case count.Total <= 0:
+		case count.Reaction == known.ReactionLike:
+			likes[count.PostID] = count.Total
+		default:
+			if reactions[count.PostID] == nil {
+				reactions[count.PostID] = make(map[string]int64)
+			}
+			reactions[count.PostID][count.Reaction] = count.Total
+		}
+	}
+
+	return likes, reactions, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// toPost 将文章转换为 apiv1.Post，并填充渲染后的 HTML、目录和摘要.
+// 渲染结果按文章版本缓存，因此列表接口不会重复渲染未变更的文章.
+func (b *postBiz) toPost(postM *model.PostM) (*apiv1.Post, error) {
+	out, err := b.renderer.RenderPost(postM)
+	if err != nil {
+		return nil, err
+	}
+
+	post := conversion.PostModelToPostV1(postM)
+	post.ContentHtml = out.HTML
+	post.Toc = toTocEntries(out.TOC)
+	post.Excerpt = out.Excerpt
+	return post, nil
+}
+
+// toPublicPost 将文章转换为 apiv1.PublicPost，并填充渲染后的 HTML、目录和摘要.
+func (b *postBiz) toPublicPost(postM *model.PostM, author *model.UserM) (*apiv1.PublicPost, error) {
+	out, err := b.renderer.RenderPost(postM)
+	if err != nil {
+		return nil, err
+	}
+
+	post := conversion.PostModelToPublicPostV1(postM, author)
+	post.ContentHtml = out.HTML
+	post.Toc = toTocEntries(out.TOC)
+	post.Excerpt = out.Excerpt
+	return post, nil
+}
+
+// toTocEntries 将渲染生成的目录转换为 apiv1.TocEntry 列表.
+func toTocEntries(toc []*render.TOCEntry) []*apiv1.TocEntry {
+	entries := make([]*apiv1.TocEntry, 0, len(toc))
+	for _, entry := range toc {
+		entries = append(entries, &apiv1.TocEntry{Level: int32(entry.Level), Anchor: entry.Anchor, Title: entry.Title})
+	}
+	return entries
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ListRevisions 列出文章的历史版本，按版本号倒序排列. 作者和协作者都可以查看文章的历史版本.
+func (b *postBiz) ListRevisions(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) (*apiv1.ListPostRevisionsResponse, error) {
+	if _, _, err := b.getCollaborative(ctx, rq.GetPostID()); err != nil {
+		return nil, err
+	}
+
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit())).F("postID", rq.GetPostID())
+	count, revisionList, err := b.store.PostRevision().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*apiv1.PostRevision, 0, len(revisionList))
+	for _, revision := range revisionList {
+		revisions = append(revisions, conversion.PostRevisionModelToPostRevisionV1(revision))
+	}
+
+	return &apiv1.ListPostRevisionsResponse{TotalCount: count, Revisions: revisions}, nil
+}
+
+// GetRevision 获取文章的指定历史版本.
+func (b *postBiz) GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	revisionM, err := b.getRevision(ctx, rq.GetPostID(), rq.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPostRevisionResponse{Revision: conversion.PostRevisionModelToPostRevisionV1(revisionM)}, nil
+}
+
+// DiffRevisions 比较文章的两个历史版本，分别返回标题和内容的 unified diff.
+func (b *postBiz) DiffRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) (*apiv1.DiffPostRevisionsResponse, error) {
+	from, err := b.getRevision(ctx, rq.GetPostID(), rq.GetFromRevision())
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.getRevision(ctx, rq.GetPostID(), rq.GetToRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	titleDiff, err := diffText(from.Title, to.Title, from.Revision, to.Revision)
+	if err != nil {
+		return nil, err
+	}
+	contentDiff, err := diffText(from.Content, to.Content, from.Revision, to.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DiffPostRevisionsResponse{TitleDiff: titleDiff, ContentDiff: contentDiff}, nil
+}
+
+// RestoreRevision 将文章的标题、内容和内容格式回滚到指定历史版本. 作者和编辑者可以回滚文章.
+// 回滚本身也是一次更新，会生成一个新的历史版本，因此回滚操作同样可以撤销.
+func (b *postBiz) RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	postM, _, err := b.getEditable(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+
+	revisionM, err := b.store.PostRevision().Get(ctx, where.F("postID", postM.PostID, "revision", rq.GetRevision()))
+	if err != nil {
+		return nil, err
+	}
+
+	oldTitle := postM.Title
+	postM.Title = revisionM.Title
+	postM.Content = revisionM.Content
+	postM.ContentFormat = revisionM.ContentFormat
+
+	var revision int64
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.updateSlug(ctx, postM, oldTitle, nil); err != nil {
+			return err
+		}
+
+		if revision, err = b.createRevision(ctx, postM); err != nil {
+			return err
+		}
+		return b.store.Post().Update(ctx, postM)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.indexPost(ctx, postM)
+
+	return &apiv1.RestorePostRevisionResponse{Revision: revision}, nil
+}
+
+// getRevision 获取当前用户作为作者或协作者的博客的指定历史版本.
+func (b *postBiz) getRevision(ctx context.Context, postID string, revision int64) (*model.PostRevisionM, error) {
+	if _, _, err := b.getCollaborative(ctx, postID); err != nil {
+		return nil, err
+	}
+	return b.store.PostRevision().Get(ctx, where.F("postID", postID, "revision", revision))
+}
+
+// createRevision 将文章的当前标题和内容保存为一个新的历史版本，并将文章的当前版本号设置为新版本号.
+// 调用方负责保存文章. 需要在事务中调用，以保证文章更新和历史版本的写入同时成功或失败.
+func (b *postBiz) createRevision(ctx context.Context, postM *model.PostM) (int64, error) {
+	latest, err := b.store.PostRevision().LatestRevision(ctx, postM.PostID)
+	if err != nil {
+		return 0, err
+	}
+
+	revisionM := model.PostRevisionM{
+		UserID:        postM.UserID,
+		PostID:        postM.PostID,
+		Revision:      latest + 1,
+		Title:         postM.Title,
+		Content:       postM.Content,
+		ContentFormat: postM.ContentFormat,
+		CreatedAt:     time.Now(),
+	}
+	if err := b.store.PostRevision().Create(ctx, &revisionM); err != nil {
+		return 0, err
+	}
+
+	postM.Revision = revisionM.Revision
+	return revisionM.Revision, nil
+}
+
+// diffText 生成两段文本之间的 unified diff，文本相同时返回空字符串.
+func diffText(from, to string, fromRevision, toRevision int64) (string, error) {
+	if from == to {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fmt.Sprintf("revision %d", fromRevision),
+		ToFile:   fmt.Sprintf("revision %d", toRevision),
+		Context:  3,
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/search"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// Search 在当前用户的文章中全文检索，结果按相关度倒序排列.
+func (b *postBiz) Search(ctx context.Context, rq *apiv1.SearchPostsRequest) (*apiv1.SearchPostsResponse, error) {
+	ret, err := b.search.Search(ctx, &search.Query{
+		UserID: contextx.UserID(ctx),
+		Text:   rq.GetQuery(),
+		Offset: int(rq.GetOffset()),
+		Limit:  int(rq.GetLimit()),
+	})
+	if err != nil {
+		log.W(ctx).Errorw("Failed to search posts", "query", rq.GetQuery(), "err", err)
+		return nil, err
+	}
+
+	postIDs := make([]string, 0, len(ret.Hits))
+	for _, hit := range ret.Hits {
+		postIDs = append(postIDs, hit.PostID)
+	}
+
+	posts := make(map[string]*model.PostM, len(postIDs))
+	if len(postIDs) > 0 {
+		_, postList, err := b.store.Post().List(ctx, where.T(ctx).F("postID", postIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range postList {
+			posts[post.PostID] = post
+		}
+	}
+
+	tags, err := b.listTags(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	likes, reactions, err := b.listReactions(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*apiv1.PostSearchHit, 0, len(ret.Hits))
+	for _, hit := range ret.Hits {
+		// 索引与数据库之间可能存在短暂的不一致，跳过已不存在的文章
+		postM, ok := posts[hit.PostID]
+		if !ok {
+			continue
+		}
+
+		post, err := b.toPost(postM)
+		if err != nil {
+			return nil, err
+		}
+		post.Tags = tags[postM.PostID]
+		post.LikeCount = likes[postM.PostID]
+		post.Reactions = reactions[postM.PostID]
+		hits = append(hits, &apiv1.PostSearchHit{
+			Post:           post,
+			Score:          hit.Score,
+			TitleSnippet:   hit.TitleSnippet,
+			ContentSnippet: hit.ContentSnippet,
+		})
+	}
+
+	return &apiv1.SearchPostsResponse{TotalCount: ret.Total, Hits: hits}, nil
+}
+
+// indexPost 更新文章的全文索引. 文章已成功写入数据库，索引更新失败只记录日志，不影响请求结果.
+func (b *postBiz) indexPost(ctx context.Context, postM *model.PostM) {
+	if err := b.search.Index(ctx, postM); err != nil {
+		log.W(ctx).Errorw("Failed to index post", "postID", postM.PostID, "err", err)
+	}
+}
+
+// deleteIndex 删除文章的全文索引. 索引删除失败只记录日志，不影响请求结果.
+func (b *postBiz) deleteIndex(ctx context.Context, postIDs ...string) {
+	if len(postIDs) == 0 {
+		return
+	}
+	if err := b.search.Delete(ctx, postIDs...); err != nil {
+		log.W(ctx).Errorw("Failed to delete post index", "postIDs", postIDs, "err", err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// seriesNavigation 返回文章在所属系列中的位置和前后相邻的文章，文章不属于任何系列时返回 nil.
+// 其他用户查看时，位置和前后相邻的文章只按该用户有权查看的文章计算.
+func (b *postBiz) seriesNavigation(ctx context.Context, postID string) (*apiv1.SeriesNavigation, error) {
+	memberM, err := b.store.SeriesPost().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	seriesM, err := b.store.Series().Get(ctx, where.F("seriesID", memberM.SeriesID))
+	if err != nil {
+		return nil, err
+	}
+
+	postList, err := b.store.SeriesPost().ListPosts(ctx, seriesM.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	if postList, err = b.filterViewable(ctx, postList); err != nil {
+		return nil, err
+	}
+
+	nav := &apiv1.SeriesNavigation{
+		SeriesID: seriesM.SeriesID,
+		Title:    seriesM.Title,
+		Total:    int64(len(postList)),
+	}
+	for i, post := range postList {
+		if post.PostID != postID {
+			continue
+		}
+		nav.Position = int64(i + 1)
+		if i > 0 {
+			nav.Previous = conversion.PostModelToSeriesPostV1(postList[i-1], int64(i))
+		}
+		if i+1 < len(postList) {
+			nav.Next = conversion.PostModelToSeriesPostV1(postList[i+1], int64(i+2))
+		}
+	}
+	return nav, nil
+}
+
+// filterViewable 过滤掉当前用户无权查看的文章.
+func (b *postBiz) filterViewable(ctx context.Context, postList []*model.PostM) ([]*model.PostM, error) {
+	userID := contextx.UserID(ctx)
+	ret := make([]*model.PostM, 0, len(postList))
+	for _, post := range postList {
+		visible, err := visibility.Check(ctx, b.store, post, userID)
+		if err != nil {
+			return nil, err
+		}
+		if visible {
+			ret = append(ret, post)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// Share 将当前用户的文章分享给指定用户. 重复分享时保留最初的分享时间.
+// 分享记录与文章的可见范围相互独立，只有文章的可见范围为 Shared 时，被分享的用户才能查看文章.
+func (b *postBiz) Share(ctx context.Context, rq *apiv1.SharePostRequest) (*apiv1.SharePostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.createShares(ctx, postM, []string{rq.GetUserID()}); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SharePostResponse{}, nil
+}
+
+// Unshare 取消将当前用户的文章分享给指定用户.
+func (b *postBiz) Unshare(ctx context.Context, rq *apiv1.UnsharePostRequest) (*apiv1.UnsharePostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.store.PostShare().Delete(ctx, where.F("postID", postM.PostID, "userID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnsharePostResponse{}, nil
+}
+
+// createShares 将文章分享给指定的用户列表，忽略重复的用户.
+func (b *postBiz) createShares(ctx context.Context, postM *model.PostM, userIDs []string) error {
+	now := time.Now()
+	shareList := make([]*model.PostShareM, 0, len(userIDs))
+	seen := make(map[string]struct{}, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+		shareList = append(shareList, &model.PostShareM{PostID: postM.PostID, UserID: userID, CreatedAt: now})
+	}
+	return b.store.PostShare().Add(ctx, shareList...)
+}
+
+// getViewable 获取当前用户可以查看的文章. 文章不存在与无权查看返回相同的错误，避免泄露文章的存在.
+func (b *postBiz) getViewable(ctx context.Context, postID string) (*model.PostM, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	visible, err := visibility.Check(ctx, b.store, postM, contextx.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, errno.ErrPostNotFound
+	}
+	return postM, nil
+}
+
+// listSharedWith 查询当前用户自己的文章被分享给的用户，其他用户的文章不返回分享记录.
+func (b *postBiz) listSharedWith(ctx context.Context, postList ...*model.PostM) (map[string][]string, error) {
+	userID := contextx.UserID(ctx)
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		if post.UserID == userID {
+			postIDs = append(postIDs, post.PostID)
+		}
+	}
+	return b.store.PostShare().UserIDs(ctx, postIDs)
+}
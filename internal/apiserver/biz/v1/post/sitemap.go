@@ -0,0 +1,77 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/sitemap"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+)
+
+// Sitemap 生成站点地图，包含已发布文章的地址和有已发布文章的作者的公开主页.
+// 文章排在作者主页之前，两者按 id 升序排列，并按 sitemap.MaxURLs 分页.
+// page 为 0 且地址总数超过单页上限时，只返回页数，由调用方输出站点地图索引.
+func (b *postBiz) Sitemap(ctx context.Context, page int) (*sitemap.Sitemap, error) {
+	postCount, _, err := b.store.Post().ListPublished(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	authorCount, _, err := b.store.User().ListAuthors(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int(postCount + authorCount)
+	pages := max(1, (total+sitemap.MaxURLs-1)/sitemap.MaxURLs)
+	if page == 0 && pages > 1 {
+		return &sitemap.Sitemap{Pages: pages}, nil
+	}
+	if page > pages {
+		return nil, errno.ErrPageNotFound
+	}
+	page = max(page, 1)
+
+	ret := &sitemap.Sitemap{Pages: pages, Page: page, URLs: make([]*sitemap.URL, 0, sitemap.MaxURLs)}
+	offset := (page - 1) * sitemap.MaxURLs
+	if offset < int(postCount) {
+		_, postList, err := b.store.Post().ListPublished(ctx, offset, sitemap.MaxURLs)
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range postList {
+			ret.URLs = append(ret.URLs, &sitemap.URL{
+				Path:    "/v1/public/posts/slug/" + url.PathEscape(post.Slug),
+				LastMod: post.UpdatedAt,
+			})
+		}
+	}
+
+	if remaining := sitemap.MaxURLs - len(ret.URLs); remaining > 0 && authorCount > 0 {
+		_, userList, err := b.store.User().ListAuthors(ctx, max(0, offset-int(postCount)), remaining)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range userList {
+			ret.URLs = append(ret.URLs, &sitemap.URL{
+				Path:    "/v1/public/users/" + url.PathEscape(user.Username) + "/posts",
+				LastMod: user.UpdatedAt,
+			})
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/slug"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// GetBySlug 通过 slug 获取已发布的公开或不公开列出的文章. 请求的是文章曾经使用过的 slug 时，同时返回文章当前的 slug，便于调用方重定向.
+func (b *postBiz) GetBySlug(ctx context.Context, rq *apiv1.GetPostBySlugRequest) (*apiv1.GetPostBySlugResponse, error) {
+	postM, err := b.store.Post().Get(ctx, visibility.LinkableWhere().F("slug", rq.GetSlug()))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var redirectSlug string
+	if postM == nil {
+		postM, err = b.getByOldSlug(ctx, rq.GetSlug())
+		if err != nil {
+			return nil, err
+		}
+		redirectSlug = postM.Slug
+	}
+
+	posts, err := b.toPublicPosts(ctx, []*model.PostM{postM})
+	if err != nil {
+		return nil, err
+	}
+	b.recordView(ctx, postM.PostID)
+
+	return &apiv1.GetPostBySlugResponse{Post: posts[0], RedirectSlug: redirectSlug}, nil
+}
+
+// getByOldSlug 通过文章曾经使用过的 slug 获取已发布的文章.
+func (b *postBiz) getByOldSlug(ctx context.Context, oldSlug string) (*model.PostM, error) {
+	slugM, err := b.store.PostSlug().Get(ctx, where.F("slug", oldSlug))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	postM, err := b.store.Post().Get(ctx, visibility.LinkableWhere().F("postID", slugM.PostID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+	return postM, nil
+}
+
+// generateSlug 根据标题生成未被其它文章占用的 slug，冲突时依次追加 -2、-3 等数字后缀.
+// 标题无法生成 slug 时返回空字符串.
+func (b *postBiz) generateSlug(ctx context.Context, title string, postID string) (string, error) {
+	base := slug.Make(title)
+	if base == "" {
+		return "", nil
+	}
+
+	candidate := base
+	for n := 2; ; n++ {
+		exists, err := b.store.Post().SlugExists(ctx, candidate, postID)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+		candidate = slug.WithSuffix(base, n)
+	}
+}
+
+// updateSlug 在文章标题或 slug 变更时更新文章的 slug，并保留旧的 slug 用于重定向.
+// 指定了 newSlug 时直接使用，否则在标题变更时根据新标题重新生成. 需要在事务中调用.
+func (b *postBiz) updateSlug(ctx context.Context, postM *model.PostM, oldTitle string, newSlug *string) error {
+	target := postM.Slug
+	switch {
+	case newSlug != nil:
+		target = *newSlug
+	case postM.Title != oldTitle:
+		generated, err := b.generateSlug(ctx, postM.Title, postM.PostID)
+		if err != nil {
+			return err
+		}
+		if generated != "" {
+			target = generated
+		}
+	}
+
+	if target == postM.Slug {
+		return nil
+	}
+
+	// 文章重新使用自己曾经使用过的 slug 时，该 slug 不再需要重定向
+	if err := b.store.PostSlug().Delete(ctx, where.F("postID", postM.PostID, "slug", target)); err != nil {
+		return err
+	}
+	if postM.Slug != "" {
+		slugM := model.PostSlugM{PostID: postM.PostID, Slug: postM.Slug, CreatedAt: time.Now()}
+		if err := b.store.PostSlug().Create(ctx, &slugM); err != nil {
+			return err
+		}
+	}
+
+	postM.Slug = target
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/hll"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// GetStats 获取博文在指定日期范围内每天的浏览次数和独立访客数.
+// 浏览统计先缓冲在内存中再定期写入数据库，尚未写入的浏览不会出现在结果中.
+func (b *postBiz) GetStats(ctx context.Context, rq *apiv1.GetPostStatsRequest) (*apiv1.GetPostStatsResponse, error) {
+	start, end, err := viewcount.ParseRange(rq.StartDate, rq.EndDate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	startDay, endDay := start.Format(viewcount.DayLayout), end.Format(viewcount.DayLayout)
+
+	whr := where.F("postID", rq.GetPostID()).Q("day >= ? AND day <= ?", startDay, endDay)
+	_, viewList, err := b.store.PostView().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &apiv1.GetPostStatsResponse{StartDate: startDay, EndDate: endDay}
+	visitors := hll.New()
+	days := make(map[string]*apiv1.PostDailyStats, len(viewList))
+	for _, view := range viewList {
+		days[view.Day] = &apiv1.PostDailyStats{Date: view.Day, Views: view.Views, Visitors: view.Visitors}
+		resp.TotalViews += view.Views
+
+		// 合并每天的访客草图，同一访客在多天内的浏览只计一次
+		sketch, err := hll.FromBytes(view.VisitorSketch)
+		if err != nil {
+			log.W(ctx).Warnw("Ignoring invalid visitor sketch", "postID", view.PostID, "day", view.Day, "err", err)
+			continue
+		}
+		visitors.Merge(sketch)
+	}
+	resp.TotalVisitors = int64(visitors.Estimate())
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(viewcount.DayLayout)
+		if stats, ok := days[date]; ok {
+			resp.Days = append(resp.Days, stats)
+			continue
+		}
+		resp.Days = append(resp.Days, &apiv1.PostDailyStats{Date: date})
+	}
+
+	return resp, nil
+}
+
+// recordView 记录一次博文浏览. 登录用户按用户 ID 区分访客，匿名用户按客户端 IP 区分访客.
+func (b *postBiz) recordView(ctx context.Context, postID string) {
+	var visitor string
+	if userID := contextx.UserID(ctx); userID != "" {
+		visitor = "user:" + userID
+	} else if clientIP := contextx.ClientIP(ctx); clientIP != "" {
+		visitor = "ip:" + clientIP
+	}
+	b.views.Record(postID, visitor)
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/cursor"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// defaultTimelineLimit 定义未指定每页数量时，时间线每页返回的文章数量.
+const defaultTimelineLimit = 20
+
+// Timeline 按发布时间倒序列出当前用户关注的作者已发布的文章.
+// 发布时间可能相同，因此游标同时记录发布时间和 id，并按 (publishedAt, id) 倒序分页.
+func (b *postBiz) Timeline(ctx context.Context, rq *apiv1.GetTimelineRequest) (*apiv1.GetTimelineResponse, error) {
+	limit := int(rq.GetLimit())
+	if limit == 0 {
+		limit = defaultTimelineLimit
+	}
+
+	// 游标的合法性已由 validation 层校验
+	publishedAt, after, _ := cursor.DecodeAt(rq.GetCursor())
+
+	followees := b.store.DB(ctx).Model(&model.FollowM{}).Select("followeeID").Where("followerID = ?", contextx.UserID(ctx))
+	whr := publishedWhere().L(limit+1).Q("userID IN (?)", followees).C(clause.OrderBy{
+		Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "publishedAt"}, Desc: true}},
+	})
+	if after > 0 {
+		whr.Q("(publishedAt < ? OR (publishedAt = ? AND id < ?))", publishedAt, publishedAt, after)
+	}
+
+	_, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(postList) > limit {
+		postList = postList[:limit]
+		last := postList[limit-1]
+		nextCursor = cursor.EncodeAt(*last.PublishedAt, last.ID)
+	}
+
+	posts, err := b.toPublicPosts(ctx, postList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetTimelineResponse{Posts: posts, NextCursor: nextCursor}, nil
+}
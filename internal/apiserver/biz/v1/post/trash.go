@@ -0,0 +1,74 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ListTrashed 列出当前用户回收站中的文章. 回收站中的文章会在保留期过后由后台任务永久删除.
+func (b *postBiz) ListTrashed(ctx context.Context, rq *apiv1.ListTrashedPostsRequest) (*apiv1.ListTrashedPostsResponse, error) {
+	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	count, postList, err := b.store.Post().ListTrashed(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	tags, err := b.listTags(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range postList {
+		converted, err := b.toPost(post)
+		if err != nil {
+			return nil, err
+		}
+		converted.Tags = tags[post.PostID]
+		posts = append(posts, converted)
+	}
+
+	return &apiv1.ListTrashedPostsResponse{TotalCount: count, Posts: posts}, nil
+}
+
+// Restore 将文章从回收站中恢复，文章恢复为删除前的状态.
+func (b *postBiz) Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	restored, err := b.store.Post().Restore(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+	if restored == 0 {
+		return nil, errno.ErrPostNotFound
+	}
+
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+	b.indexPost(ctx, postM)
+
+	return &apiv1.RestorePostResponse{}, nil
+}
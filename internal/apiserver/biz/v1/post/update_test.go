@@ -0,0 +1,130 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestPostBiz_UpdateMask(t *testing.T) {
+	original := &apiv1.CreatePostRequest{
+		Title:         "Original Title",
+		Content:       "<p>original</p>",
+		Tags:          []string{"go", "db"},
+		Slug:          ptr.To("custom-slug"),
+		ContentFormat: apiv1.ContentFormat_HTML,
+		Visibility:    apiv1.PostVisibility_Private,
+	}
+
+	tests := []struct {
+		name  string
+		rq    *apiv1.UpdatePostRequest
+		check func(t *testing.T, post *apiv1.Post)
+	}{
+		{
+			name: "without mask updates set fields only",
+			rq:   &apiv1.UpdatePostRequest{Title: ptr.To("New Title")},
+			check: func(t *testing.T, post *apiv1.Post) {
+				assert.Equal(t, "New Title", post.GetTitle())
+				assert.Equal(t, "new-title", post.GetSlug())
+				assert.Equal(t, original.Content, post.GetContent())
+				assert.ElementsMatch(t, original.Tags, post.GetTags())
+				assert.Equal(t, original.ContentFormat, post.GetContentFormat())
+				assert.Equal(t, original.Visibility, post.GetVisibility())
+			},
+		},
+		{
+			name: "set fields not in mask are ignored",
+			rq: &apiv1.UpdatePostRequest{
+				Title:      ptr.To("New Title"),
+				Content:    ptr.To("new content"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+			},
+			check: func(t *testing.T, post *apiv1.Post) {
+				assert.Equal(t, original.Title, post.GetTitle())
+				assert.Equal(t, original.GetSlug(), post.GetSlug())
+				assert.Equal(t, "new content", post.GetContent())
+			},
+		},
+		{
+			name: "unset tags in mask are cleared",
+			rq:   &apiv1.UpdatePostRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}},
+			check: func(t *testing.T, post *apiv1.Post) {
+				assert.Empty(t, post.GetTags())
+				assert.Equal(t, original.Title, post.GetTitle())
+			},
+		},
+		{
+			name: "unset enums in mask keep current values",
+			rq:   &apiv1.UpdatePostRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content_format", "visibility"}}},
+			check: func(t *testing.T, post *apiv1.Post) {
+				assert.Equal(t, original.ContentFormat, post.GetContentFormat())
+				assert.Equal(t, apiv1.PostVisibility_Private, post.GetVisibility())
+				assert.ElementsMatch(t, original.Tags, post.GetTags())
+			},
+		},
+		{
+			name: "unset slug in mask is regenerated from title",
+			rq:   &apiv1.UpdatePostRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"slug"}}},
+			check: func(t *testing.T, post *apiv1.Post) {
+				assert.Equal(t, "original-title", post.GetSlug())
+				assert.Equal(t, original.Title, post.GetTitle())
+			},
+		},
+		{
+			name: "set fields in mask are updated",
+			rq: &apiv1.UpdatePostRequest{
+				Title:      ptr.To("New Title"),
+				Slug:       ptr.To("another-slug"),
+				Tags:       []string{"rust"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "slug", "tags"}},
+			},
+			check: func(t *testing.T, post *apiv1.Post) {
+				assert.Equal(t, "New Title", post.GetTitle())
+				assert.Equal(t, "another-slug", post.GetSlug())
+				assert.Equal(t, []string{"rust"}, post.GetTags())
+				assert.Equal(t, original.Content, post.GetContent())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := newTestBiz(t)
+			ctx := contextx.WithIfMatch(contextx.WithUserID(context.Background(), ownerID), etag.Any)
+
+			rsp, err := b.Create(ctx, original)
+			require.NoError(t, err)
+
+			tt.rq.PostID = rsp.GetPostID()
+			_, err = b.Update(ctx, tt.rq)
+			require.NoError(t, err)
+
+			resp, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: rsp.GetPostID()})
+			require.NoError(t, err)
+			tt.check(t, resp.GetPost())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestPostBiz_UpdateVersion(t *testing.T) {
+	b, _ := newTestBiz(t)
+	ownerCtx := contextx.WithUserID(context.Background(), ownerID)
+
+	rsp, err := b.Create(ownerCtx, &apiv1.CreatePostRequest{Title: "title", Content: "content"})
+	require.NoError(t, err)
+	get := func() *apiv1.Post {
+		resp, err := b.Get(ownerCtx, &apiv1.GetPostRequest{PostID: rsp.GetPostID()})
+		require.NoError(t, err)
+		return resp.GetPost()
+	}
+	require.Equal(t, int64(1), get().GetVersion())
+
+	// 先更新一次，使过期版本号的用例可以使用大于 0 的版本号
+	_, err = b.Update(contextx.WithIfMatch(ownerCtx, etag.Any), &apiv1.UpdatePostRequest{PostID: rsp.GetPostID(), Title: ptr.To("title")})
+	require.NoError(t, err)
+	version := get().GetVersion()
+	require.Equal(t, int64(2), version)
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		version *int64
+		wantErr error
+	}{
+		{name: "missing version", wantErr: errno.ErrInvalidArgument},
+		{name: "stale version", version: ptr.To(version - 1), wantErr: errno.ErrVersionConflict},
+		{name: "stale If-Match", ifMatch: etag.Format(version - 1), wantErr: errno.ErrVersionConflict},
+		{name: "invalid If-Match", ifMatch: "abc", wantErr: errno.ErrInvalidArgument},
+		{name: "current version", version: ptr.To(version)},
+		{name: "current If-Match", ifMatch: etag.Format(version + 1)},
+		{name: "version ignores If-Match", ifMatch: etag.Format(version), version: ptr.To(version + 2)},
+		{name: "If-Match matches any version", ifMatch: etag.Any},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ownerCtx
+			if tt.ifMatch != "" {
+				ctx = contextx.WithIfMatch(ctx, tt.ifMatch)
+			}
+
+			before := get()
+			_, err := b.Update(ctx, &apiv1.UpdatePostRequest{PostID: before.GetPostID(), Title: ptr.To(tt.name), Version: tt.version})
+			after := get()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, before.GetTitle(), after.GetTitle())
+				assert.Equal(t, before.GetVersion(), after.GetVersion())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.name, after.GetTitle())
+			assert.Equal(t, before.GetVersion()+1, after.GetVersion())
+		})
+	}
+}
+
+func TestPostBiz_UpdateConcurrentModification(t *testing.T) {
+	b, s := newTestBiz(t)
+	ownerCtx := contextx.WithUserID(context.Background(), ownerID)
+
+	postM := model.PostM{UserID: ownerID, Title: "title", Content: "content"}
+	require.NoError(t, s.Post().Create(context.Background(), &postM))
+	require.Equal(t, int64(1), postM.Version)
+
+	// 其他修改博文的操作同样会递增版本号，基于旧版本号的更新会失败
+	_, err := b.Publish(ownerCtx, &apiv1.PublishPostRequest{PostID: postM.PostID})
+	require.NoError(t, err)
+	_, err = b.Update(ownerCtx, &apiv1.UpdatePostRequest{PostID: postM.PostID, Title: ptr.To("stale"), Version: ptr.To(postM.Version)})
+	assert.ErrorIs(t, err, errno.ErrVersionConflict)
+
+	// 两个请求读取到同一版本后先后保存，后保存的请求不会覆盖先保存的修改
+	first, err := s.Post().Get(context.Background(), where.F("postID", postM.PostID))
+	require.NoError(t, err)
+	second, err := s.Post().Get(context.Background(), where.F("postID", postM.PostID))
+	require.NoError(t, err)
+
+	first.Title = "first"
+	require.NoError(t, s.Post().Update(context.Background(), first))
+	second.Title = "second"
+	assert.ErrorIs(t, s.Post().Update(context.Background(), second), errno.ErrVersionConflict)
+	// 更新失败时保留读取到的版本号
+	assert.Equal(t, first.Version-1, second.Version)
+
+	got, err := s.Post().Get(context.Background(), where.F("postID", postM.PostID))
+	require.NoError(t, err)
+	assert.Equal(t, "first", got.Title)
+	assert.Equal(t, int32(apiv1.PostStatus_Published), got.Status)
+	assert.Equal(t, first.Version, got.Version)
+}
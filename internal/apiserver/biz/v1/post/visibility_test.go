@@ -0,0 +1,352 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package post
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/search"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	ownerID   = "user-owner"
+	granteeID = "user-grantee"
+	otherID   = "user-other"
+)
+
+var (
+	dbOnce     sync.Once
+	testDB     *gorm.DB
+	testModels = []any{
+		&model.PostM{},
+		&model.PostTagM{},
+		&model.PostRevisionM{},
+		&model.PostSlugM{},
+		&model.PostCollaboratorM{},
+		&model.PostShareM{},
+		&model.PostReactionCountM{},
+		&model.SeriesPostM{},
+		&model.UserM{},
+	}
+)
+
+// visibilityCases 列出可见范围和文章状态的全部组合，以及作者、被分享的用户和其他用户能否查看.
+// 每篇文章都分享给了 granteeID，以验证分享记录只对 Shared 文章生效.
+var visibilityCases = []struct {
+	visibility apiv1.PostVisibility
+	status     apiv1.PostStatus
+	owner      bool
+	grantee    bool
+	other      bool
+}{
+	{apiv1.PostVisibility_Public, apiv1.PostStatus_Draft, true, false, false},
+	{apiv1.PostVisibility_Public, apiv1.PostStatus_Published, true, true, true},
+	{apiv1.PostVisibility_Public, apiv1.PostStatus_Archived, true, false, false},
+	{apiv1.PostVisibility_Unlisted, apiv1.PostStatus_Draft, true, false, false},
+	{apiv1.PostVisibility_Unlisted, apiv1.PostStatus_Published, true, true, true},
+	{apiv1.PostVisibility_Unlisted, apiv1.PostStatus_Archived, true, false, false},
+	{apiv1.PostVisibility_Private, apiv1.PostStatus_Draft, true, false, false},
+	{apiv1.PostVisibility_Private, apiv1.PostStatus_Published, true, false, false},
+	{apiv1.PostVisibility_Private, apiv1.PostStatus_Archived, true, false, false},
+	{apiv1.PostVisibility_Shared, apiv1.PostStatus_Draft, true, true, false},
+	{apiv1.PostVisibility_Shared, apiv1.PostStatus_Published, true, true, false},
+	{apiv1.PostVisibility_Shared, apiv1.PostStatus_Archived, true, true, false},
+}
+
+// newTestBiz 返回基于 SQLite 内存数据库的 postBiz，并清空 testModels 中的全部数据.
+func newTestBiz(t *testing.T) (*postBiz, store.IStore) {
+	t.Helper()
+
+	dbOnce.Do(func() {
+		db, err := gorm.Open(sqlite.Open("file:post?mode=memory&cache=shared"), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(testModels...))
+		where.RegisterTenant("userID", contextx.UserID)
+		testDB = db
+	})
+	for _, m := range testModels {
+		require.NoError(t, testDB.Unscoped().Where("1 = 1").Delete(m).Error)
+	}
+
+	searcher, err := search.NewBleveSearcher()
+	require.NoError(t, err)
+
+	// store.NewStore 返回全局唯一的实例，测试之间通过清空数据隔离
+	s := store.NewStore(testDB)
+	return New(s, searcher, render.NewRenderer(), viewcount.NewCounter(s, clock.RealClock{})), s
+}
+
+// createPosts 为 visibilityCases 中的每个组合创建一篇 ownerID 的文章，并分享给 granteeID. 返回的文章 ID 与 visibilityCases 一一对应.
+func createPosts(t *testing.T, s store.IStore) []string {
+	t.Helper()
+
+	ctx := context.Background()
+	postIDs := make([]string, 0, len(visibilityCases))
+	for _, tt := range visibilityCases {
+		postM := model.PostM{
+			UserID:     ownerID,
+			Title:      tt.visibility.String() + " " + tt.status.String(),
+			Content:    "content",
+			Status:     int32(tt.status),
+			Visibility: int32(tt.visibility),
+		}
+		require.NoError(t, s.Post().Create(ctx, &postM))
+		require.NoError(t, s.PostShare().Add(ctx, &model.PostShareM{PostID: postM.PostID, UserID: granteeID}))
+		postIDs = append(postIDs, postM.PostID)
+	}
+	return postIDs
+}
+
+func TestPostBiz_Get(t *testing.T) {
+	b, s := newTestBiz(t)
+	postIDs := createPosts(t, s)
+
+	for i, tt := range visibilityCases {
+		t.Run(tt.visibility.String()+"/"+tt.status.String(), func(t *testing.T) {
+			for _, viewer := range []struct {
+				userID  string
+				visible bool
+			}{
+				{ownerID, tt.owner},
+				{granteeID, tt.grantee},
+				{otherID, tt.other},
+			} {
+				ctx := contextx.WithUserID(context.Background(), viewer.userID)
+				resp, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postIDs[i]})
+				if !viewer.visible {
+					// 无权查看与文章不存在返回相同的错误
+					assert.ErrorIs(t, err, errno.ErrPostNotFound, viewer.userID)
+					continue
+				}
+
+				require.NoError(t, err, viewer.userID)
+				assert.Equal(t, tt.visibility, resp.GetPost().GetVisibility(), viewer.userID)
+				// 只有作者本人能看到文章的分享记录
+				if viewer.userID == ownerID {
+					assert.Equal(t, []string{granteeID}, resp.GetPost().GetSharedWith())
+				} else {
+					assert.Empty(t, resp.GetPost().GetSharedWith(), viewer.userID)
+				}
+			}
+		})
+	}
+
+	_, err := b.Get(contextx.WithUserID(context.Background(), ownerID), &apiv1.GetPostRequest{PostID: "post-missing"})
+	assert.ErrorIs(t, err, errno.ErrPostNotFound)
+}
+
+func TestPostBiz_List(t *testing.T) {
+	b, s := newTestBiz(t)
+	postIDs := createPosts(t, s)
+
+	// expected 返回 visibilityCases 中满足条件的文章 ID
+	expected := func(match func(i int) bool) []string {
+		ret := []string{}
+		for i := range visibilityCases {
+			if match(i) {
+				ret = append(ret, postIDs[i])
+			}
+		}
+		return ret
+	}
+	listed := func(i int) bool {
+		return visibilityCases[i].visibility == apiv1.PostVisibility_Public && visibilityCases[i].status == apiv1.PostStatus_Published
+	}
+	shared := func(i int) bool {
+		return visibilityCases[i].visibility == apiv1.PostVisibility_Shared
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		rq     *apiv1.ListPostRequest
+		want   []string
+	}{
+		{
+			name:   "owner lists own posts",
+			userID: ownerID,
+			rq:     &apiv1.ListPostRequest{},
+			want:   postIDs,
+		},
+		{
+			name:   "owner lists own posts by userID",
+			userID: ownerID,
+			rq:     &apiv1.ListPostRequest{UserID: ptr.To(ownerID)},
+			want:   postIDs,
+		},
+		{
+			name:   "grantee lists owner posts",
+			userID: granteeID,
+			rq:     &apiv1.ListPostRequest{UserID: ptr.To(ownerID)},
+			want:   expected(func(i int) bool { return listed(i) || shared(i) }),
+		},
+		{
+			name:   "other lists owner posts",
+			userID: otherID,
+			rq:     &apiv1.ListPostRequest{UserID: ptr.To(ownerID)},
+			want:   expected(listed),
+		},
+		{
+			name:   "grantee lists posts shared with them",
+			userID: granteeID,
+			rq:     &apiv1.ListPostRequest{SharedWithMe: true},
+			want:   expected(shared),
+		},
+		{
+			name:   "grantee lists posts shared with them by owner",
+			userID: granteeID,
+			rq:     &apiv1.ListPostRequest{SharedWithMe: true, UserID: ptr.To(ownerID)},
+			want:   expected(shared),
+		},
+		{
+			name:   "other lists posts shared with them",
+			userID: otherID,
+			rq:     &apiv1.ListPostRequest{SharedWithMe: true},
+			want:   []string{},
+		},
+		{
+			name:   "owner lists posts shared with them",
+			userID: ownerID,
+			rq:     &apiv1.ListPostRequest{SharedWithMe: true},
+			want:   []string{},
+		},
+		{
+			name:   "grantee lists own posts",
+			userID: granteeID,
+			rq:     &apiv1.ListPostRequest{},
+			want:   []string{},
+		},
+		{
+			name:   "grantee lists owner posts by status",
+			userID: granteeID,
+			rq:     &apiv1.ListPostRequest{UserID: ptr.To(ownerID), Status: ptr.To(apiv1.PostStatus_Draft)},
+			want: expected(func(i int) bool {
+				return shared(i) && visibilityCases[i].status == apiv1.PostStatus_Draft
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contextx.WithUserID(context.Background(), tt.userID)
+			resp, err := b.List(ctx, tt.rq)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(resp.GetPosts()))
+			for _, post := range resp.GetPosts() {
+				got = append(got, post.GetPostID())
+				// 只有作者本人能看到文章的分享记录
+				assert.Equal(t, tt.userID == ownerID, len(post.GetSharedWith()) > 0, post.GetPostID())
+			}
+			assert.ElementsMatch(t, tt.want, got)
+			assert.Equal(t, int64(len(tt.want)), resp.GetTotalCount())
+		})
+	}
+}
+
+func TestPostBiz_OwnerOnlyMutation(t *testing.T) {
+	b, s := newTestBiz(t)
+	postIDs := createPosts(t, s)
+
+	for i, tt := range visibilityCases {
+		t.Run(tt.visibility.String()+"/"+tt.status.String(), func(t *testing.T) {
+			postID := postIDs[i]
+			for _, viewer := range []struct {
+				userID  string
+				visible bool
+			}{
+				{granteeID, tt.grantee},
+				{otherID, tt.other},
+			} {
+				userID := viewer.userID
+				ctx := contextx.WithUserID(context.Background(), userID)
+
+				// 有权查看但不是协作者时拒绝修改，无权查看时与文章不存在返回相同的错误
+				wantErr := errno.ErrPostNotFound
+				if viewer.visible {
+					wantErr = errno.ErrPostPermissionDenied
+				}
+				_, err := b.Update(ctx, &apiv1.UpdatePostRequest{PostID: postID, Title: ptr.To("changed"), Visibility: ptr.To(apiv1.PostVisibility_Public), Version: ptr.To(int64(1))})
+				assert.ErrorIs(t, err, wantErr, userID)
+				_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: postID})
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound, userID)
+				_, err = b.Share(ctx, &apiv1.SharePostRequest{PostID: postID, UserID: otherID})
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound, userID)
+				_, err = b.Unshare(ctx, &apiv1.UnsharePostRequest{PostID: postID, UserID: granteeID})
+				assert.ErrorIs(t, err, gorm.ErrRecordNotFound, userID)
+				_, err = b.Delete(ctx, &apiv1.DeletePostRequest{PostIDs: []string{postID}})
+				require.NoError(t, err, userID)
+			}
+
+			// 其他用户的修改都没有生效
+			postM, err := s.Post().Get(context.Background(), where.F("postID", postID))
+			require.NoError(t, err)
+			assert.Equal(t, tt.visibility.String()+" "+tt.status.String(), postM.Title)
+			assert.Equal(t, int32(tt.visibility), postM.Visibility)
+			assert.Equal(t, int32(tt.status), postM.Status)
+			sharedWith, err := s.PostShare().UserIDs(context.Background(), []string{postID})
+			require.NoError(t, err)
+			assert.Equal(t, []string{granteeID}, sharedWith[postID])
+		})
+	}
+}
+
+func TestPostBiz_ShareAndUpdateVisibility(t *testing.T) {
+	b, s := newTestBiz(t)
+	ownerCtx := contextx.WithUserID(context.Background(), ownerID)
+	otherCtx := contextx.WithUserID(context.Background(), otherID)
+
+	postM := model.PostM{UserID: ownerID, Title: "title", Content: "content", Visibility: int32(apiv1.PostVisibility_Private)}
+	require.NoError(t, s.Post().Create(context.Background(), &postM))
+	get := func(ctx context.Context) error {
+		_, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postM.PostID})
+		return err
+	}
+
+	// 私密文章即使存在分享记录也只有作者本人可见
+	_, err := b.Share(ownerCtx, &apiv1.SharePostRequest{PostID: postM.PostID, UserID: otherID})
+	require.NoError(t, err)
+	_, err = b.Share(ownerCtx, &apiv1.SharePostRequest{PostID: postM.PostID, UserID: otherID})
+	require.NoError(t, err, "sharing twice should be idempotent")
+	require.NoError(t, get(ownerCtx))
+	assert.ErrorIs(t, get(otherCtx), errno.ErrPostNotFound)
+
+	// 改为 Shared 后，被分享的用户可以查看
+	_, err = b.Update(ownerCtx, &apiv1.UpdatePostRequest{PostID: postM.PostID, Visibility: ptr.To(apiv1.PostVisibility_Shared), Version: ptr.To(postM.Version)})
+	require.NoError(t, err)
+	require.NoError(t, get(otherCtx))
+
+	// 取消分享后不能再查看
+	_, err = b.Unshare(ownerCtx, &apiv1.UnsharePostRequest{PostID: postM.PostID, UserID: otherID})
+	require.NoError(t, err)
+	assert.ErrorIs(t, get(otherCtx), errno.ErrPostNotFound)
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ReactionBiz 定义处理博文点赞和表情回应请求所需的方法.
+type ReactionBiz interface {
+	Like(ctx context.Context, rq *apiv1.LikePostRequest) (*apiv1.LikePostResponse, error)
+	Unlike(ctx context.Context, rq *apiv1.UnlikePostRequest) (*apiv1.UnlikePostResponse, error)
+	Add(ctx context.Context, rq *apiv1.AddPostReactionRequest) (*apiv1.AddPostReactionResponse, error)
+	Remove(ctx context.Context, rq *apiv1.RemovePostReactionRequest) (*apiv1.RemovePostReactionResponse, error)
+	ListLikers(ctx context.Context, rq *apiv1.ListPostLikersRequest) (*apiv1.ListPostLikersResponse, error)
+
+	ReactionExpansion
+}
+
+// ReactionExpansion 定义额外的回应操作方法.
+type ReactionExpansion interface{}
+
+type reactionBiz struct {
+	store store.IStore
+}
+
+var _ ReactionBiz = (*reactionBiz)(nil)
+
+func New(store store.IStore) *reactionBiz {
+	return &reactionBiz{store: store}
+}
+
+// Like 点赞博文. 重复点赞不会重复计数.
+func (b *reactionBiz) Like(ctx context.Context, rq *apiv1.LikePostRequest) (*apiv1.LikePostResponse, error) {
+	if err := b.add(ctx, rq.GetPostID(), known.ReactionLike); err != nil {
+		return nil, err
+	}
+
+	counts, err := b.counts(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.LikePostResponse{LikeCount: counts[known.ReactionLike]}, nil
+}
+
+// Unlike 取消点赞. 未点赞时不会扣减计数.
+func (b *reactionBiz) Unlike(ctx context.Context, rq *apiv1.UnlikePostRequest) (*apiv1.UnlikePostResponse, error) {
+	if err := b.remove(ctx, rq.GetPostID(), known.ReactionLike); err != nil {
+		return nil, err
+	}
+
+	counts, err := b.counts(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnlikePostResponse{LikeCount: counts[known.ReactionLike]}, nil
+}
+
+// Add 添加表情回应. 同一用户对同一表情只计数一次.
+func (b *reactionBiz) Add(ctx context.Context, rq *apiv1.AddPostReactionRequest) (*apiv1.AddPostReactionResponse, error) {
+	if err := b.add(ctx, rq.GetPostID(), rq.GetReaction()); err != nil {
+		return nil, err
+	}
+
+	counts, err := b.counts(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	delete(counts, known.ReactionLike)
+
+	return &apiv1.AddPostReactionResponse{Reactions: counts}, nil
+}
+
+// Remove 移除表情回应.
+func (b *reactionBiz) Remove(ctx context.Context, rq *apiv1.RemovePostReactionRequest) (*apiv1.RemovePostReactionResponse, error) {
+	if err := b.remove(ctx, rq.GetPostID(), rq.GetReaction()); err != nil {
+		return nil, err
+	}
+
+	counts, err := b.counts(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	delete(counts, known.ReactionLike)
+
+	return &apiv1.RemovePostReactionResponse{Reactions: counts}, nil
+}
+
+// ListLikers 分页列出点赞博文的用户，按点赞时间倒序排列.
+func (b *reactionBiz) ListLikers(ctx context.Context, rq *apiv1.ListPostLikersRequest) (*apiv1.ListPostLikersResponse, error) {
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit())).F("postID", rq.GetPostID(), "reaction", known.ReactionLike)
+	count, reactionList, err := b.store.PostReaction().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(reactionList))
+	for _, reaction := range reactionList {
+		userIDs = append(userIDs, reaction.UserID)
+	}
+
+	users := make(map[string]*model.UserM, len(userIDs))
+	if len(userIDs) > 0 {
+		_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range userList {
+			users[user.UserID] = user
+		}
+	}
+
+	likers := make([]*apiv1.PostLiker, 0, len(reactionList))
+	for _, reaction := range reactionList {
+		// 用户注销后不再展示其点赞记录
+		user, ok := users[reaction.UserID]
+		if !ok {
+			continue
+		}
+		likers = append(likers, &apiv1.PostLiker{
+			User:    conversion.UserModelToPublicAuthorV1(user),
+			LikedAt: timestamppb.New(reaction.CreatedAt),
+		})
+	}
+
+	return &apiv1.ListPostLikersResponse{TotalCount: count, Likers: likers}, nil
+}
+
+// add 在同一事务中记录回应并累加计数. 只有新增了回应记录时才累加计数，
+// 因此重复请求和并发请求都不会导致计数与回应记录不一致.
+func (b *reactionBiz) add(ctx context.Context, postID string, reaction string) error {
+	return b.store.TX(ctx, func(ctx context.Context) error {
+		reactionM := model.PostReactionM{
+			PostID:    postID,
+			UserID:    contextx.UserID(ctx),
+			Reaction:  reaction,
+			CreatedAt: time.Now(),
+		}
+		added, err := b.store.PostReaction().Add(ctx, &reactionM)
+		if err != nil || !added {
+			return err
+		}
+		return b.store.PostReactionCount().Increment(ctx, postID, reaction)
+	})
+}
+
+// remove 在同一事务中删除回应并扣减计数. 只有确实删除了回应记录时才扣减计数.
+func (b *reactionBiz) remove(ctx context.Context, postID string, reaction string) error {
+	return b.store.TX(ctx, func(ctx context.Context) error {
+		removed, err := b.store.PostReaction().Remove(ctx, postID, contextx.UserID(ctx), reaction)
+		if err != nil || !removed {
+			return err
+		}
+		return b.store.PostReactionCount().Decrement(ctx, postID, reaction)
+	})
+}
+
+// counts 查询博文各回应的计数，返回以回应类型为键的计数，计数为 0 的回应不会返回.
+func (b *reactionBiz) counts(ctx context.Context, postID string) (map[string]int64, error) {
+	_, countList, err := b.store.PostReactionCount().List(ctx, where.F("postID", postID))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]int64, len(countList))
+	for _, count := range countList {
+		if count.Total > 0 {
+			ret[count.Reaction] = count.Total
+		}
+	}
+	return ret, nil
+}
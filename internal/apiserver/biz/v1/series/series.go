@@ -0,0 +1,214 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package series
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// SeriesBiz 定义处理系列请求所需的方法.
+type SeriesBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateSeriesRequest) (*apiv1.CreateSeriesResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdateSeriesRequest) (*apiv1.UpdateSeriesResponse, error)
+	Get(ctx context.Context, rq *apiv1.GetSeriesRequest) (*apiv1.GetSeriesResponse, error)
+
+	SeriesExpansion
+}
+
+// SeriesExpansion 定义额外的系列操作方法.
+type SeriesExpansion interface {
+	Reorder(ctx context.Context, rq *apiv1.ReorderSeriesRequest) (*apiv1.ReorderSeriesResponse, error)
+}
+
+type seriesBiz struct {
+	store store.IStore
+}
+
+var _ SeriesBiz = (*seriesBiz)(nil)
+
+func New(store store.IStore) *seriesBiz {
+	return &seriesBiz{store: store}
+}
+
+// Create 创建系列，并按给出的顺序将博文加入系列.
+func (b *seriesBiz) Create(ctx context.Context, rq *apiv1.CreateSeriesRequest) (*apiv1.CreateSeriesResponse, error) {
+	now := time.Now()
+	seriesM := model.SeriesM{
+		UserID:      contextx.UserID(ctx),
+		Title:       rq.GetTitle(),
+		Description: rq.GetDescription(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Series().Create(ctx, &seriesM); err != nil {
+			return err
+		}
+		return b.createMembers(ctx, seriesM.SeriesID, rq.GetPostIDs())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateSeriesResponse{SeriesID: seriesM.SeriesID}, nil
+}
+
+// Update 更新系列的标题和简介. 博文列表不为空时，替换系列中的全部博文.
+func (b *seriesBiz) Update(ctx context.Context, rq *apiv1.UpdateSeriesRequest) (*apiv1.UpdateSeriesResponse, error) {
+	seriesM, err := b.getSeries(ctx, rq.GetSeriesID())
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.Title != nil {
+		seriesM.Title = rq.GetTitle()
+	}
+	if rq.Description != nil {
+		seriesM.Description = rq.GetDescription()
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Series().Update(ctx, seriesM); err != nil {
+			return err
+		}
+
+		// 博文列表为空时保留原有博文
+		if len(rq.GetPostIDs()) == 0 {
+			return nil
+		}
+
+		if err := b.store.SeriesPost().Delete(ctx, where.F("seriesID", seriesM.SeriesID)); err != nil {
+			return err
+		}
+		return b.createMembers(ctx, seriesM.SeriesID, rq.GetPostIDs())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateSeriesResponse{}, nil
+}
+
+// Reorder 按给出的顺序调整系列中博文的位置.
+// 回收站中的博文不会出现在请求中，这些博文保持原有的相对顺序，排在其他博文之后.
+func (b *seriesBiz) Reorder(ctx context.Context, rq *apiv1.ReorderSeriesRequest) (*apiv1.ReorderSeriesResponse, error) {
+	seriesM, err := b.getSeries(ctx, rq.GetSeriesID())
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int64, len(rq.GetPostIDs()))
+	for i, postID := range rq.GetPostIDs() {
+		positions[postID] = int64(i)
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		whr := where.F("seriesID", seriesM.SeriesID).C(clause.OrderBy{
+			Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "position"}}},
+		})
+		_, memberList, err := b.store.SeriesPost().List(ctx, whr)
+		if err != nil {
+			return err
+		}
+
+		next := int64(len(positions))
+		for _, member := range memberList {
+			position, ok := positions[member.PostID]
+			if !ok {
+				position = next
+				next++
+			}
+			if member.Position == position {
+				continue
+			}
+			member.Position = position
+			if err := b.store.SeriesPost().Update(ctx, member); err != nil {
+				return err
+			}
+		}
+
+		// 调整顺序也视为对系列的修改
+		seriesM.UpdatedAt = time.Now()
+		return b.store.Series().Update(ctx, seriesM)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ReorderSeriesResponse{}, nil
+}
+
+// Get 获取当前用户的系列，以及系列中按顺序排列的博文.
+func (b *seriesBiz) Get(ctx context.Context, rq *apiv1.GetSeriesRequest) (*apiv1.GetSeriesResponse, error) {
+	seriesM, err := b.getSeries(ctx, rq.GetSeriesID())
+	if err != nil {
+		return nil, err
+	}
+
+	postList, err := b.store.SeriesPost().ListPosts(ctx, seriesM.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	series := conversion.SeriesModelToSeriesV1(seriesM)
+	series.Posts = make([]*apiv1.SeriesPost, 0, len(postList))
+	for i, post := range postList {
+		series.Posts = append(series.Posts, conversion.PostModelToSeriesPostV1(post, int64(i+1)))
+	}
+
+	return &apiv1.GetSeriesResponse{Series: series}, nil
+}
+
+// getSeries 查询当前用户拥有的系列.
+func (b *seriesBiz) getSeries(ctx context.Context, seriesID string) (*model.SeriesM, error) {
+	seriesM, err := b.store.Series().Get(ctx, where.T(ctx).F("seriesID", seriesID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrSeriesNotFound
+		}
+		return nil, err
+	}
+	return seriesM, nil
+}
+
+// createMembers 按给出的顺序将博文加入系列.
+func (b *seriesBiz) createMembers(ctx context.Context, seriesID string, postIDs []string) error {
+	for i, postID := range postIDs {
+		memberM := model.SeriesPostM{
+			SeriesID:  seriesID,
+			PostID:    postID,
+			Position:  int64(i),
+			CreatedAt: time.Now(),
+		}
+		if err := b.store.SeriesPost().Create(ctx, &memberM); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// Follow 关注指定用户. 重复关注时保留最初的关注时间.
+func (u *userBiz) Follow(ctx context.Context, rq *apiv1.FollowUserRequest) (*apiv1.FollowUserResponse, error) {
+	followM := model.FollowM{
+		FollowerID: contextx.UserID(ctx),
+		FolloweeID: rq.GetUserID(),
+		CreatedAt:  time.Now(),
+	}
+	if err := u.store.Follow().Add(ctx, &followM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.FollowUserResponse{}, nil
+}
+
+// Unfollow 取消关注指定用户.
+func (u *userBiz) Unfollow(ctx context.Context, rq *apiv1.UnfollowUserRequest) (*apiv1.UnfollowUserResponse, error) {
+	whr := where.F("followerID", contextx.UserID(ctx), "followeeID", rq.GetUserID())
+	if err := u.store.Follow().Delete(ctx, whr); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnfollowUserResponse{}, nil
+}
+
+// ListFollowers 分页列出关注指定用户的用户，按关注时间倒序排列.
+func (u *userBiz) ListFollowers(ctx context.Context, rq *apiv1.ListFollowersRequest) (*apiv1.ListFollowersResponse, error) {
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit())).F("followeeID", rq.GetUserID())
+	count, followList, err := u.store.Follow().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	followers, err := u.toFollowRelations(ctx, followList, func(follow *model.FollowM) string { return follow.FollowerID })
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListFollowersResponse{TotalCount: count, Followers: followers}, nil
+}
+
+// ListFollowing 分页列出指定用户关注的用户，按关注时间倒序排列.
+func (u *userBiz) ListFollowing(ctx context.Context, rq *apiv1.ListFollowingRequest) (*apiv1.ListFollowingResponse, error) {
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit())).F("followerID", rq.GetUserID())
+	count, followList, err := u.store.Follow().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := u.toFollowRelations(ctx, followList, func(follow *model.FollowM) string { return follow.FolloweeID })
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListFollowingResponse{TotalCount: count, Following: following}, nil
+}
+
+// toFollowRelations 将关注关系转换为 apiv1.FollowRelation 列表，other 返回关注关系中需要展示的另一方用户 ID.
+func (u *userBiz) toFollowRelations(ctx context.Context, followList []*model.FollowM, other func(*model.FollowM) string) ([]*apiv1.FollowRelation, error) {
+	userIDs := make([]string, 0, len(followList))
+	for _, follow := range followList {
+		userIDs = append(userIDs, other(follow))
+	}
+
+	users := make(map[string]*model.UserM, len(userIDs))
+	if len(userIDs) > 0 {
+		_, userList, err := u.store.User().List(ctx, where.F("userID", userIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range userList {
+			users[user.UserID] = user
+		}
+	}
+
+	relations := make([]*apiv1.FollowRelation, 0, len(followList))
+	for _, follow := range followList {
+		user, ok := users[other(follow)]
+		if !ok {
+			continue
+		}
+		relations = append(relations, &apiv1.FollowRelation{
+			User:       conversion.UserModelToPublicAuthorV1(user),
+			FollowedAt: timestamppb.New(follow.CreatedAt),
+		})
+	}
+
+	return relations, nil
+}
+
+// countFollows 统计指定用户的粉丝数量和关注的用户数量，返回以 userID 为键的数量.
+func (u *userBiz) countFollows(ctx context.Context, userIDs ...string) (map[string]int64, map[string]int64, error) {
+	followers, err := u.store.Follow().CountFollowers(ctx, userIDs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	following, err := u.store.Follow().CountFollowing(ctx, userIDs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return followers, following, nil
+}
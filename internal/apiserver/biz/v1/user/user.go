@@ -25,6 +25,8 @@ import (
 	"github.com/jwcen/miniblog/internal/apiserver/store"
 	"github.com/jwcen/miniblog/internal/pkg/contextx"
 	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+	"github.com/jwcen/miniblog/internal/pkg/fieldmask"
 	"github.com/jwcen/miniblog/internal/pkg/known"
 	"github.com/jwcen/miniblog/internal/pkg/log"
 	"github.com/jwcen/miniblog/internal/pkg/rid"
@@ -53,6 +55,10 @@ type UserExpansion interface {
 	RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error)
 	ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error)
 	ListWithBadPerformance(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error)
+	Follow(ctx context.Context, rq *apiv1.FollowUserRequest) (*apiv1.FollowUserResponse, error)
+	Unfollow(ctx context.Context, rq *apiv1.UnfollowUserRequest) (*apiv1.UnfollowUserResponse, error)
+	ListFollowers(ctx context.Context, rq *apiv1.ListFollowersRequest) (*apiv1.ListFollowersResponse, error)
+	ListFollowing(ctx context.Context, rq *apiv1.ListFollowingRequest) (*apiv1.ListFollowingResponse, error)
 }
 
 type userBiz struct {
@@ -163,21 +169,32 @@ func (u *userBiz) Create(ctx context.Context, req *apiv1.CreateUserRequest) (*ap
 }
 
 func (u *userBiz) Update(ctx context.Context, req *apiv1.UpdateUserRequest) (*apiv1.UpdateUserResponse, error) {
+	expected, err := etag.Expected(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	userM, err := u.store.User().Get(ctx, where.T(ctx))
 	if err != nil {
 		return nil, err
 	}
+	// 用户信息在客户端读取之后已被修改，拒绝覆盖其他请求的修改
+	if expected != 0 && userM.Version != expected {
+		return nil, errno.ErrVersionConflict
+	}
 
-	if req.Username != nil {
-		userM.Username = *req.Username
+	// 指定 updateMask 时只更新其中列出的字段，列出但未设置的字段会被清空
+	mask := fieldmask.New(req.GetUpdateMask())
+	if mask.Covers("username", req.Username != nil) {
+		userM.Username = req.GetUsername()
 	}
-	if req.Email != nil {
+	if mask.Covers("email", req.Email != nil) {
 		userM.Email = req.GetEmail()
 	}
-	if req.Nickname != nil {
+	if mask.Covers("nickname", req.Nickname != nil) {
 		userM.Nickname = req.GetNickname()
 	}
-	if req.Phone != nil {
+	if mask.Covers("phone", req.Phone != nil) {
 		userM.Phone = req.GetPhone()
 	}
 
@@ -191,7 +208,17 @@ func (u *userBiz) Update(ctx context.Context, req *apiv1.UpdateUserRequest) (*ap
 func (u *userBiz) Delete(ctx context.Context, req *apiv1.DeleteUserRequest) (*apiv1.DeleteUserResponse, error) {
 	// 只有 `root` 用户可以删除用户，并且可以删除其他用户
 	// 所以这里不用 where.T()，因为 where.T() 会查询 `root` 用户自己
-	if err := u.store.User().Delete(ctx, where.F("userID", req.GetUserID())); err != nil {
+	err := u.store.TX(ctx, func(ctx context.Context) error {
+		if err := u.store.User().Delete(ctx, where.F("userID", req.GetUserID())); err != nil {
+			return err
+		}
+		// 用户删除后，其关注和被关注关系一并删除
+		if err := u.store.Follow().Delete(ctx, where.F("followerID", req.GetUserID())); err != nil {
+			return err
+		}
+		return u.store.Follow().Delete(ctx, where.F("followeeID", req.GetUserID()))
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -209,8 +236,17 @@ func (u *userBiz) Get(ctx context.Context, req *apiv1.GetUserRequest) (*apiv1.Ge
 		return nil, err
 	}
 
+	followers, following, err := u.countFollows(ctx, userM.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user := conversion.UserModelToUserV1(userM)
+	user.FollowerCount = followers[userM.UserID]
+	user.FollowingCount = following[userM.UserID]
+
 	return &apiv1.GetUserResponse{
-		User: conversion.UserModelToUserV1(userM),
+		User: user,
 	}, nil
 }
 
@@ -225,6 +261,15 @@ func (u *userBiz) List(ctx context.Context, req *apiv1.ListUserRequest) (*apiv1.
 		return nil, err
 	}
 
+	userIDs := make([]string, 0, len(userList))
+	for _, user := range userList {
+		userIDs = append(userIDs, user.UserID)
+	}
+	followers, following, err := u.countFollows(ctx, userIDs...)
+	if err != nil {
+		return nil, err
+	}
+
 	var m sync.Map
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -246,6 +291,8 @@ func (u *userBiz) List(ctx context.Context, req *apiv1.ListUserRequest) (*apiv1.
 
 				converted := conversion.UserModelToUserV1(user)
 				converted.PostCount = count
+				converted.FollowerCount = followers[user.UserID]
+				converted.FollowingCount = following[user.UserID]
 				m.Store(user.UserID, converted) // 使用 UserID 作为 key
 
 				return nil
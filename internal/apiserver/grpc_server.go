@@ -16,13 +16,19 @@ package apiserver
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"strconv"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	handler "github.com/jwcen/miniblog/internal/apiserver/handler/grpc"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
 	"github.com/jwcen/miniblog/internal/pkg/log"
 	mw "github.com/jwcen/miniblog/internal/pkg/middleware/grpc"
 	"github.com/jwcen/miniblog/internal/pkg/server"
@@ -48,10 +54,23 @@ var _ server.Server = (*grpcServer)(nil)
 //  3. 表达灵活选项
 func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 	log.Infow("New GRPC Server start...")
+	// grpc-gateway 通过 gRPC 监听地址访问 gRPC 服务，监听地址不是本机地址时也需要信任该地址
+	trustedProxies := c.cfg.TrustedProxies
+	if host, _, err := net.SplitHostPort(c.cfg.GRPCOptions.Addr); err == nil && host != "" {
+		if listen, err := httpx.ParseTrustedProxies(host); err == nil {
+			trustedProxies = append(listen, trustedProxies...)
+		}
+	}
 	// 配置 gRPC 服务器选项，包括拦截器链
 	serverOptions := []grpc.ServerOption{
+		// 上传的媒体文件随请求一起发送，需要放宽默认 4MB 的请求大小限制，额外预留 1MB 给请求中的其他字段
+		grpc.MaxRecvMsgSize(int(c.cfg.MaxMediaSize) + 1<<20),
 		grpc.ChainUnaryInterceptor(
 			mw.RequestIDInterceptor(),
+			// 记录客户端 IP，只信任来自 grpc-gateway 和可信代理的 x-forwarded-for
+			mw.ClientIPInterceptor(trustedProxies),
+			// 记录客户端期望的资源版本
+			mw.IfMatchInterceptor(),
 			// 认证拦截器
 			selector.UnaryServerInterceptor(mw.AuthnInterceptor(c.retriever), NewAuthnWhiteListMatcher()),
 			// 授权拦截器
@@ -63,15 +82,22 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 			// 数据校验拦截器
 			mw.ValidatorInterceptor(genericvalidation.NewValidator(c.val)),
 		),
+		// 流式接口逐条处理请求消息，由接口自行校验每条消息
+		grpc.ChainStreamInterceptor(
+			selector.StreamServerInterceptor(mw.AuthnStreamInterceptor(c.retriever), NewAuthnWhiteListMatcher()),
+			selector.StreamServerInterceptor(mw.AuthzStreamInterceptor(c.authz), NewAuthzWhiteListMatcher()),
+		),
 	}
 
+	handler := handler.NewHandler(c.biz, c.cfg.TrustedProxies)
+
 	// 创建 gRPC 服务器
 	grpcsrv, err := server.NewGRPCServer(
 		c.cfg.GRPCOptions,
 		serverOptions,
 		c.cfg.TLSOptions,
 		func(s grpc.ServiceRegistrar) {
-			apiv1.RegisterMiniBlogServer(s, handler.NewHandler(c.biz))
+			apiv1.RegisterMiniBlogServer(s, handler)
 		},
 	)
 	if err != nil {
@@ -95,8 +121,18 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 		c.cfg.GRPCOptions,
 		c.cfg.TLSOptions,
 		func(mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-			return apiv1.RegisterMiniBlogHandler(context.Background(), mux, conn)
+			if err := apiv1.RegisterMiniBlogHandler(context.Background(), mux, conn); err != nil {
+				return err
+			}
+			if err := handler.InstallFeedHandlers(mux); err != nil {
+				return err
+			}
+			if err := handler.InstallExportHandlers(mux, conn); err != nil {
+				return err
+			}
+			return handler.InstallSitemapHandlers(mux)
 		},
+		runtime.WithForwardResponseOption(httpResponseModifier),
 	)
 	if err != nil {
 		return nil, err
@@ -121,12 +157,47 @@ func (s *grpcServer) GracefulStop(ctx context.Context) {
 	s.stop(ctx)
 }
 
+// httpResponseModifier 根据 gRPC 响应头中的 x-http-code、x-http-location 和 x-http-etag 修改 HTTP 响应的状态码、Location 头和 ETag 头，
+// 使 gRPC 接口可以通过 grpc-gateway 返回重定向等非 200 的响应.
+func httpResponseModifier(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	// 这些响应头只用于控制 HTTP 响应，不需要再以 Grpc-Metadata- 前缀的形式返回给客户端
+	if values := md.HeaderMD.Get(known.XHTTPLocation); len(values) > 0 {
+		w.Header().Del(runtime.MetadataHeaderPrefix + known.XHTTPLocation)
+		w.Header().Set("Location", values[0])
+	}
+	if values := md.HeaderMD.Get(known.XHTTPETag); len(values) > 0 {
+		w.Header().Del(runtime.MetadataHeaderPrefix + known.XHTTPETag)
+		w.Header().Set("ETag", values[0])
+	}
+	if values := md.HeaderMD.Get(known.XHTTPCode); len(values) > 0 {
+		code, err := strconv.Atoi(values[0])
+		if err != nil {
+			return err
+		}
+		w.Header().Del(runtime.MetadataHeaderPrefix + known.XHTTPCode)
+		w.WriteHeader(code)
+	}
+
+	return nil
+}
+
 // NewAuthnWhiteListMatcher 创建认证白名单匹配器.
 func NewAuthnWhiteListMatcher() selector.Matcher {
 	whitelist := map[string]struct{}{
 		apiv1.MiniBlog_Healthz_FullMethodName:    {},
 		apiv1.MiniBlog_CreateUser_FullMethodName: {},
 		apiv1.MiniBlog_Login_FullMethodName:      {},
+		// 公开接口只返回已发布的博客，无需认证和授权
+		apiv1.MiniBlog_GetPublicPost_FullMethodName:         {},
+		apiv1.MiniBlog_ListPublicPosts_FullMethodName:       {},
+		apiv1.MiniBlog_ListAuthorPublicPosts_FullMethodName: {},
+		apiv1.MiniBlog_GetPostBySlug_FullMethodName:         {},
+		apiv1.MiniBlog_GetPublicMedia_FullMethodName:        {},
 	}
 
 	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
@@ -141,6 +212,12 @@ func NewAuthzWhiteListMatcher() selector.Matcher {
 		apiv1.MiniBlog_Healthz_FullMethodName:    {},
 		apiv1.MiniBlog_CreateUser_FullMethodName: {},
 		apiv1.MiniBlog_Login_FullMethodName:      {},
+		// 公开接口只返回已发布的博客，无需认证和授权
+		apiv1.MiniBlog_GetPublicPost_FullMethodName:         {},
+		apiv1.MiniBlog_ListPublicPosts_FullMethodName:       {},
+		apiv1.MiniBlog_ListAuthorPublicPosts_FullMethodName: {},
+		apiv1.MiniBlog_GetPostBySlug_FullMethodName:         {},
+		apiv1.MiniBlog_GetPublicMedia_FullMethodName:        {},
 	}
 	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
 		_, ok := whitelist[call.FullMethod()]
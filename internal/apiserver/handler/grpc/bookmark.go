@@ -0,0 +1,36 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// AddBookmark 收藏博客.
+func (h *Handler) AddBookmark(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error) {
+	return h.biz.PostV1().AddBookmark(ctx, rq)
+}
+
+// RemoveBookmark 取消收藏博客.
+func (h *Handler) RemoveBookmark(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error) {
+	return h.biz.PostV1().RemoveBookmark(ctx, rq)
+}
+
+// ListBookmarks 列出当前用户的阅读列表.
+func (h *Handler) ListBookmarks(ctx context.Context, rq *apiv1.ListBookmarksRequest) (*apiv1.ListBookmarksResponse, error) {
+	return h.biz.PostV1().ListBookmarks(ctx, rq)
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreateComment 创建评论.
+func (h *Handler) CreateComment(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	return h.biz.CommentV1().Create(ctx, rq)
+}
+
+// UpdateComment 更新评论.
+func (h *Handler) UpdateComment(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error) {
+	return h.biz.CommentV1().Update(ctx, rq)
+}
+
+// DeleteComment 删除评论.
+func (h *Handler) DeleteComment(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	return h.biz.CommentV1().Delete(ctx, rq)
+}
+
+// ListComment 列出博客帖子的评论.
+func (h *Handler) ListComment(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error) {
+	return h.biz.CommentV1().List(ctx, rq)
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+)
+
+// setETag 将资源的版本号设置到响应头中，通过 grpc-gateway 访问的 HTTP 客户端会收到对应的 ETag 头，
+// 更新资源时可以通过 If-Match 请求头带上该值.
+func setETag(ctx context.Context, version int64) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(known.XHTTPETag, etag.Format(version)))
+}
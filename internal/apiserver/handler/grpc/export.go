@@ -0,0 +1,152 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// exportChunkSize 是下载导出文件时每个响应消息中文件内容的大小.
+const exportChunkSize = 64 * 1024
+
+// CreateExportJob 创建博文导出任务.
+func (h *Handler) CreateExportJob(ctx context.Context, rq *apiv1.CreateExportJobRequest) (*apiv1.CreateExportJobResponse, error) {
+	return h.biz.ExportV1().CreateJob(ctx, rq)
+}
+
+// GetExportJob 查询博文导出任务.
+func (h *Handler) GetExportJob(ctx context.Context, rq *apiv1.GetExportJobRequest) (*apiv1.GetExportJobResponse, error) {
+	return h.biz.ExportV1().GetJob(ctx, rq)
+}
+
+// ExportPosts 下载导出文件. 文件内容按块写入流中，建议的文件名通过响应头返回.
+func (h *Handler) ExportPosts(rq *apiv1.ExportPostsRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	download, err := h.biz.ExportV1().Export(stream.Context(), rq)
+	if err != nil {
+		return err
+	}
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": download.Filename})
+	if err := stream.SetHeader(metadata.Pairs(known.XHTTPContentDisposition, disposition)); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&httpBodyWriter{stream: stream, contentType: download.ContentType}, exportChunkSize)
+	if err := download.Copy(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// httpBodyWriter 将写入的内容作为 HttpBody 消息发送到流中.
+type httpBodyWriter struct {
+	stream      grpc.ServerStreamingServer[httpbody.HttpBody]
+	contentType string
+}
+
+func (w *httpBodyWriter) Write(p []byte) (int, error) {
+	// Send 返回前已经完成消息的序列化，因此可以直接引用调用方的缓冲区
+	if err := w.stream.Send(&httpbody.HttpBody{ContentType: w.contentType, Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// InstallExportHandlers 在 grpc-gateway 上注册下载导出文件的接口.
+// grpc-gateway 在流式响应的每个消息之后都会写入换行符，会破坏归档文件的内容，
+// 因此通过 HTTP 处理函数调用 gRPC 接口，并将文件内容原样写入响应.
+func (h *Handler) InstallExportHandlers(mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	client := apiv1.NewMiniBlogClient(conn)
+	for _, pattern := range []string{"/v1/export", "/v1/exports/{jobID}/download"} {
+		if err := mux.HandlePath(http.MethodGet, pattern, serveExport(mux, client, pattern)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serveExport 返回下载导出文件的 HTTP 处理函数. 认证信息等请求头会像 grpc-gateway 生成的接口一样转发给 gRPC 服务.
+func serveExport(mux *runtime.ServeMux, client apiv1.MiniBlogClient, pattern string) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		writeError := func(ctx context.Context, err error) {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, apiv1.MiniBlog_ExportPosts_FullMethodName, runtime.WithHTTPPathPattern(pattern))
+		if err != nil {
+			writeError(r.Context(), err)
+			return
+		}
+
+		var rq apiv1.ExportPostsRequest
+		if err := runtime.PopulateQueryParameters(&rq, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			writeError(ctx, errno.ErrInvalidArgument.WithMessage("%s", err.Error()))
+			return
+		}
+		rq.JobID = pathParams["jobID"]
+
+		stream, err := client.ExportPosts(ctx, &rq)
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+		header, err := stream.Header()
+		if err != nil {
+			writeError(ctx, err)
+			return
+		}
+
+		// 读取第一个消息之后再写入响应头，以便在开始下载之前出错时返回 JSON 格式的错误
+		body, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			writeError(ctx, err)
+			return
+		}
+		if values := header.Get(known.XHTTPContentDisposition); len(values) > 0 {
+			w.Header().Set("Content-Disposition", values[0])
+		}
+		w.Header().Set("Content-Type", body.GetContentType())
+		w.WriteHeader(http.StatusOK)
+
+		for body != nil {
+			if _, err := w.Write(body.GetData()); err != nil {
+				return
+			}
+			if body, err = stream.Recv(); err != nil {
+				if !errors.Is(err, io.EOF) {
+					// 已经开始写入文件内容，只能中断连接，避免客户端将不完整的文件当作下载成功
+					panic(http.ErrAbortHandler)
+				}
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/feed"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+)
+
+// InstallFeedHandlers 在 grpc-gateway 上注册订阅源接口.
+// 订阅源输出的是 XML 而不是 JSON，因此不经过 gRPC 服务，直接作为 HTTP 处理函数注册到网关.
+func (h *Handler) InstallFeedHandlers(mux *runtime.ServeMux) error {
+	handlers := []struct {
+		pattern string
+		format  feed.Format
+	}{
+		{pattern: "/feeds/rss.xml", format: feed.FormatRSS},
+		{pattern: "/feeds/atom.xml", format: feed.FormatAtom},
+		{pattern: "/users/{username}/feed.xml", format: feed.FormatRSS},
+	}
+
+	for _, handler := range handlers {
+		if err := mux.HandlePath(http.MethodGet, handler.pattern, h.serveFeed(mux, handler.format)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serveFeed 返回生成订阅源的 HTTP 处理函数. 路径中没有 username 参数时生成全站订阅源.
+func (h *Handler) serveFeed(mux *runtime.ServeMux, format feed.Format) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		f, err := h.biz.PostV1().Feed(r.Context(), pathParams["username"])
+		if err != nil {
+			// 与 gRPC 接口保持一致的错误响应格式
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		feed.Serve(w, r, f, format, httpx.BaseURL(r, h.proxies))
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// FollowUser 关注用户.
+func (h *Handler) FollowUser(ctx context.Context, rq *apiv1.FollowUserRequest) (*apiv1.FollowUserResponse, error) {
+	return h.biz.UserV1().Follow(ctx, rq)
+}
+
+// UnfollowUser 取消关注用户.
+func (h *Handler) UnfollowUser(ctx context.Context, rq *apiv1.UnfollowUserRequest) (*apiv1.UnfollowUserResponse, error) {
+	return h.biz.UserV1().Unfollow(ctx, rq)
+}
+
+// ListFollowers 列出用户的粉丝.
+func (h *Handler) ListFollowers(ctx context.Context, rq *apiv1.ListFollowersRequest) (*apiv1.ListFollowersResponse, error) {
+	return h.biz.UserV1().ListFollowers(ctx, rq)
+}
+
+// ListFollowing 列出用户关注的用户.
+func (h *Handler) ListFollowing(ctx context.Context, rq *apiv1.ListFollowingRequest) (*apiv1.ListFollowingResponse, error) {
+	return h.biz.UserV1().ListFollowing(ctx, rq)
+}
+
+// GetTimeline 获取当前用户的首页时间线.
+func (h *Handler) GetTimeline(ctx context.Context, rq *apiv1.GetTimelineRequest) (*apiv1.GetTimelineResponse, error) {
+	return h.biz.PostV1().Timeline(ctx, rq)
+}
@@ -16,6 +16,7 @@ package grpc
 
 import (
 	"github.com/jwcen/miniblog/internal/apiserver/biz"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
 	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 )
 
@@ -24,10 +25,13 @@ type Handler struct {
 	apiv1.UnimplementedMiniBlogServer
 
 	biz biz.IBiz
+	// proxies 为可信代理，用于推断订阅源和站点地图中链接的站点地址.
+	proxies httpx.TrustedProxies
 }
 
-func NewHandler(biz biz.IBiz) *Handler {
+func NewHandler(biz biz.IBiz, proxies httpx.TrustedProxies) *Handler {
 	return &Handler{
-		biz: biz,
+		biz:     biz,
+		proxies: proxies,
 	}
 }
@@ -0,0 +1,43 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// UploadMedia 上传媒体文件.
+func (h *Handler) UploadMedia(ctx context.Context, rq *apiv1.UploadMediaRequest) (*apiv1.UploadMediaResponse, error) {
+	return h.biz.MediaV1().Upload(ctx, rq)
+}
+
+// GetMedia 下载媒体文件. 通过 grpc-gateway 访问时直接返回文件内容.
+func (h *Handler) GetMedia(ctx context.Context, rq *apiv1.GetMediaRequest) (*httpbody.HttpBody, error) {
+	return h.biz.MediaV1().Get(ctx, rq)
+}
+
+// DeleteMedia 删除媒体文件.
+func (h *Handler) DeleteMedia(ctx context.Context, rq *apiv1.DeleteMediaRequest) (*apiv1.DeleteMediaResponse, error) {
+	return h.biz.MediaV1().Delete(ctx, rq)
+}
+
+// GetPublicMedia 下载已发布博文中的媒体文件.
+func (h *Handler) GetPublicMedia(ctx context.Context, rq *apiv1.GetPublicMediaRequest) (*httpbody.HttpBody, error) {
+	return h.biz.MediaV1().GetPublic(ctx, rq)
+}
@@ -16,6 +16,10 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
 
 	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 )
@@ -37,10 +41,104 @@ func (h *Handler) DeletePost(ctx context.Context, rq *apiv1.DeletePostRequest) (
 
 // GetPost 获取博客帖子.
 func (h *Handler) GetPost(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.GetPostResponse, error) {
-	return h.biz.PostV1().Get(ctx, rq)
+	resp, err := h.biz.PostV1().Get(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setETag(ctx, resp.GetPost().GetVersion()); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 // ListPost 列出所有博客帖子.
 func (h *Handler) ListPost(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
 	return h.biz.PostV1().List(ctx, rq)
 }
+
+// ListTags 列出所有博客标签.
+func (h *Handler) ListTags(ctx context.Context, rq *apiv1.ListTagsRequest) (*apiv1.ListTagsResponse, error) {
+	return h.biz.PostV1().ListTags(ctx, rq)
+}
+
+// PublishPost 发布博客帖子.
+func (h *Handler) PublishPost(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	return h.biz.PostV1().Publish(ctx, rq)
+}
+
+// UnpublishPost 撤回已发布的博客帖子.
+func (h *Handler) UnpublishPost(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	return h.biz.PostV1().Unpublish(ctx, rq)
+}
+
+// ArchivePost 归档博客帖子.
+func (h *Handler) ArchivePost(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error) {
+	return h.biz.PostV1().Archive(ctx, rq)
+}
+
+// SchedulePost 设置博客帖子的定时发布.
+func (h *Handler) SchedulePost(ctx context.Context, rq *apiv1.SchedulePostRequest) (*apiv1.SchedulePostResponse, error) {
+	return h.biz.PostV1().Schedule(ctx, rq)
+}
+
+// ListTrashedPosts 列出回收站中的博客帖子.
+func (h *Handler) ListTrashedPosts(ctx context.Context, rq *apiv1.ListTrashedPostsRequest) (*apiv1.ListTrashedPostsResponse, error) {
+	return h.biz.PostV1().ListTrashed(ctx, rq)
+}
+
+// RestorePost 从回收站中恢复博客帖子.
+func (h *Handler) RestorePost(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	return h.biz.PostV1().Restore(ctx, rq)
+}
+
+// GetPostStats 获取博客帖子的浏览统计.
+func (h *Handler) GetPostStats(ctx context.Context, rq *apiv1.GetPostStatsRequest) (*apiv1.GetPostStatsResponse, error) {
+	return h.biz.PostV1().GetStats(ctx, rq)
+}
+
+// ListPostRevisions 列出博客帖子的历史版本.
+func (h *Handler) ListPostRevisions(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) (*apiv1.ListPostRevisionsResponse, error) {
+	return h.biz.PostV1().ListRevisions(ctx, rq)
+}
+
+// GetPostRevision 获取博客帖子的历史版本.
+func (h *Handler) GetPostRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	return h.biz.PostV1().GetRevision(ctx, rq)
+}
+
+// DiffPostRevisions 比较博客帖子的两个历史版本.
+func (h *Handler) DiffPostRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) (*apiv1.DiffPostRevisionsResponse, error) {
+	return h.biz.PostV1().DiffRevisions(ctx, rq)
+}
+
+// RestorePostRevision 将博客帖子回滚到指定历史版本.
+func (h *Handler) RestorePostRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	return h.biz.PostV1().RestoreRevision(ctx, rq)
+}
+
+// SearchPosts 全文检索博客帖子.
+func (h *Handler) SearchPosts(ctx context.Context, rq *apiv1.SearchPostsRequest) (*apiv1.SearchPostsResponse, error) {
+	return h.biz.PostV1().Search(ctx, rq)
+}
+
+// ImportPosts 批量导入 Markdown 博客帖子. 每收到一个文件就返回该文件的导入结果.
+func (h *Handler) ImportPosts(stream grpc.BidiStreamingServer[apiv1.ImportPostsRequest, apiv1.ImportPostsResponse]) error {
+	for {
+		rq, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := h.biz.PostV1().Import(stream.Context(), rq)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
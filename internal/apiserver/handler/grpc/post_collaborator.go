@@ -0,0 +1,36 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// AddCollaborator 邀请用户成为博客的协作者.
+func (h *Handler) AddCollaborator(ctx context.Context, rq *apiv1.AddCollaboratorRequest) (*apiv1.AddCollaboratorResponse, error) {
+	return h.biz.PostV1().AddCollaborator(ctx, rq)
+}
+
+// RemoveCollaborator 移除博客的协作者.
+func (h *Handler) RemoveCollaborator(ctx context.Context, rq *apiv1.RemoveCollaboratorRequest) (*apiv1.RemoveCollaboratorResponse, error) {
+	return h.biz.PostV1().RemoveCollaborator(ctx, rq)
+}
+
+// ListCollaborators 列出博客的协作者.
+func (h *Handler) ListCollaborators(ctx context.Context, rq *apiv1.ListCollaboratorsRequest) (*apiv1.ListCollaboratorsResponse, error) {
+	return h.biz.PostV1().ListCollaborators(ctx, rq)
+}
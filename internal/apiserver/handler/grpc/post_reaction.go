@@ -0,0 +1,46 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// LikePost 点赞博客.
+func (h *Handler) LikePost(ctx context.Context, rq *apiv1.LikePostRequest) (*apiv1.LikePostResponse, error) {
+	return h.biz.ReactionV1().Like(ctx, rq)
+}
+
+// UnlikePost 取消点赞博客.
+func (h *Handler) UnlikePost(ctx context.Context, rq *apiv1.UnlikePostRequest) (*apiv1.UnlikePostResponse, error) {
+	return h.biz.ReactionV1().Unlike(ctx, rq)
+}
+
+// AddPostReaction 添加表情回应.
+func (h *Handler) AddPostReaction(ctx context.Context, rq *apiv1.AddPostReactionRequest) (*apiv1.AddPostReactionResponse, error) {
+	return h.biz.ReactionV1().Add(ctx, rq)
+}
+
+// RemovePostReaction 移除表情回应.
+func (h *Handler) RemovePostReaction(ctx context.Context, rq *apiv1.RemovePostReactionRequest) (*apiv1.RemovePostReactionResponse, error) {
+	return h.biz.ReactionV1().Remove(ctx, rq)
+}
+
+// ListPostLikers 列出点赞博客的用户.
+func (h *Handler) ListPostLikers(ctx context.Context, rq *apiv1.ListPostLikersRequest) (*apiv1.ListPostLikersResponse, error) {
+	return h.biz.ReactionV1().ListLikers(ctx, rq)
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// SharePost 将博客分享给指定用户.
+func (h *Handler) SharePost(ctx context.Context, rq *apiv1.SharePostRequest) (*apiv1.SharePostResponse, error) {
+	return h.biz.PostV1().Share(ctx, rq)
+}
+
+// UnsharePost 取消将博客分享给指定用户.
+func (h *Handler) UnsharePost(ctx context.Context, rq *apiv1.UnsharePostRequest) (*apiv1.UnsharePostResponse, error) {
+	return h.biz.PostV1().Unshare(ctx, rq)
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// GetPublicPost 获取已发布的博客帖子.
+func (h *Handler) GetPublicPost(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	return h.biz.PostV1().GetPublic(ctx, rq)
+}
+
+// ListPublicPosts 列出所有已发布的博客帖子.
+func (h *Handler) ListPublicPosts(ctx context.Context, rq *apiv1.ListPublicPostsRequest) (*apiv1.ListPublicPostsResponse, error) {
+	return h.biz.PostV1().ListPublic(ctx, rq)
+}
+
+// ListAuthorPublicPosts 列出指定作者已发布的博客帖子.
+func (h *Handler) ListAuthorPublicPosts(ctx context.Context, rq *apiv1.ListAuthorPublicPostsRequest) (*apiv1.ListAuthorPublicPostsResponse, error) {
+	return h.biz.PostV1().ListAuthorPublic(ctx, rq)
+}
+
+// GetPostBySlug 通过 slug 获取已发布的博客帖子.
+// 请求文章曾经使用过的 slug 时，通过 grpc-gateway 访问的 HTTP 客户端会收到指向当前 slug 的 301 重定向.
+func (h *Handler) GetPostBySlug(ctx context.Context, rq *apiv1.GetPostBySlugRequest) (*apiv1.GetPostBySlugResponse, error) {
+	rsp, err := h.biz.PostV1().GetBySlug(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.GetRedirectSlug() != "" {
+		md := metadata.Pairs(
+			known.XHTTPCode, strconv.Itoa(http.StatusMovedPermanently),
+			known.XHTTPLocation, "/v1/public/posts/slug/"+rsp.GetRedirectSlug(),
+		)
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			return nil, err
+		}
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreateSeries 创建博客系列.
+func (h *Handler) CreateSeries(ctx context.Context, rq *apiv1.CreateSeriesRequest) (*apiv1.CreateSeriesResponse, error) {
+	return h.biz.SeriesV1().Create(ctx, rq)
+}
+
+// UpdateSeries 更新博客系列.
+func (h *Handler) UpdateSeries(ctx context.Context, rq *apiv1.UpdateSeriesRequest) (*apiv1.UpdateSeriesResponse, error) {
+	return h.biz.SeriesV1().Update(ctx, rq)
+}
+
+// ReorderSeries 调整博客系列中博客的顺序.
+func (h *Handler) ReorderSeries(ctx context.Context, rq *apiv1.ReorderSeriesRequest) (*apiv1.ReorderSeriesResponse, error) {
+	return h.biz.SeriesV1().Reorder(ctx, rq)
+}
+
+// GetSeries 获取博客系列详情.
+func (h *Handler) GetSeries(ctx context.Context, rq *apiv1.GetSeriesRequest) (*apiv1.GetSeriesResponse, error) {
+	return h.biz.SeriesV1().Get(ctx, rq)
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/sitemap"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+)
+
+// InstallSitemapHandlers 在 grpc-gateway 上注册站点地图和 robots.txt 接口.
+func (h *Handler) InstallSitemapHandlers(mux *runtime.ServeMux) error {
+	if err := mux.HandlePath(http.MethodGet, "/sitemap.xml", h.serveSitemap(mux)); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, "/robots.txt", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		sitemap.ServeRobots(w, httpx.BaseURL(r, h.proxies))
+	})
+}
+
+// serveSitemap 返回生成站点地图的 HTTP 处理函数.
+func (h *Handler) serveSitemap(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		s, err := h.sitemap(r)
+		if err != nil {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		sitemap.Serve(w, s, httpx.BaseURL(r, h.proxies))
+	}
+}
+
+func (h *Handler) sitemap(r *http.Request) (*sitemap.Sitemap, error) {
+	page, err := sitemap.Page(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.biz.PostV1().Sitemap(r.Context(), page)
+}
@@ -49,7 +49,15 @@ func (h *Handler) DeleteUser(ctx context.Context, rq *apiv1.DeleteUserRequest) (
 }
 
 func (h *Handler) GetUser(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1.GetUserResponse, error) {
-	return h.biz.UserV1().Get(ctx, rq)
+	resp, err := h.biz.UserV1().Get(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setETag(ctx, resp.GetUser().GetVersion()); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (h *Handler) ListUser(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error) {
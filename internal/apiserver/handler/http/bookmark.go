@@ -0,0 +1,35 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+)
+
+// AddBookmark 收藏博客.
+func (h *Handler) AddBookmark(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().AddBookmark, h.val.ValidateAddBookmarkRequest)
+}
+
+// RemoveBookmark 取消收藏博客.
+func (h *Handler) RemoveBookmark(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().RemoveBookmark, h.val.ValidateRemoveBookmarkRequest)
+}
+
+// ListBookmarks 列出当前用户的阅读列表.
+func (h *Handler) ListBookmarks(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListBookmarks, h.val.ValidateListBookmarksRequest)
+}
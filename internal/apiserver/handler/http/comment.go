@@ -0,0 +1,40 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+)
+
+// CreateComment 创建评论.
+func (h *Handler) CreateComment(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.CommentV1().Create, h.val.ValidateCreateCommentRequest)
+}
+
+// UpdateComment 更新评论.
+func (h *Handler) UpdateComment(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.CommentV1().Update, h.val.ValidateUpdateCommentRequest)
+}
+
+// DeleteComment 删除评论.
+func (h *Handler) DeleteComment(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.CommentV1().Delete, h.val.ValidateDeleteCommentRequest)
+}
+
+// ListComment 列出博客帖子的评论.
+func (h *Handler) ListComment(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.CommentV1().List, h.val.ValidateListCommentRequest)
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+)
+
+// withETag 包装获取资源的业务函数，在响应中设置资源版本号对应的 ETag 头，
+// 更新资源时可以通过 If-Match 请求头带上该值.
+func withETag[T any, R any](c *gin.Context, handler func(context.Context, *T) (*R, error), version func(*R) int64) func(context.Context, *T) (*R, error) {
+	return func(ctx context.Context, rq *T) (*R, error) {
+		resp, err := handler(ctx, rq)
+		if err != nil {
+			return nil, err
+		}
+
+		c.Header("ETag", etag.Format(version(resp)))
+		return resp, nil
+	}
+}
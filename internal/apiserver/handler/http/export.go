@@ -0,0 +1,120 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// CreateExportJob 创建博文导出任务.
+func (h *Handler) CreateExportJob(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.ExportV1().CreateJob, h.val.ValidateCreateExportJobRequest)
+}
+
+// GetExportJob 查询博文导出任务.
+func (h *Handler) GetExportJob(c *gin.Context) {
+	var rq apiv1.GetExportJobRequest
+	if err := core.ReadRequest(c, &rq, bindExportJobID(c, &rq.JobID), h.val.ValidateGetExportJobRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	rsp, err := h.biz.ExportV1().GetJob(c.Request.Context(), &rq)
+	core.WriteResponse(c, rsp, err)
+}
+
+// ExportPosts 下载导出文件. 路径中没有 jobID 参数时直接导出当前用户的博文，导出格式由查询参数 format 指定.
+func (h *Handler) ExportPosts(c *gin.Context) {
+	var rq apiv1.ExportPostsRequest
+	if err := core.ReadRequest(c, &rq, bindExportPosts(c, &rq), h.val.ValidateExportPostsRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	download, err := h.biz.ExportV1().Export(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	w := &downloadWriter{c: c, header: func() {
+		c.Header("Content-Type", download.ContentType)
+		c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": download.Filename}))
+		c.Status(http.StatusOK)
+	}}
+	if err := download.Copy(w); err != nil {
+		// 尚未写入任何内容时按 JSON 格式返回错误，否则只能中断响应
+		if !w.written {
+			core.WriteResponse(c, nil, err)
+			return
+		}
+		log.W(c.Request.Context()).Errorw("Failed to write export file", "jobID", rq.GetJobID(), "err", err)
+		c.Abort()
+	}
+}
+
+// downloadWriter 在第一次写入文件内容时才写入响应头，以便在开始下载之前出错时仍然可以返回 JSON 格式的错误.
+type downloadWriter struct {
+	c       *gin.Context
+	header  func()
+	written bool
+}
+
+func (w *downloadWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.header()
+		w.written = true
+	}
+	return w.c.Writer.Write(p)
+}
+
+// bindExportJobID 返回从路径参数中读取 jobID 的绑定函数.
+func bindExportJobID(c *gin.Context, jobID *string) func(any) error {
+	return func(any) error {
+		*jobID = c.Param("jobID")
+		return nil
+	}
+}
+
+// bindExportPosts 返回读取下载导出文件请求的绑定函数. 查询参数 format 可以是导出格式的名称或者数值.
+func bindExportPosts(c *gin.Context, rq *apiv1.ExportPostsRequest) func(any) error {
+	return func(any) error {
+		rq.JobID = c.Param("jobID")
+		format := c.Query("format")
+		if format == "" {
+			return nil
+		}
+		if value, ok := apiv1.ExportFormat_value[format]; ok {
+			rq.Format = apiv1.ExportFormat(value)
+			return nil
+		}
+		value, err := strconv.ParseInt(format, 10, 32)
+		if err != nil {
+			// ReadRequest 会将绑定函数返回的错误转换为 errno.ErrBind
+			return fmt.Errorf("invalid export format %q", format)
+		}
+		rq.Format = apiv1.ExportFormat(value)
+		return nil
+	}
+}
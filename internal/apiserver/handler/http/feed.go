@@ -0,0 +1,49 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/feed"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+)
+
+// RSSFeed 输出全站已发布文章的 RSS 2.0 订阅源.
+func (h *Handler) RSSFeed(c *gin.Context) {
+	h.serveFeed(c, "", feed.FormatRSS)
+}
+
+// AtomFeed 输出全站已发布文章的 Atom 订阅源.
+func (h *Handler) AtomFeed(c *gin.Context) {
+	h.serveFeed(c, "", feed.FormatAtom)
+}
+
+// AuthorFeed 输出指定作者已发布文章的 RSS 2.0 订阅源.
+func (h *Handler) AuthorFeed(c *gin.Context) {
+	h.serveFeed(c, c.Param("username"), feed.FormatRSS)
+}
+
+// serveFeed 生成订阅源并写入响应，出错时按 JSON 格式返回错误.
+func (h *Handler) serveFeed(c *gin.Context, username string, format feed.Format) {
+	f, err := h.biz.PostV1().Feed(c.Request.Context(), username)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	feed.Serve(c.Writer, c.Request, f, format, httpx.BaseURL(c.Request, h.proxies))
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+)
+
+// FollowUser 关注用户.
+func (h *Handler) FollowUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().Follow, h.val.ValidateFollowUserRequest)
+}
+
+// UnfollowUser 取消关注用户.
+func (h *Handler) UnfollowUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.UserV1().Unfollow, h.val.ValidateUnfollowUserRequest)
+}
+
+// ListFollowers 列出用户的粉丝.
+func (h *Handler) ListFollowers(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().ListFollowers, h.val.ValidateListFollowersRequest)
+}
+
+// ListFollowing 列出用户关注的用户.
+func (h *Handler) ListFollowing(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.UserV1().ListFollowing, h.val.ValidateListFollowingRequest)
+}
+
+// GetTimeline 获取当前用户的首页时间线.
+func (h *Handler) GetTimeline(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().Timeline, h.val.ValidateGetTimelineRequest)
+}
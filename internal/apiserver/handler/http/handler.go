@@ -17,18 +17,22 @@ package http
 import (
 	"github.com/jwcen/miniblog/internal/apiserver/biz"
 	"github.com/jwcen/miniblog/internal/apiserver/pkg/validation"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
 )
 
 // Handler 处理博客模块的请求.
 type Handler struct {
 	biz biz.IBiz
 	val *validation.Validator
+	// proxies 为可信代理，用于推断订阅源和站点地图中链接的站点地址.
+	proxies httpx.TrustedProxies
 }
 
 // NewHandler 创建新的 Handler 实例.
-func NewHandler(biz biz.IBiz, val *validation.Validator) *Handler {
+func NewHandler(biz biz.IBiz, val *validation.Validator, proxies httpx.TrustedProxies) *Handler {
 	return &Handler{
-		biz: biz,
-		val: val,
+		biz:     biz,
+		val:     val,
+		proxies: proxies,
 	}
 }
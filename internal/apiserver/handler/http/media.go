@@ -0,0 +1,83 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// UploadMedia 上传媒体文件，文件内容以 base64 编码放在 JSON 请求体的 data 字段中.
+func (h *Handler) UploadMedia(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.MediaV1().Upload, h.val.ValidateUploadMediaRequest)
+}
+
+// GetMedia 下载媒体文件.
+func (h *Handler) GetMedia(c *gin.Context) {
+	var rq apiv1.GetMediaRequest
+	if err := core.ReadRequest(c, &rq, bindMediaID(c, &rq.MediaID), h.val.ValidateGetMediaRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	body, err := h.biz.MediaV1().Get(c.Request.Context(), &rq)
+	writeHTTPBody(c, body, err)
+}
+
+// DeleteMedia 删除媒体文件.
+func (h *Handler) DeleteMedia(c *gin.Context) {
+	var rq apiv1.DeleteMediaRequest
+	if err := core.ReadRequest(c, &rq, bindMediaID(c, &rq.MediaID), h.val.ValidateDeleteMediaRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	rsp, err := h.biz.MediaV1().Delete(c.Request.Context(), &rq)
+	core.WriteResponse(c, rsp, err)
+}
+
+// GetPublicMedia 下载已发布博文中的媒体文件.
+func (h *Handler) GetPublicMedia(c *gin.Context) {
+	var rq apiv1.GetPublicMediaRequest
+	if err := core.ReadRequest(c, &rq, bindMediaID(c, &rq.MediaID), h.val.ValidateGetPublicMediaRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	body, err := h.biz.MediaV1().GetPublic(c.Request.Context(), &rq)
+	writeHTTPBody(c, body, err)
+}
+
+// bindMediaID 返回从路径参数中读取 mediaID 的绑定函数，proto 生成的结构体没有 uri 标签.
+func bindMediaID(c *gin.Context, mediaID *string) func(any) error {
+	return func(any) error {
+		*mediaID = c.Param("mediaID")
+		return nil
+	}
+}
+
+// writeHTTPBody 将文件内容按原始格式写入响应，出错时按 JSON 格式返回错误.
+func writeHTTPBody(c *gin.Context, body *httpbody.HttpBody, err error) {
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	c.Data(http.StatusOK, body.GetContentType(), body.GetData())
+}
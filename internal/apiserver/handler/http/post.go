@@ -17,6 +17,8 @@ package http
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 )
 
 // CreatePost 创建博客帖子.
@@ -36,10 +38,78 @@ func (h *Handler) DeletePost(c *gin.Context) {
 
 // GetPost 获取博客帖子.
 func (h *Handler) GetPost(c *gin.Context) {
-	core.HandleUriRequest(c, h.biz.PostV1().Get, h.val.ValidateGetPostRequest)
+	getPost := withETag(c, h.biz.PostV1().Get, func(resp *apiv1.GetPostResponse) int64 {
+		return resp.GetPost().GetVersion()
+	})
+	core.HandleUriRequest(c, getPost, h.val.ValidateGetPostRequest)
 }
 
 // ListPosts 列出用户的所有博客帖子.
 func (h *Handler) ListPost(c *gin.Context) {
 	core.HandleQueryRequest(c, h.biz.PostV1().List, h.val.ValidateListPostRequest)
 }
+
+// ListTags 列出用户的所有博客标签.
+func (h *Handler) ListTags(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListTags)
+}
+
+// PublishPost 发布博客帖子.
+func (h *Handler) PublishPost(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().Publish, h.val.ValidatePublishPostRequest)
+}
+
+// UnpublishPost 撤回已发布的博客帖子.
+func (h *Handler) UnpublishPost(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().Unpublish, h.val.ValidateUnpublishPostRequest)
+}
+
+// ArchivePost 归档博客帖子.
+func (h *Handler) ArchivePost(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().Archive, h.val.ValidateArchivePostRequest)
+}
+
+// SchedulePost 设置博客帖子的定时发布.
+func (h *Handler) SchedulePost(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().Schedule, h.val.ValidateSchedulePostRequest)
+}
+
+// ListTrashedPosts 列出回收站中的博客帖子.
+func (h *Handler) ListTrashedPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListTrashed, h.val.ValidateListTrashedPostsRequest)
+}
+
+// RestorePost 从回收站中恢复博客帖子.
+func (h *Handler) RestorePost(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().Restore, h.val.ValidateRestorePostRequest)
+}
+
+// GetPostStats 获取博客帖子的浏览统计.
+func (h *Handler) GetPostStats(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().GetStats, h.val.ValidateGetPostStatsRequest)
+}
+
+// ListPostRevisions 列出博客帖子的历史版本.
+func (h *Handler) ListPostRevisions(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListRevisions, h.val.ValidateListPostRevisionsRequest)
+}
+
+// GetPostRevision 获取博客帖子的历史版本.
+func (h *Handler) GetPostRevision(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().GetRevision, h.val.ValidateGetPostRevisionRequest)
+}
+
+// DiffPostRevisions 比较博客帖子的两个历史版本.
+func (h *Handler) DiffPostRevisions(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().DiffRevisions, h.val.ValidateDiffPostRevisionsRequest)
+}
+
+// RestorePostRevision 将博客帖子回滚到指定历史版本.
+func (h *Handler) RestorePostRevision(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().RestoreRevision, h.val.ValidateRestorePostRevisionRequest)
+}
+
+// SearchPosts 全文检索博客帖子.
+func (h *Handler) SearchPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().Search, h.val.ValidateSearchPostsRequest)
+}
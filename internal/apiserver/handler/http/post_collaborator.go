@@ -0,0 +1,35 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+)
+
+// AddCollaborator 邀请用户成为博客的协作者.
+func (h *Handler) AddCollaborator(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PostV1().AddCollaborator, h.val.ValidateAddCollaboratorRequest)
+}
+
+// RemoveCollaborator 移除博客的协作者.
+func (h *Handler) RemoveCollaborator(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().RemoveCollaborator, h.val.ValidateRemoveCollaboratorRequest)
+}
+
+// ListCollaborators 列出博客的协作者.
+func (h *Handler) ListCollaborators(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().ListCollaborators, h.val.ValidateListCollaboratorsRequest)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+	"github.com/onexstack/onexstack/pkg/errorsx"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// importResult 是流式响应中的一行，与 grpc-gateway 流式接口的响应格式保持一致.
+type importResult struct {
+	Result *apiv1.ImportPostsResponse `json:"result,omitempty"`
+	Error  *core.ErrorResponse        `json:"error,omitempty"`
+}
+
+// ImportPosts 批量导入 Markdown 博客帖子.
+// 请求体是由多个 ImportPostsRequest JSON 对象组成的流，响应按行返回每个文件的导入结果.
+func (h *Handler) ImportPosts(c *gin.Context) {
+	ctx := c.Request.Context()
+	decoder := json.NewDecoder(c.Request.Body)
+	encoder := json.NewEncoder(c.Writer)
+
+	// HTTP/1.x 默认在开始写响应后禁止继续读取请求体，需要开启全双工才能边读取文件边返回导入结果
+	_ = http.NewResponseController(c.Writer).EnableFullDuplex()
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+
+	writeError := func(err error) {
+		errx := errorsx.FromError(err)
+		_ = encoder.Encode(importResult{Error: &core.ErrorResponse{Reason: errx.Reason, Message: errx.Message}})
+	}
+
+	for {
+		var rq apiv1.ImportPostsRequest
+		if err := decoder.Decode(&rq); err != nil {
+			if !errors.Is(err, io.EOF) {
+				writeError(errno.ErrBind.WithMessage("%s", err.Error()))
+			}
+			return
+		}
+
+		resp, err := h.biz.PostV1().Import(ctx, &rq)
+		if err != nil {
+			writeError(err)
+			return
+		}
+		if err := encoder.Encode(importResult{Result: resp}); err != nil {
+			return
+		}
+		c.Writer.Flush()
+	}
+}
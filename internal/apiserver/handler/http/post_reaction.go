@@ -0,0 +1,45 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+)
+
+// LikePost 点赞博客.
+func (h *Handler) LikePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.ReactionV1().Like, h.val.ValidateLikePostRequest)
+}
+
+// UnlikePost 取消点赞博客.
+func (h *Handler) UnlikePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.ReactionV1().Unlike, h.val.ValidateUnlikePostRequest)
+}
+
+// AddPostReaction 添加表情回应.
+func (h *Handler) AddPostReaction(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.ReactionV1().Add, h.val.ValidateAddPostReactionRequest)
+}
+
+// RemovePostReaction 移除表情回应.
+func (h *Handler) RemovePostReaction(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.ReactionV1().Remove, h.val.ValidateRemovePostReactionRequest)
+}
+
+// ListPostLikers 列出点赞博客的用户.
+func (h *Handler) ListPostLikers(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.ReactionV1().ListLikers, h.val.ValidateListPostLikersRequest)
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// GetPublicPost 获取已发布的博客帖子.
+func (h *Handler) GetPublicPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().GetPublic, h.val.ValidateGetPublicPostRequest)
+}
+
+// ListPublicPosts 列出所有已发布的博客帖子.
+func (h *Handler) ListPublicPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListPublic, h.val.ValidateListPublicPostsRequest)
+}
+
+// ListAuthorPublicPosts 列出指定作者已发布的博客帖子.
+func (h *Handler) ListAuthorPublicPosts(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().ListAuthorPublic, h.val.ValidateListAuthorPublicPostsRequest)
+}
+
+// GetPostBySlug 通过 slug 获取已发布的博客帖子，请求文章曾经使用过的 slug 时重定向到当前 slug.
+func (h *Handler) GetPostBySlug(c *gin.Context) {
+	var rq apiv1.GetPostBySlugRequest
+	// proto 生成的结构体没有 uri 标签，这里直接从路径参数中读取 slug
+	bindSlug := func(any) error {
+		rq.Slug = c.Param("slug")
+		return nil
+	}
+	if err := core.ReadRequest(c, &rq, bindSlug, h.val.ValidateGetPostBySlugRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	rsp, err := h.biz.PostV1().GetBySlug(c.Request.Context(), &rq)
+	if err == nil && rsp.GetRedirectSlug() != "" {
+		c.Redirect(http.StatusMovedPermanently, "/v1/public/posts/slug/"+rsp.GetRedirectSlug())
+		return
+	}
+	core.WriteResponse(c, rsp, err)
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+)
+
+// CreateSeries 创建博客系列.
+func (h *Handler) CreateSeries(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.SeriesV1().Create, h.val.ValidateCreateSeriesRequest)
+}
+
+// UpdateSeries 更新博客系列.
+func (h *Handler) UpdateSeries(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.SeriesV1().Update, h.val.ValidateUpdateSeriesRequest)
+}
+
+// ReorderSeries 调整博客系列中博客的顺序.
+func (h *Handler) ReorderSeries(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.SeriesV1().Reorder, h.val.ValidateReorderSeriesRequest)
+}
+
+// GetSeries 获取博客系列详情.
+func (h *Handler) GetSeries(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SeriesV1().Get, h.val.ValidateGetSeriesRequest)
+}
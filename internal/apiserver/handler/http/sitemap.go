@@ -0,0 +1,45 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/sitemap"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+)
+
+// Sitemap 输出站点地图，地址过多时输出站点地图索引.
+func (h *Handler) Sitemap(c *gin.Context) {
+	page, err := sitemap.Page(c.Request)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	s, err := h.biz.PostV1().Sitemap(c.Request.Context(), page)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	sitemap.Serve(c.Writer, s, httpx.BaseURL(c.Request, h.proxies))
+}
+
+// Robots 输出 robots.txt.
+func (h *Handler) Robots(c *gin.Context) {
+	sitemap.ServeRobots(c.Writer, httpx.BaseURL(c.Request, h.proxies))
+}
@@ -17,6 +17,8 @@ package http
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 )
 
 func (h *Handler) Login(c *gin.Context) {
@@ -50,7 +52,10 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 
 // GetUser 获取用户信息.
 func (h *Handler) GetUser(c *gin.Context) {
-	core.HandleUriRequest(c, h.biz.UserV1().Get)
+	getUser := withETag(c, h.biz.UserV1().Get, func(resp *apiv1.GetUserResponse) int64 {
+		return resp.GetUser().GetVersion()
+	})
+	core.HandleUriRequest(c, getUser)
 }
 
 // ListUser 列出用户信息.
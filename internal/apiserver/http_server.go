@@ -35,7 +35,7 @@ var _ server.Server = (*ginServer)(nil)
 func (c *ServerConfig) NewGinServer() server.Server {
 	engine := gin.New()
 	// 注册全局中间件，用于恢复 panic、设置 HTTP 头、添加请求 ID 等
-	engine.Use(gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.RequestIDMiddleware())
+	engine.Use(gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.RequestIDMiddleware(), mw.ClientIPMiddleware(c.cfg.TrustedProxies), mw.IfMatchMiddleware())
 
 	// 注册 REST API 路由
 	c.InstallRESTAPI(engine)
@@ -59,7 +59,7 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 	InstallGenericAPI(engine)
 
 	// 创建核心业务处理器
-	handler := handler.NewHandler(c.biz, c.val)
+	handler := handler.NewHandler(c.biz, c.val, c.cfg.TrustedProxies)
 
 	// 注册健康检查接口
 	engine.GET("/healthz", handler.Healthz)
@@ -71,26 +71,136 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine) {
 		mw.AuthzMiddleware(c.authz),
 	}
 
+	// 订阅源接口只包含已发布的博客，无需认证和授权
+	engine.GET("/feeds/rss.xml", handler.RSSFeed)               // 全站 RSS 2.0 订阅源
+	engine.GET("/feeds/atom.xml", handler.AtomFeed)             // 全站 Atom 订阅源
+	engine.GET("/users/:username/feed.xml", handler.AuthorFeed) // 指定作者的 RSS 2.0 订阅源
+	engine.GET("/sitemap.xml", handler.Sitemap)                 // 站点地图
+	engine.GET("/robots.txt", handler.Robots)                   // 爬虫协议
+
 	v1 := engine.Group("/v1")
 	{
+		// 公开接口只返回已发布的博客，无需认证和授权
+		publicv1 := v1.Group("/public")
+		{
+			publicv1.GET("posts/:postID", handler.GetPublicPost)                 // 查询已发布的博客详情
+			publicv1.GET("posts", handler.ListPublicPosts)                       // 查询已发布的博客列表
+			publicv1.GET("users/:username/posts", handler.ListAuthorPublicPosts) // 查询指定作者已发布的博客列表
+			publicv1.GET("posts/slug/:slug", handler.GetPostBySlug)              // 通过 slug 查询已发布的博客详情
+			publicv1.GET("media/:mediaID", handler.GetPublicMedia)               // 下载已发布博客中的媒体文件
+		}
+
 		userv1 := v1.Group("/users")
 		{
 			userv1.POST("", handler.CreateUser)
 			userv1.Use(authMiddlewares...)
 			userv1.PUT(":userID/change-password", handler.ChangePassword)
 			userv1.PUT(":userID", handler.UpdateUser)
+			userv1.PATCH(":userID", handler.UpdateUser)
 			userv1.DELETE(":userID", handler.DeleteUser)
 			userv1.GET(":userID", handler.GetUser)
 			userv1.GET("", handler.ListUser)
+			userv1.GET(":userID/followers", handler.ListFollowers)
+			userv1.GET(":userID/following", handler.ListFollowing)
 		}
 
 		postv1 := v1.Group("/posts", authMiddlewares...)
 		{
-			postv1.POST("", handler.CreatePost)       // 创建博客
-			postv1.PUT(":postID", handler.UpdatePost) // 更新博客
-			postv1.DELETE("", handler.DeletePost)     // 删除博客
-			postv1.GET(":postID", handler.GetPost)    // 查询博客详情
-			postv1.GET("", handler.ListPost)          // 查询博客列表
+			postv1.POST("", handler.CreatePost)                                             // 创建博客
+			postv1.PUT(":postID", handler.UpdatePost)                                       // 更新博客
+			postv1.PATCH(":postID", handler.UpdatePost)                                     // 部分更新博客
+			postv1.DELETE("", handler.DeletePost)                                           // 删除博客
+			postv1.GET(":postID", handler.GetPost)                                          // 查询博客详情
+			postv1.GET("", handler.ListPost)                                                // 查询博客列表
+			postv1.POST(":postID/publish", handler.PublishPost)                             // 发布博客
+			postv1.POST(":postID/unpublish", handler.UnpublishPost)                         // 撤回发布
+			postv1.POST(":postID/archive", handler.ArchivePost)                             // 归档博客
+			postv1.POST(":postID/schedule", handler.SchedulePost)                           // 定时发布博客
+			postv1.GET(":postID/revisions", handler.ListPostRevisions)                      // 查询博客历史版本列表
+			postv1.GET(":postID/revisions/:revision", handler.GetPostRevision)              // 查询博客历史版本详情
+			postv1.GET(":postID/diff", handler.DiffPostRevisions)                           // 比较博客历史版本
+			postv1.GET(":postID/stats", handler.GetPostStats)                               // 查询博客浏览统计
+			postv1.POST(":postID/revisions/:revision/restore", handler.RestorePostRevision) // 回滚博客到历史版本
+			postv1.POST(":postID/comments", handler.CreateComment)                          // 创建评论
+			postv1.PUT(":postID/comments/:commentID", handler.UpdateComment)                // 更新评论
+			postv1.DELETE(":postID/comments/:commentID", handler.DeleteComment)             // 删除评论
+			postv1.GET(":postID/comments", handler.ListComment)                             // 查询评论列表
+			postv1.POST(":postID/media", handler.UploadMedia)                               // 上传媒体文件
+			postv1.PUT(":postID/like", handler.LikePost)                                    // 点赞博客
+			postv1.DELETE(":postID/like", handler.UnlikePost)                               // 取消点赞博客
+			postv1.PUT(":postID/reactions/:reaction", handler.AddPostReaction)              // 添加表情回应
+			postv1.DELETE(":postID/reactions/:reaction", handler.RemovePostReaction)        // 移除表情回应
+			postv1.GET(":postID/likers", handler.ListPostLikers)                            // 查询博客点赞用户列表
+			postv1.PUT(":postID/shares/:userID", handler.SharePost)                         // 分享博客给指定用户
+			postv1.DELETE(":postID/shares/:userID", handler.UnsharePost)                    // 取消分享博客
+			postv1.PUT(":postID/collaborators/:userID", handler.AddCollaborator)            // 邀请博客协作者
+			postv1.DELETE(":postID/collaborators/:userID", handler.RemoveCollaborator)      // 移除博客协作者
+			postv1.GET(":postID/collaborators", handler.ListCollaborators)                  // 查询博客协作者列表
+		}
+
+		tagv1 := v1.Group("/tags", authMiddlewares...)
+		{
+			tagv1.GET("", handler.ListTags) // 查询标签列表
+		}
+
+		mediav1 := v1.Group("/media", authMiddlewares...)
+		{
+			mediav1.GET(":mediaID", handler.GetMedia)       // 下载媒体文件
+			mediav1.DELETE(":mediaID", handler.DeleteMedia) // 删除媒体文件
+		}
+
+		followingv1 := v1.Group("/following", authMiddlewares...)
+		{
+			followingv1.PUT(":userID", handler.FollowUser)      // 关注用户
+			followingv1.DELETE(":userID", handler.UnfollowUser) // 取消关注用户
+		}
+
+		timelinev1 := v1.Group("/timeline", authMiddlewares...)
+		{
+			timelinev1.GET("", handler.GetTimeline) // 查询首页时间线
+		}
+
+		seriesv1 := v1.Group("/series", authMiddlewares...)
+		{
+			seriesv1.POST("", handler.CreateSeries)                // 创建博客系列
+			seriesv1.PUT(":seriesID", handler.UpdateSeries)        // 更新博客系列
+			seriesv1.PUT(":seriesID/order", handler.ReorderSeries) // 调整博客系列中博客的顺序
+			seriesv1.GET(":seriesID", handler.GetSeries)           // 查询博客系列详情
+		}
+
+		trashv1 := v1.Group("/trash", authMiddlewares...)
+		{
+			trashv1.GET("posts", handler.ListTrashedPosts)             // 查询回收站中的博客列表
+			trashv1.POST("posts/:postID/restore", handler.RestorePost) // 从回收站恢复博客
+		}
+
+		importv1 := v1.Group("/import", authMiddlewares...)
+		{
+			importv1.POST("posts", handler.ImportPosts) // 批量导入 Markdown 博客
+		}
+
+		exportv1 := v1.Group("/exports", authMiddlewares...)
+		{
+			exportv1.POST("", handler.CreateExportJob)           // 创建博客导出任务
+			exportv1.GET(":jobID", handler.GetExportJob)         // 查询博客导出任务
+			exportv1.GET(":jobID/download", handler.ExportPosts) // 下载导出任务生成的文件
+		}
+
+		directExportv1 := v1.Group("/export", authMiddlewares...)
+		{
+			directExportv1.GET("", handler.ExportPosts) // 直接导出当前用户的博客
+		}
+
+		bookmarkv1 := v1.Group("/bookmarks", authMiddlewares...)
+		{
+			bookmarkv1.PUT(":postID", handler.AddBookmark)       // 收藏博客
+			bookmarkv1.DELETE(":postID", handler.RemoveBookmark) // 取消收藏博客
+			bookmarkv1.GET("", handler.ListBookmarks)            // 查询阅读列表
+		}
+
+		searchv1 := v1.Group("/search", authMiddlewares...)
+		{
+			searchv1.GET("posts", handler.SearchPosts) // 全文检索博客
 		}
 	}
 }
@@ -0,0 +1,76 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/authz"
+	"k8s.io/utils/clock"
+
+	"github.com/jwcen/miniblog/internal/apiserver/biz"
+	postv1 "github.com/jwcen/miniblog/internal/apiserver/biz/v1/post"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/apiserver/wpimport"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// PostImporter 将带 YAML front matter 的 Markdown 文件导入为博客.
+type PostImporter interface {
+	Import(ctx context.Context, rq *apiv1.ImportPostsRequest) (*apiv1.ImportPostsResponse, error)
+}
+
+// NewPostImporter 创建一个直接读写数据库的博客导入器，供命令行导入工具使用.
+func (cfg *Config) NewPostImporter() (PostImporter, error) {
+	db, err := cfg.NewDB()
+	if err != nil {
+		return nil, err
+	}
+
+	store := store.NewStore(db)
+	searcher, err := cfg.NewSearcher(store)
+	if err != nil {
+		return nil, err
+	}
+
+	return postv1.New(store, searcher, render.NewRenderer(), viewcount.NewCounter(store, clock.RealClock{})), nil
+}
+
+// NewWordPressImporter 创建一个直接读写数据库的 WordPress 导入器，供命令行导入工具使用.
+func (cfg *Config) NewWordPressImporter(opts wpimport.Options) (*wpimport.Importer, error) {
+	db, err := cfg.NewDB()
+	if err != nil {
+		return nil, err
+	}
+
+	store := store.NewStore(db)
+	authorizer, err := authz.NewAuthz(db, authz.DefaultOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	searcher, err := cfg.NewSearcher(store)
+	if err != nil {
+		return nil, err
+	}
+
+	// 导入时以作者的身份设置定时发布，需要按用户 ID 隔离数据
+	registerTenant()
+
+	// 导入不涉及媒体文件，不需要连接对象存储
+	bizBiz := biz.NewBiz(store, authorizer, searcher, render.NewRenderer(), nil, viewcount.NewCounter(store, clock.RealClock{}))
+	return wpimport.New(bizBiz, store, opts), nil
+}
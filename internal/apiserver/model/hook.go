@@ -20,9 +20,51 @@ import (
 	"gorm.io/gorm"
 )
 
+// BeforeCreate 在创建数据库记录之前设置初始版本号.
+// AfterCreate 会使用 Save 写回全部字段，因此不能依赖数据库的默认值.
+func (m *PostM) BeforeCreate(tx *gorm.DB) error {
+	if m.Version == 0 {
+		m.Version = 1
+	}
+
+	return nil
+}
+
 // AfterCreate 在创建数据库记录之后生成 postID.
+// 标题无法生成 slug 时，使用 postID 作为 slug.
 func (m *PostM) AfterCreate(tx *gorm.DB) error {
 	m.PostID = rid.PostID.New(uint64(m.ID))
+	if m.Slug == "" {
+		m.Slug = m.PostID
+	}
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 commentID.
+func (m *CommentM) AfterCreate(tx *gorm.DB) error {
+	m.CommentID = rid.CommentID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 jobID.
+func (m *ExportJobM) AfterCreate(tx *gorm.DB) error {
+	m.JobID = rid.ExportJobID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 mediaID.
+func (m *MediaM) AfterCreate(tx *gorm.DB) error {
+	m.MediaID = rid.MediaID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 seriesID.
+func (m *SeriesM) AfterCreate(tx *gorm.DB) error {
+	m.SeriesID = rid.SeriesID.New(uint64(m.ID))
 
 	return tx.Save(m).Error
 }
@@ -34,8 +76,12 @@ func (m *UserM) AfterCreate(tx *gorm.DB) error {
 	return tx.Save(m).Error
 }
 
-// BeforeCreate 在创建数据库记录之前加密明文密码.
+// BeforeCreate 在创建数据库记录之前加密明文密码，并设置初始版本号.
 func (m *UserM) BeforeCreate(tx *gorm.DB) error {
+	if m.Version == 0 {
+		m.Version = 1
+	}
+
 	// Encrypt the user password.
 	var err error
 	m.Password, err = auth.Encrypt(m.Password)
@@ -20,19 +20,30 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 const TableNamePostM = "post"
 
 // PostM 博文表
 type PostM struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                  // 用户唯一 ID
-	PostID    string    `gorm:"column:postID;not null;uniqueIndex:idx_post_postID;comment:博文唯一 ID" json:"postID"`      // 博文唯一 ID
-	Title     string    `gorm:"column:title;not null;comment:博文标题" json:"title"`                                       // 博文标题
-	Content   string    `gorm:"column:content;not null;comment:博文内容" json:"content"`                                   // 博文内容
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp;comment:博文创建时间" json:"createdAt"`   // 博文创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp;comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
+	ID            int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID        string         `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                                          // 用户唯一 ID
+	PostID        string         `gorm:"column:postID;not null;uniqueIndex:idx_post_postID;comment:博文唯一 ID" json:"postID"`                              // 博文唯一 ID
+	Title         string         `gorm:"column:title;not null;comment:博文标题" json:"title"`                                                               // 博文标题
+	Slug          string         `gorm:"column:slug;not null;uniqueIndex:idx_post_slug;comment:博文 URL 中使用的可读标识" json:"slug"`                            // 博文 URL 中使用的可读标识
+	Content       string         `gorm:"column:content;not null;comment:博文内容" json:"content"`                                                           // 博文内容
+	ContentFormat int32          `gorm:"column:contentFormat;not null;comment:博文内容格式：0-Markdown，1-纯文本，2-HTML" json:"contentFormat"`                     // 博文内容格式：0-Markdown，1-纯文本，2-HTML
+	Revision      int64          `gorm:"column:revision;not null;comment:博文当前的版本号" json:"revision"`                                                     // 博文当前的版本号
+	Status        int32          `gorm:"column:status;not null;index:idx_post_status_publishAt,priority:1;comment:博文状态：0-草稿，1-已发布，2-已归档" json:"status"` // 博文状态：0-草稿，1-已发布，2-已归档
+	Visibility    int32          `gorm:"column:visibility;not null;comment:博文可见范围：0-公开，1-不公开列出，2-私密，3-仅分享给指定用户" json:"visibility"`                      // 博文可见范围：0-公开，1-不公开列出，2-私密，3-仅分享给指定用户
+	PublishedAt   *time.Time     `gorm:"column:publishedAt;comment:博文最近一次发布时间" json:"publishedAt"`                                                      // 博文最近一次发布时间
+	PublishAt     *time.Time     `gorm:"column:publishAt;index:idx_post_status_publishAt,priority:2;comment:博文定时发布时间" json:"publishAt"`                 // 博文定时发布时间
+	Version       int64          `gorm:"column:version;not null;default:1;comment:博文数据版本号，每次修改博文时递增，用于检测并发修改" json:"version"`                           // 博文数据版本号，每次修改博文时递增，用于检测并发修改
+	CreatedAt     time.Time      `gorm:"column:createdAt;not null;default:current_timestamp;comment:博文创建时间" json:"createdAt"`                           // 博文创建时间
+	UpdatedAt     time.Time      `gorm:"column:updatedAt;not null;default:current_timestamp;comment:博文最后修改时间" json:"updatedAt"`                         // 博文最后修改时间
+	DeletedAt     gorm.DeletedAt `gorm:"column:deletedAt;index:idx_post_deletedAt;comment:博文移入回收站的时间，为空表示未删除" json:"deletedAt"`                         // 博文移入回收站的时间，为空表示未删除
 }
 
 // TableName PostM's table name
@@ -0,0 +1,35 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blob 定义了媒体文件等二进制对象的存储接口，并提供本地文件系统和 S3 兼容对象存储两种实现.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound 表示指定键的对象不存在.
+var ErrNotFound = errors.New("blob: object not found")
+
+// BlobStore 定义了二进制对象存储需要实现的方法. 键使用 / 分隔层级，由调用方保证唯一.
+type BlobStore interface {
+	// Put 写入对象，size 为对象的字节数，已存在的对象会被覆盖.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get 读取对象，对象不存在时返回 ErrNotFound. 调用方负责关闭返回的 io.ReadCloser.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，对象不存在时不返回错误.
+	Delete(ctx context.Context, key string) error
+}
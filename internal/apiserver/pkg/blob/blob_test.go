@@ -0,0 +1,184 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+)
+
+func TestBlobStore(t *testing.T) {
+	s3 := newFakeS3(t)
+
+	tests := []struct {
+		name  string
+		store func(t *testing.T) blob.BlobStore
+	}{
+		{
+			name: "local",
+			store: func(t *testing.T) blob.BlobStore {
+				store, err := blob.NewLocalStore(t.TempDir())
+				require.NoError(t, err)
+				return store
+			},
+		},
+		{
+			name: "s3",
+			store: func(t *testing.T) blob.BlobStore {
+				store, err := blob.NewS3Store(&blob.S3Options{
+					Endpoint:        strings.TrimPrefix(s3.URL, "http://"),
+					Bucket:          "miniblog",
+					Region:          "us-east-1",
+					AccessKeyID:     "minioadmin",
+					SecretAccessKey: "minioadmin",
+				})
+				require.NoError(t, err)
+				return store
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := tt.store(t)
+			data := []byte("\x89PNG\r\n\x1a\n fake image")
+
+			_, err := store.Get(ctx, "user-1/media-1")
+			assert.ErrorIs(t, err, blob.ErrNotFound)
+
+			require.NoError(t, store.Put(ctx, "user-1/media-1", bytes.NewReader(data), int64(len(data)), "image/png"))
+
+			rc, err := store.Get(ctx, "user-1/media-1")
+			require.NoError(t, err)
+			got, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			assert.Equal(t, data, got)
+
+			require.NoError(t, store.Delete(ctx, "user-1/media-1"))
+			_, err = store.Get(ctx, "user-1/media-1")
+			assert.ErrorIs(t, err, blob.ErrNotFound)
+
+			// 删除不存在的对象不报错
+			assert.NoError(t, store.Delete(ctx, "user-1/media-1"))
+		})
+	}
+}
+
+func TestLocalStore_InvalidKey(t *testing.T) {
+	store, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "a/../../escape", "a//b"} {
+		err := store.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain")
+		assert.Error(t, err, key)
+	}
+}
+
+// newFakeS3 启动一个最小化的 S3 替身服务，只支持单个对象的上传、下载、查询和删除，用于在没有真实对象存储的环境下测试 S3Store.
+func newFakeS3(t *testing.T) *httptest.Server {
+	var (
+		mu      sync.Mutex
+		objects = map[string][]byte{}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := r.URL.Path
+		switch r.Method {
+		case http.MethodPut:
+			body, err := readS3Body(r)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[key] = body
+			w.Header().Set("ETag", `"fake"`)
+		case http.MethodGet, http.MethodHead:
+			data, ok := objects[key]
+			if !ok {
+				w.Header().Set("Content-Type", "application/xml")
+				w.WriteHeader(http.StatusNotFound)
+				if r.Method == http.MethodGet {
+					_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+				}
+				return
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.Header().Set("ETag", `"fake"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			if r.Method == http.MethodGet {
+				_, _ = w.Write(data)
+			}
+		case http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// readS3Body 读取上传的对象内容. 通过 HTTP 访问时，minio 客户端使用 aws-chunked 编码分块上传，需要去掉每个分块的签名头.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body []byte
+	reader := bufio.NewReader(r.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.SplitN(strings.TrimSpace(line), ";", 2)[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return body, nil
+		}
+		chunk := make([]byte, size+2) // 分块数据后跟随 \r\n
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		body = append(body, chunk[:size]...)
+	}
+}
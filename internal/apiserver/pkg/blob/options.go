@@ -0,0 +1,105 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// LocalBackend 表示使用本地文件系统存储对象.
+	LocalBackend = "local"
+	// S3Backend 表示使用 S3 兼容的对象存储（例如 AWS S3、MinIO）存储对象.
+	S3Backend = "s3"
+)
+
+// Options 包含对象存储的配置选项.
+type Options struct {
+	// Backend 指定存储后端，可选值为 local 和 s3.
+	Backend string `json:"backend" mapstructure:"backend"`
+	// LocalDir 指定 local 后端保存对象的根目录.
+	LocalDir string `json:"local-dir" mapstructure:"local-dir"`
+	// S3 包含 s3 后端的配置选项.
+	S3 *S3Options `json:"s3" mapstructure:"s3"`
+}
+
+// S3Options 包含 S3 兼容对象存储的配置选项.
+type S3Options struct {
+	// Endpoint 为对象存储服务的地址，格式为 host:port，不包含协议.
+	Endpoint string `json:"endpoint" mapstructure:"endpoint"`
+	// Bucket 为保存对象的存储桶，需要预先创建.
+	Bucket string `json:"bucket" mapstructure:"bucket"`
+	// Region 为存储桶所在的区域.
+	Region string `json:"region" mapstructure:"region"`
+	// AccessKeyID 和 SecretAccessKey 为访问对象存储的凭证.
+	AccessKeyID     string `json:"access-key-id" mapstructure:"access-key-id"`
+	SecretAccessKey string `json:"secret-access-key" mapstructure:"secret-access-key"`
+	// UseSSL 指示是否使用 HTTPS 访问对象存储.
+	UseSSL bool `json:"use-ssl" mapstructure:"use-ssl"`
+}
+
+// NewOptions 创建带有默认值的 Options 实例.
+func NewOptions() *Options {
+	return &Options{
+		Backend:  LocalBackend,
+		LocalDir: "_output/media",
+		S3: &S3Options{
+			Region: "us-east-1",
+		},
+	}
+}
+
+// AddFlags 将 Options 的选项绑定到命令行标志.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Backend, "blob.backend", o.Backend, fmt.Sprintf("Storage backend for media files, available options: %v", []string{LocalBackend, S3Backend}))
+	fs.StringVar(&o.LocalDir, "blob.local-dir", o.LocalDir, "Root directory of the local storage backend.")
+	fs.StringVar(&o.S3.Endpoint, "blob.s3.endpoint", o.S3.Endpoint, "Endpoint (host:port) of the S3 compatible storage.")
+	fs.StringVar(&o.S3.Bucket, "blob.s3.bucket", o.S3.Bucket, "Bucket used to store media files.")
+	fs.StringVar(&o.S3.Region, "blob.s3.region", o.S3.Region, "Region of the bucket.")
+	fs.StringVar(&o.S3.AccessKeyID, "blob.s3.access-key-id", o.S3.AccessKeyID, "Access key ID of the S3 compatible storage.")
+	fs.StringVar(&o.S3.SecretAccessKey, "blob.s3.secret-access-key", o.S3.SecretAccessKey, "Secret access key of the S3 compatible storage.")
+	fs.BoolVar(&o.S3.UseSSL, "blob.s3.use-ssl", o.S3.UseSSL, "Use HTTPS to access the S3 compatible storage.")
+}
+
+// Validate 校验 Options 中的选项是否合法.
+func (o *Options) Validate() []error {
+	errs := []error{}
+
+	switch o.Backend {
+	case LocalBackend:
+		if o.LocalDir == "" {
+			errs = append(errs, errors.New("blob.local-dir cannot be empty when using the local backend"))
+		}
+	case S3Backend:
+		if o.S3.Endpoint == "" || o.S3.Bucket == "" {
+			errs = append(errs, errors.New("blob.s3.endpoint and blob.s3.bucket are required when using the s3 backend"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid blob backend %q: must be one of %v", o.Backend, []string{LocalBackend, S3Backend}))
+	}
+
+	return errs
+}
+
+// NewBlobStore 根据配置创建对象存储实例.
+func (o *Options) NewBlobStore() (BlobStore, error) {
+	if o.Backend == S3Backend {
+		return NewS3Store(o.S3)
+	}
+	return NewLocalStore(o.LocalDir)
+}
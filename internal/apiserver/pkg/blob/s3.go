@@ -0,0 +1,78 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Store 将对象保存到 S3 兼容的对象存储中，适用于多实例部署.
+type S3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+// 确保 S3Store 实现了 BlobStore 接口.
+var _ BlobStore = (*S3Store)(nil)
+
+// NewS3Store 创建一个 S3Store 实例. 使用路径风格访问存储桶，以兼容 MinIO 等自建的对象存储.
+func NewS3Store(opts *S3Options) (*S3Store, error) {
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(opts.AccessKeyID, opts.SecretAccessKey, ""),
+		Secure:       opts.UseSSL,
+		Region:       opts.Region,
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &S3Store{client: client, bucket: opts.Bucket}, nil
+}
+
+// Put 上传对象.
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+// Get 下载对象. minio 的 GetObject 在首次读取时才发起请求，这里先通过 Stat 判断对象是否存在.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, convertS3Error(err)
+	}
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		return nil, convertS3Error(err)
+	}
+	return obj, nil
+}
+
+// Delete 删除对象. S3 删除不存在的对象时同样返回成功.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	return convertS3Error(s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}))
+}
+
+// convertS3Error 将对象不存在的错误转换为 ErrNotFound.
+func convertS3Error(err error) error {
+	if err != nil && minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrNotFound
+	}
+	return err
+}
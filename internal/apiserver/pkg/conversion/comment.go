@@ -0,0 +1,36 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// CommentModelToCommentV1 将模型层的 CommentM（评论模型对象）转换为 Protobuf 层的 Comment（v1 评论对象）.
+func CommentModelToCommentV1(commentModel *model.CommentM) *apiv1.Comment {
+	var protoComment apiv1.Comment
+	_ = core.CopyWithConverters(&protoComment, commentModel)
+	return &protoComment
+}
+
+// CommentV1ToCommentModel 将 Protobuf 层的 Comment（v1 评论对象）转换为模型层的 CommentM（评论模型对象）.
+func CommentV1ToCommentModel(protoComment *apiv1.Comment) *model.CommentM {
+	var commentModel model.CommentM
+	_ = core.CopyWithConverters(&commentModel, protoComment)
+	return &commentModel
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/export"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ExportJobModelToExportJobV1 将模型层的 ExportJobM（导出任务模型对象）转换为 Protobuf 层的 ExportJob（v1 导出任务对象）.
+func ExportJobModelToExportJobV1(jobModel *model.ExportJobM) *apiv1.ExportJob {
+	var protoJob apiv1.ExportJob
+	_ = core.CopyWithConverters(&protoJob, jobModel)
+	if jobModel.FinishedAt != nil {
+		protoJob.FinishedAt = timestamppb.New(*jobModel.FinishedAt)
+		protoJob.ExpiresAt = timestamppb.New(jobModel.FinishedAt.Add(export.Retention))
+	}
+	if jobModel.Status == int32(apiv1.ExportJobStatus_Succeeded) {
+		protoJob.DownloadURL = "/v1/exports/" + jobModel.JobID + "/download"
+	}
+	return &protoJob
+}
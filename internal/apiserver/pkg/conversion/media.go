@@ -0,0 +1,30 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// MediaModelToMediaV1 将模型层的 MediaM（媒体文件模型对象）转换为 Protobuf 层的 Media（v1 媒体文件对象）.
+func MediaModelToMediaV1(mediaModel *model.MediaM) *apiv1.Media {
+	var protoMedia apiv1.Media
+	_ = core.CopyWithConverters(&protoMedia, mediaModel)
+	protoMedia.Url = "/v1/public/media/" + mediaModel.MediaID
+	return &protoMedia
+}
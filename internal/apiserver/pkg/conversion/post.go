@@ -16,6 +16,8 @@ package conversion
 
 import (
 	"github.com/onexstack/onexstack/pkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 
 	"github.com/jwcen/miniblog/internal/apiserver/model"
 	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
@@ -25,6 +27,16 @@ import (
 func PostModelToPostV1(postModel *model.PostM) *apiv1.Post {
 	var protoPost apiv1.Post
 	_ = core.CopyWithConverters(&protoPost, postModel)
+	// 指针类型和 gorm.DeletedAt 类型的时间字段不在 core.TypeConverters 的支持范围内，需要单独转换
+	if postModel.PublishedAt != nil {
+		protoPost.PublishedAt = timestamppb.New(*postModel.PublishedAt)
+	}
+	if postModel.PublishAt != nil {
+		protoPost.PublishAt = timestamppb.New(*postModel.PublishAt)
+	}
+	if postModel.DeletedAt.Valid {
+		protoPost.DeletedAt = timestamppb.New(postModel.DeletedAt.Time)
+	}
 	return &protoPost
 }
 
@@ -32,5 +44,16 @@ func PostModelToPostV1(postModel *model.PostM) *apiv1.Post {
 func PostV1ToPostModel(protoPost *apiv1.Post) *model.PostM {
 	var postModel model.PostM
 	_ = core.CopyWithConverters(&postModel, protoPost)
+	if protoPost.PublishedAt != nil {
+		publishedAt := protoPost.PublishedAt.AsTime()
+		postModel.PublishedAt = &publishedAt
+	}
+	if protoPost.PublishAt != nil {
+		publishAt := protoPost.PublishAt.AsTime()
+		postModel.PublishAt = &publishAt
+	}
+	if protoPost.DeletedAt != nil {
+		postModel.DeletedAt = gorm.DeletedAt{Time: protoPost.DeletedAt.AsTime(), Valid: true}
+	}
 	return &postModel
 }
@@ -0,0 +1,51 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// PostModelToPublicPostV1 将模型层的 PostM（博客模型对象）转换为 Protobuf 层的 PublicPost（v1 公开博客对象）.
+// 这里逐个字段赋值而不使用 core.CopyWithConverters，避免模型中新增的非公开字段被意外暴露.
+func PostModelToPublicPostV1(postModel *model.PostM, author *model.UserM) *apiv1.PublicPost {
+	protoPost := &apiv1.PublicPost{
+		PostID:    postModel.PostID,
+		Author:    UserModelToPublicAuthorV1(author),
+		Title:     postModel.Title,
+		Slug:      postModel.Slug,
+		Content:   postModel.Content,
+		UpdatedAt: timestamppb.New(postModel.UpdatedAt),
+	}
+	if postModel.PublishedAt != nil {
+		protoPost.PublishedAt = timestamppb.New(*postModel.PublishedAt)
+	}
+	return protoPost
+}
+
+// UserModelToPublicAuthorV1 将模型层的 UserM（用户模型对象）转换为 Protobuf 层的 PublicAuthor（v1 公开作者对象）.
+func UserModelToPublicAuthorV1(userModel *model.UserM) *apiv1.PublicAuthor {
+	if userModel == nil {
+		return nil
+	}
+	return &apiv1.PublicAuthor{
+		UserID:   userModel.UserID,
+		Username: userModel.Username,
+		Nickname: userModel.Nickname,
+	}
+}
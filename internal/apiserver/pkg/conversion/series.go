@@ -0,0 +1,39 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// SeriesModelToSeriesV1 将模型层的 SeriesM（系列模型对象）转换为 Protobuf 层的 Series（v1 系列对象）.
+func SeriesModelToSeriesV1(seriesModel *model.SeriesM) *apiv1.Series {
+	var protoSeries apiv1.Series
+	_ = core.CopyWithConverters(&protoSeries, seriesModel)
+	return &protoSeries
+}
+
+// PostModelToSeriesPostV1 将模型层的 PostM 转换为 Protobuf 层的 SeriesPost，position 为博文在系列中的位置，从 1 开始.
+func PostModelToSeriesPostV1(postModel *model.PostM, position int64) *apiv1.SeriesPost {
+	return &apiv1.SeriesPost{
+		PostID:   postModel.PostID,
+		Title:    postModel.Title,
+		Slug:     postModel.Slug,
+		Position: position,
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"time"
+
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// Extension 返回归档格式对应的文件扩展名.
+func Extension(format apiv1.ExportFormat) string {
+	if format == apiv1.ExportFormat_TarGz {
+		return ".tar.gz"
+	}
+	return ".zip"
+}
+
+// ContentType 返回归档格式对应的媒体类型.
+func ContentType(format apiv1.ExportFormat) string {
+	if format == apiv1.ExportFormat_TarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// archiveWriter 将文件逐个写入归档.
+type archiveWriter interface {
+	// add 向归档中添加一个文件.
+	add(name string, modTime time.Time, data []byte) error
+	// Close 写入归档的结尾，不会关闭底层的 io.Writer.
+	Close() error
+}
+
+// newArchiveWriter 根据归档格式创建 archiveWriter.
+func newArchiveWriter(w io.Writer, format apiv1.ExportFormat) (archiveWriter, error) {
+	if format == apiv1.ExportFormat_TarGz {
+		gw := gzip.NewWriter(w)
+		return &tarWriter{gw: gw, tw: tar.NewWriter(gw)}, nil
+	}
+	return &zipWriter{zw: zip.NewWriter(w)}, nil
+}
+
+type zipWriter struct {
+	zw *zip.Writer
+}
+
+func (w *zipWriter) add(name string, modTime time.Time, data []byte) error {
+	fw, err := w.zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modTime})
+	if err != nil {
+		return err
+	}
+	_, err = fw.Write(data)
+	return err
+}
+
+func (w *zipWriter) Close() error {
+	return w.zw.Close()
+}
+
+type tarWriter struct {
+	gw *gzip.Writer
+	tw *tar.Writer
+}
+
+func (w *tarWriter) add(name string, modTime time.Time, data []byte) error {
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := w.tw.Write(data)
+	return err
+}
+
+func (w *tarWriter) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return w.gw.Close()
+}
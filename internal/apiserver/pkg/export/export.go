@@ -0,0 +1,225 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export 将用户的博文导出为 zip 或 tar.gz 归档.
+// 每篇博文导出为一个带 YAML front matter 的文件，导出的文件可以通过导入接口重新导入.
+// 归档中另外包含一个 manifest.json，记录每篇博文的状态、标签和时间等信息.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/frontmatter"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// ManifestName 是归档中清单文件的名称.
+	ManifestName = "manifest.json"
+	// ManifestVersion 是清单文件格式的版本号，格式发生不兼容的变化时递增.
+	ManifestVersion = 1
+
+	// Retention 是后台导出任务生成的导出文件的保留时长.
+	Retention = 7 * 24 * time.Hour
+
+	// postsDir 是归档中博文文件所在的目录.
+	postsDir = "posts/"
+	// batchSize 是每次从数据库中读取的博文数量.
+	batchSize = 100
+)
+
+// Manifest 是归档中 manifest.json 的内容.
+type Manifest struct {
+	Version    int            `json:"version"`
+	ExportedAt time.Time      `json:"exportedAt"`
+	UserID     string         `json:"userID"`
+	Username   string         `json:"username"`
+	PostCount  int            `json:"postCount"`
+	Posts      []ManifestPost `json:"posts"`
+}
+
+// ManifestPost 描述归档中的一篇博文.
+type ManifestPost struct {
+	PostID        string     `json:"postID"`
+	File          string     `json:"file"`
+	Title         string     `json:"title"`
+	Slug          string     `json:"slug"`
+	Status        string     `json:"status"`
+	ContentFormat string     `json:"contentFormat"`
+	Tags          []string   `json:"tags"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	PublishedAt   *time.Time `json:"publishedAt,omitempty"`
+}
+
+// Exporter 从数据库中读取用户的博文并写入归档.
+type Exporter struct {
+	store store.IStore
+}
+
+// NewExporter 创建一个 *Exporter 实例.
+func NewExporter(store store.IStore) *Exporter {
+	return &Exporter{store: store}
+}
+
+// Count 返回用户可以导出的博文数量，回收站中的博文不会被导出.
+func (e *Exporter) Count(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := e.store.DB(ctx).Model(&model.PostM{}).Where("userID = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// Export 将用户的全部博文按 format 格式写入 w，返回导出的博文数量.
+// 写入失败时 w 中可能已经写入了部分内容，调用方需要自行丢弃.
+func (e *Exporter) Export(ctx context.Context, userID string, format apiv1.ExportFormat, w io.Writer) (int64, error) {
+	userM, err := e.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		return 0, err
+	}
+
+	aw, err := newArchiveWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	manifest := Manifest{
+		Version:    ManifestVersion,
+		ExportedAt: now,
+		UserID:     userM.UserID,
+		Username:   userM.Username,
+		Posts:      []ManifestPost{},
+	}
+
+	// 按 id 倒序分批读取博文，使用上一批最后一篇博文的 id 作为下一批的起点
+	var lastID int64
+	for {
+		whr := where.L(batchSize).F("userID", userID)
+		if lastID > 0 {
+			whr = whr.Q("id < ?", lastID)
+		}
+		_, postList, err := e.store.Post().List(ctx, whr)
+		if err != nil {
+			return 0, err
+		}
+		if len(postList) == 0 {
+			break
+		}
+
+		tags, err := e.listTags(ctx, postList)
+		if err != nil {
+			return 0, err
+		}
+		for _, postM := range postList {
+			entry, data, err := marshalPost(postM, tags[postM.PostID])
+			if err != nil {
+				return 0, err
+			}
+			if err := aw.add(entry.File, postM.UpdatedAt, data); err != nil {
+				return 0, err
+			}
+			manifest.Posts = append(manifest.Posts, entry)
+		}
+
+		lastID = postList[len(postList)-1].ID
+		if len(postList) < batchSize {
+			break
+		}
+	}
+
+	manifest.PostCount = len(manifest.Posts)
+	data, err := json.MarshalIndent(&manifest, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := aw.add(ManifestName, now, data); err != nil {
+		return 0, err
+	}
+	if err := aw.Close(); err != nil {
+		return 0, err
+	}
+
+	return int64(manifest.PostCount), nil
+}
+
+// listTags 查询博文的标签，返回以 postID 为键的标签列表.
+func (e *Exporter) listTags(ctx context.Context, postList []*model.PostM) (map[string][]string, error) {
+	postIDs := make([]string, 0, len(postList))
+	for _, postM := range postList {
+		postIDs = append(postIDs, postM.PostID)
+	}
+
+	_, tagList, err := e.store.PostTag().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string][]string, len(postList))
+	for _, tag := range tagList {
+		ret[tag.PostID] = append(ret[tag.PostID], tag.Name)
+	}
+	for _, names := range ret {
+		sort.Strings(names)
+	}
+	return ret, nil
+}
+
+// marshalPost 将博文序列化为带 YAML front matter 的文件，并返回其在清单中的描述.
+// 只有已发布的博文不是草稿，已归档的博文重新导入后保持不公开.
+func marshalPost(postM *model.PostM, tags []string) (ManifestPost, []byte, error) {
+	ext := ".md"
+	if postM.ContentFormat == int32(apiv1.ContentFormat_HTML) {
+		ext = ".html"
+	}
+
+	entry := ManifestPost{
+		PostID:        postM.PostID,
+		File:          postsDir + postM.Slug + ext,
+		Title:         postM.Title,
+		Slug:          postM.Slug,
+		Status:        apiv1.PostStatus(postM.Status).String(),
+		ContentFormat: apiv1.ContentFormat(postM.ContentFormat).String(),
+		Tags:          tags,
+		CreatedAt:     postM.CreatedAt,
+		UpdatedAt:     postM.UpdatedAt,
+		PublishedAt:   postM.PublishedAt,
+	}
+	if entry.Tags == nil {
+		entry.Tags = []string{}
+	}
+
+	doc := &frontmatter.Document{
+		Title:   postM.Title,
+		Date:    postM.CreatedAt,
+		Lastmod: postM.UpdatedAt,
+		Tags:    tags,
+		Draft:   postM.Status != int32(apiv1.PostStatus_Published),
+		Slug:    postM.Slug,
+		Body:    postM.Content,
+	}
+	if postM.PublishedAt != nil {
+		doc.Date = *postM.PublishedAt
+	}
+
+	data, err := frontmatter.Marshal(doc)
+	return entry, data, err
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/frontmatter"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+var (
+	dbOnce sync.Once
+	testDB *gorm.DB
+)
+
+// newTestStore 返回基于 SQLite 内存数据库的 store 实例，并清空用户、博文和标签.
+func newTestStore(t *testing.T) store.IStore {
+	t.Helper()
+
+	dbOnce.Do(func() {
+		db, err := gorm.Open(sqlite.Open("file:export?mode=memory&cache=shared"), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(&model.UserM{}, &model.PostM{}, &model.PostTagM{}))
+		testDB = db
+	})
+	for _, m := range []any{&model.UserM{}, &model.PostM{}, &model.PostTagM{}} {
+		require.NoError(t, testDB.Unscoped().Where("1 = 1").Delete(m).Error)
+	}
+
+	return store.NewStore(testDB)
+}
+
+func createUser(t *testing.T, db *gorm.DB, username string) string {
+	t.Helper()
+
+	userM := model.UserM{Username: username, Password: "miniblog1234", Phone: username}
+	require.NoError(t, db.Create(&userM).Error)
+	return userM.UserID
+}
+
+func createPost(t *testing.T, db *gorm.DB, postM *model.PostM, tags ...string) {
+	t.Helper()
+
+	require.NoError(t, db.Create(postM).Error)
+	for _, tag := range tags {
+		require.NoError(t, db.Create(&model.PostTagM{UserID: postM.UserID, PostID: postM.PostID, Name: tag}).Error)
+	}
+}
+
+// readArchive 读取归档中的全部文件，返回以文件名为键的文件内容.
+func readArchive(t *testing.T, format apiv1.ExportFormat, data []byte) map[string]string {
+	t.Helper()
+
+	files := make(map[string]string)
+	if format == apiv1.ExportFormat_TarGz {
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		tr := tar.NewReader(gr)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[hdr.Name] = string(content)
+		}
+		return files
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = string(content)
+	}
+	return files
+}
+
+func TestExport(t *testing.T) {
+	for _, format := range []apiv1.ExportFormat{apiv1.ExportFormat_Zip, apiv1.ExportFormat_TarGz} {
+		t.Run(format.String(), func(t *testing.T) {
+			s := newTestStore(t)
+			db := s.DB(context.Background())
+			userID := createUser(t, db, "alice")
+			otherID := createUser(t, db, "bob")
+
+			publishedAt := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+			published := &model.PostM{
+				UserID:      userID,
+				Title:       "Hello",
+				Slug:        "hello",
+				Content:     "# Hello\n",
+				Status:      int32(apiv1.PostStatus_Published),
+				PublishedAt: ptr.To(publishedAt),
+			}
+			createPost(t, db, published, "web", "go")
+			createPost(t, db, &model.PostM{
+				UserID:        userID,
+				Title:         "Page",
+				Slug:          "page",
+				Content:       "<p>page</p>",
+				ContentFormat: int32(apiv1.ContentFormat_HTML),
+				Status:        int32(apiv1.PostStatus_Draft),
+			})
+			trashed := &model.PostM{UserID: userID, Title: "Trashed", Slug: "trashed", Content: "x"}
+			createPost(t, db, trashed)
+			require.NoError(t, db.Delete(trashed).Error)
+			createPost(t, db, &model.PostM{UserID: otherID, Title: "Other", Slug: "other", Content: "x"})
+
+			e := NewExporter(s)
+			count, err := e.Count(context.Background(), userID)
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, count)
+
+			var buf bytes.Buffer
+			count, err = e.Export(context.Background(), userID, format, &buf)
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, count)
+
+			files := readArchive(t, format, buf.Bytes())
+			assert.Len(t, files, 3)
+
+			var manifest Manifest
+			require.NoError(t, json.Unmarshal([]byte(files[ManifestName]), &manifest))
+			assert.Equal(t, ManifestVersion, manifest.Version)
+			assert.Equal(t, "alice", manifest.Username)
+			assert.Equal(t, 2, manifest.PostCount)
+			require.Len(t, manifest.Posts, 2)
+			assert.Equal(t, "posts/page.html", manifest.Posts[0].File)
+			assert.Equal(t, "Draft", manifest.Posts[0].Status)
+			assert.Equal(t, "HTML", manifest.Posts[0].ContentFormat)
+			assert.Equal(t, []string{}, manifest.Posts[0].Tags)
+			assert.Equal(t, "posts/hello.md", manifest.Posts[1].File)
+			assert.Equal(t, published.PostID, manifest.Posts[1].PostID)
+			assert.Equal(t, []string{"go", "web"}, manifest.Posts[1].Tags)
+
+			// 导出的文件可以重新导入
+			doc, err := frontmatter.Parse(files["posts/hello.md"])
+			require.NoError(t, err)
+			assert.Equal(t, "Hello", doc.Title)
+			assert.Equal(t, "hello", doc.Slug)
+			assert.Equal(t, []string{"go", "web"}, doc.Tags)
+			assert.False(t, doc.Draft)
+			assert.True(t, publishedAt.Equal(doc.Date), "date: %v", doc.Date)
+			assert.Equal(t, "# Hello\n", doc.Body)
+
+			doc, err = frontmatter.Parse(files["posts/page.html"])
+			require.NoError(t, err)
+			assert.True(t, doc.Draft)
+		})
+	}
+}
+
+func TestExportBatches(t *testing.T) {
+	s := newTestStore(t)
+	db := s.DB(context.Background())
+	userID := createUser(t, db, "alice")
+
+	total := 2*batchSize + 1
+	for i := 0; i < total; i++ {
+		createPost(t, db, &model.PostM{UserID: userID, Title: "post", Slug: fmt.Sprintf("post-%d", i), Content: "x"})
+	}
+
+	var buf bytes.Buffer
+	count, err := NewExporter(s).Export(context.Background(), userID, apiv1.ExportFormat_Zip, &buf)
+	require.NoError(t, err)
+	assert.EqualValues(t, total, count)
+	assert.Len(t, readArchive(t, apiv1.ExportFormat_Zip, buf.Bytes()), total+1)
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomPerson    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    *atomText      `xml:"summary,omitempty"`
+	Content    *atomText      `xml:"content,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Atom 生成 Atom 1.0 格式的订阅源. selfPath 为订阅源自身的路径.
+func Atom(f *Feed, baseURL string, selfPath string) ([]byte, error) {
+	feed := atomFeed{
+		// 订阅源的 id 必须是固定不变的 IRI，这里使用订阅源自身的地址
+		ID:    baseURL + selfPath,
+		Title: f.Title,
+		// Atom 要求 updated 必填，订阅源为空时输出零值时间，保证内容不变时 ETag 也不变
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: baseURL + selfPath, Rel: "self", Type: "application/atom+xml"},
+			{Href: baseURL + f.Path, Rel: "alternate"},
+		},
+		Entries: make([]atomEntry, 0, len(f.Entries)),
+	}
+
+	for _, entry := range f.Entries {
+		item := atomEntry{
+			ID:        "urn:miniblog:post:" + entry.ID,
+			Title:     entry.Title,
+			Link:      atomLink{Href: baseURL + entry.Path, Rel: "alternate"},
+			Published: entry.Published.UTC().Format(time.RFC3339),
+			Updated:   entry.Updated.UTC().Format(time.RFC3339),
+		}
+		if entry.Author != "" {
+			item.Author = &atomPerson{Name: entry.Author}
+		}
+		for _, category := range entry.Categories {
+			item.Categories = append(item.Categories, atomCategory{Term: category})
+		}
+		if entry.Summary != "" {
+			item.Summary = &atomText{Type: "text", Value: entry.Summary}
+		}
+		if entry.Content != "" {
+			item.Content = &atomText{Type: "html", Value: entry.Content}
+		}
+		feed.Entries = append(feed.Entries, item)
+	}
+
+	return marshal(feed)
+}
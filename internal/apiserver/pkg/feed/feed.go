@@ -0,0 +1,95 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package feed 生成 RSS 2.0 和 Atom 订阅源，并处理 ETag/If-Modified-Since 条件请求.
+package feed
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// Format 表示订阅源的格式.
+type Format int
+
+const (
+	// FormatRSS 表示 RSS 2.0 格式.
+	FormatRSS Format = iota
+	// FormatAtom 表示 Atom 1.0 格式.
+	FormatAtom
+)
+
+// Feed 表示一个订阅源. 其中的路径均为站内相对路径，输出时根据请求地址补全为绝对地址.
+type Feed struct {
+	Title       string
+	Description string
+	// Path 为订阅源对应页面的路径.
+	Path string
+	// Updated 为订阅源中所有条目的最近更新时间.
+	Updated time.Time
+	// LastModified 作为 Last-Modified 响应头. 条目离开订阅源（例如文章被删除）时 Updated 可能变早，
+	// 因此使用包括已离开订阅源的文章在内的最近修改时间，保证订阅源变化时该时间只会变晚.
+	LastModified time.Time
+	Entries      []*Entry
+}
+
+// Entry 表示订阅源中的一个条目，对应一篇已发布的文章.
+type Entry struct {
+	ID         string
+	Title      string
+	Path       string
+	Author     string
+	Summary    string
+	Content    string
+	Categories []string
+	Published  time.Time
+	Updated    time.Time
+}
+
+// Serve 将订阅源以指定格式写入 HTTP 响应.
+// ETag 由响应内容计算得到，条件请求的处理（包括 304 响应）交由 http.ServeContent 完成.
+// baseURL 为站点的根地址，用于补全订阅源中的链接.
+func Serve(w http.ResponseWriter, r *http.Request, f *Feed, format Format, baseURL string) {
+	var (
+		body        []byte
+		contentType string
+		err         error
+	)
+	switch format {
+	case FormatAtom:
+		body, err = Atom(f, baseURL, r.URL.Path)
+		contentType = "application/atom+xml; charset=utf-8"
+	default:
+		body, err = RSS(f, baseURL, r.URL.Path)
+		contentType = "application/rss+xml; charset=utf-8"
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	// 允许客户端缓存订阅源，但每次使用前都需要通过条件请求重新校验
+	header.Set("Cache-Control", "no-cache")
+	header.Del("Expires")
+	header.Del("Last-Modified")
+
+	http.ServeContent(w, r, "", f.LastModified, bytes.NewReader(body))
+}
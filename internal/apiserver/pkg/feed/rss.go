@@ -0,0 +1,106 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DCNS    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+// rssLink 为 RSS 订阅源声明自身地址，这是 RSS 校验工具推荐的做法.
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	Description string   `xml:"description"`
+	PubDate     string   `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS 生成 RSS 2.0 格式的订阅源. selfPath 为订阅源自身的路径.
+// 条目的 description 使用渲染后的 HTML，便于阅读器直接展示全文.
+func RSS(f *Feed, baseURL string, selfPath string) ([]byte, error) {
+	channel := rssChannel{
+		Title:       f.Title,
+		Link:        baseURL + f.Path,
+		Description: f.Description,
+		AtomLink:    rssLink{Href: baseURL + selfPath, Rel: "self", Type: "application/rss+xml"},
+		Items:       make([]rssItem, 0, len(f.Entries)),
+	}
+	if !f.Updated.IsZero() {
+		channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, entry := range f.Entries {
+		description := entry.Content
+		if description == "" {
+			description = entry.Summary
+		}
+		channel.Items = append(channel.Items, rssItem{
+			Title:       entry.Title,
+			Link:        baseURL + entry.Path,
+			GUID:        rssGUID{Value: entry.ID},
+			Creator:     entry.Author,
+			Categories:  entry.Categories,
+			Description: description,
+			PubDate:     entry.Published.UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return marshal(rss{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DCNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: channel,
+	})
+}
+
+// marshal 将订阅源序列化为带 XML 声明的文档.
+func marshal(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
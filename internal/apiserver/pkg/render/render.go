@@ -0,0 +1,241 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render 将博客内容渲染为经过安全过滤的 HTML，并生成目录和摘要.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"k8s.io/utils/lru"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/slug"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// cacheSize 定义渲染结果缓存的最大条目数.
+	cacheSize = 1024
+
+	// excerptLength 定义摘要的最大字符数.
+	excerptLength = 200
+)
+
+// Output 表示博客内容的渲染结果.
+type Output struct {
+	// HTML 是渲染并过滤后的 HTML.
+	HTML string
+	// TOC 是由标题生成的目录，只有 Markdown 格式的内容会生成目录.
+	TOC []*TOCEntry
+	// Excerpt 是纯文本摘要.
+	Excerpt string
+}
+
+// TOCEntry 表示目录中的一项.
+type TOCEntry struct {
+	Level  int
+	Anchor string
+	Title  string
+}
+
+// Renderer 负责渲染博客内容. Renderer 是并发安全的，应在整个服务中共享同一个实例，以便复用渲染缓存.
+type Renderer struct {
+	markdown goldmark.Markdown
+	// policy 用于过滤渲染后 HTML 中不安全的标签和属性.
+	policy *bluemonday.Policy
+	// strict 用于去除所有 HTML 标签，生成纯文本摘要.
+	strict *bluemonday.Policy
+	// cache 以文章 ID 和版本号为键缓存渲染结果. 文章内容的每次变更都会生成新的版本，因此缓存无需主动失效.
+	cache *lru.Cache
+}
+
+// NewRenderer 创建一个 *Renderer 实例.
+func NewRenderer() *Renderer {
+	return &Renderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+			// 允许 Markdown 中内嵌 HTML，不安全的标签和属性统一由 policy 过滤
+			goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+		),
+		policy: bluemonday.UGCPolicy(),
+		strict: bluemonday.StrictPolicy(),
+		cache:  lru.New(cacheSize),
+	}
+}
+
+// RenderPost 渲染文章的当前版本，渲染结果按文章 ID 和版本号缓存.
+func (r *Renderer) RenderPost(postM *model.PostM) (*Output, error) {
+	key := fmt.Sprintf("%s/%d", postM.PostID, postM.Revision)
+	if cached, ok := r.cache.Get(key); ok {
+		return cached.(*Output), nil
+	}
+
+	out, err := r.Render(apiv1.ContentFormat(postM.ContentFormat), postM.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Add(key, out)
+	return out, nil
+}
+
+// Render 按照指定格式渲染内容，不使用缓存.
+func (r *Renderer) Render(format apiv1.ContentFormat, content string) (*Output, error) {
+	var (
+		unsafe string
+		toc    []*TOCEntry
+	)
+
+	switch format {
+	case apiv1.ContentFormat_Plain:
+		unsafe = renderPlain(content)
+	case apiv1.ContentFormat_HTML:
+		unsafe = content
+	default:
+		var err error
+		if unsafe, toc, err = r.renderMarkdown(content); err != nil {
+			return nil, err
+		}
+	}
+
+	safe := r.policy.Sanitize(unsafe)
+	return &Output{HTML: safe, TOC: toc, Excerpt: r.excerpt(safe)}, nil
+}
+
+// renderMarkdown 将 Markdown 渲染为 HTML，并根据标题生成目录.
+func (r *Renderer) renderMarkdown(content string) (string, []*TOCEntry, error) {
+	source := []byte(content)
+	// 标题 ID 在同一篇文章内唯一，因此每次解析都使用新的 headingIDs
+	ctx := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	doc := r.markdown.Parser().Parse(text.NewReader(source), parser.WithContext(ctx))
+
+	var toc []*TOCEntry
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		entry := &TOCEntry{Level: heading.Level, Title: nodeText(heading, source)}
+		if id, ok := heading.AttributeString("id"); ok {
+			if b, ok := id.([]byte); ok {
+				entry.Anchor = string(b)
+			}
+		}
+		toc = append(toc, entry)
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := r.markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), toc, nil
+}
+
+// headingIDs 实现 parser.IDs，使用与文章 slug 相同的规则生成标题 ID，汉字标题会转写为拼音.
+// goldmark 默认的实现会丢弃非 ASCII 字符，中文标题全部变成 heading、heading-1 这类无意义的锚点.
+type headingIDs struct {
+	used map[string]struct{}
+	// fallbacks 为无法生成 slug 的标题数，这类标题使用 heading-N 作为 ID.
+	fallbacks int
+}
+
+var _ parser.IDs = (*headingIDs)(nil)
+
+func newHeadingIDs() *headingIDs {
+	return &headingIDs{used: make(map[string]struct{})}
+}
+
+// Generate 根据标题文本生成文章内唯一的 ID，与已有 ID 冲突时追加数字后缀.
+func (ids *headingIDs) Generate(value []byte, _ ast.NodeKind) []byte {
+	base := slug.Make(string(value))
+	if base == "" {
+		ids.fallbacks++
+		base = "heading-" + strconv.Itoa(ids.fallbacks)
+	}
+
+	id := base
+	for n := 1; ; n++ {
+		if _, ok := ids.used[id]; !ok {
+			break
+		}
+		id = slug.WithSuffix(base, n)
+	}
+	ids.used[id] = struct{}{}
+	return []byte(id)
+}
+
+// Put 记录文章中已经使用的 ID.
+func (ids *headingIDs) Put(value []byte) {
+	ids.used[string(value)] = struct{}{}
+}
+
+// excerpt 从过滤后的 HTML 中提取纯文本，并截取为摘要.
+func (r *Renderer) excerpt(safeHTML string) string {
+	// 在标签前插入空格，避免相邻块级元素中的文本在去除标签后粘连在一起
+	plain := html.UnescapeString(r.strict.Sanitize(strings.ReplaceAll(safeHTML, "<", " <")))
+	runes := []rune(strings.Join(strings.Fields(plain), " "))
+	if len(runes) <= excerptLength {
+		return string(runes)
+	}
+	return string(runes[:excerptLength]) + "…"
+}
+
+// renderPlain 将纯文本渲染为 HTML，空行分隔段落，段落内的换行转换为 <br>.
+func renderPlain(content string) string {
+	var b strings.Builder
+	for _, paragraph := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
+
+// nodeText 返回节点下所有文本节点拼接后的文本.
+func nodeText(n ast.Node, source []byte) string {
+	var b strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch t := c.(type) {
+		case *ast.Text:
+			b.Write(t.Segment.Value(source))
+		case *ast.String:
+			b.Write(t.Value)
+		default:
+			b.WriteString(nodeText(c, source))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestRender_Markdown(t *testing.T) {
+	r := render.NewRenderer()
+
+	out, err := r.Render(apiv1.ContentFormat_Markdown, "# Intro\n\nHello **world**\n\n## Details\n\ntext")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.HTML, `<h1 id="intro">Intro</h1>`)
+	assert.Contains(t, out.HTML, "<strong>world</strong>")
+	assert.Equal(t, []*render.TOCEntry{
+		{Level: 1, Anchor: "intro", Title: "Intro"},
+		{Level: 2, Anchor: "details", Title: "Details"},
+	}, out.TOC)
+	assert.Equal(t, "Intro Hello world Details text", out.Excerpt)
+}
+
+func TestRender_HeadingAnchors(t *testing.T) {
+	r := render.NewRenderer()
+
+	out, err := r.Render(apiv1.ContentFormat_Markdown, "# 你好 世界\n\n## 再见\n\n## 再见\n\n## 😀\n\n## 🎉")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.HTML, `<h1 id="ni-hao-shi-jie">你好 世界</h1>`)
+	assert.Equal(t, []*render.TOCEntry{
+		{Level: 1, Anchor: "ni-hao-shi-jie", Title: "你好 世界"},
+		{Level: 2, Anchor: "zai-jian", Title: "再见"},
+		{Level: 2, Anchor: "zai-jian-1", Title: "再见"},
+		{Level: 2, Anchor: "heading-1", Title: "😀"},
+		{Level: 2, Anchor: "heading-2", Title: "🎉"},
+	}, out.TOC)
+}
+
+func TestRender_Sanitize(t *testing.T) {
+	r := render.NewRenderer()
+
+	tests := []struct {
+		name    string
+		format  apiv1.ContentFormat
+		content string
+	}{
+		{name: "markdown", format: apiv1.ContentFormat_Markdown, content: "x <script>alert(1)</script> [link](javascript:alert(1)) <b onclick=\"alert(1)\">b</b>"},
+		{name: "html", format: apiv1.ContentFormat_HTML, content: "<p onclick=\"alert(1)\">x</p><script>alert(1)</script><a href=\"javascript:alert(1)\">a</a>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := r.Render(tt.format, tt.content)
+			require.NoError(t, err)
+
+			assert.NotContains(t, out.HTML, "<script")
+			assert.NotContains(t, out.HTML, "onclick")
+			assert.NotContains(t, out.HTML, "javascript:")
+		})
+	}
+}
+
+func TestRender_Plain(t *testing.T) {
+	r := render.NewRenderer()
+
+	out, err := r.Render(apiv1.ContentFormat_Plain, "a <b>\nline\n\n# not a heading")
+	require.NoError(t, err)
+
+	assert.Equal(t, "<p>a &lt;b&gt;<br>\nline</p>\n<p># not a heading</p>\n", out.HTML)
+	assert.Empty(t, out.TOC)
+	assert.Equal(t, "a <b> line # not a heading", out.Excerpt)
+}
+
+func TestRender_Excerpt(t *testing.T) {
+	r := render.NewRenderer()
+
+	out, err := r.Render(apiv1.ContentFormat_Plain, strings.Repeat("字", 300))
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Repeat("字", 200)+"…", out.Excerpt)
+}
+
+func TestRenderPost_CachedPerRevision(t *testing.T) {
+	r := render.NewRenderer()
+	postM := &model.PostM{PostID: "post-test01", Revision: 1, Content: "first"}
+
+	first, err := r.RenderPost(postM)
+	require.NoError(t, err)
+
+	// 同一版本的内容不会重新渲染
+	postM.Content = "changed"
+	cached, err := r.RenderPost(postM)
+	require.NoError(t, err)
+	assert.Same(t, first, cached)
+
+	// 新版本会重新渲染
+	postM.Revision = 2
+	second, err := r.RenderPost(postM)
+	require.NoError(t, err)
+	assert.Contains(t, second.HTML, "changed")
+}
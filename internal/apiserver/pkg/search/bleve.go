@@ -0,0 +1,144 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/v2/mapping"
+	blevesearch "github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+)
+
+// bleveDocument 是写入 bleve 索引的文档结构.
+type bleveDocument struct {
+	UserID  string `json:"userID"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// bleveSearcher 是基于内存 bleve 索引的 Searcher 实现，用于 SQLite 内存模式.
+type bleveSearcher struct {
+	index bleve.Index
+}
+
+var _ Searcher = (*bleveSearcher)(nil)
+
+// NewBleveSearcher 创建一个基于内存 bleve 索引的 Searcher.
+// 索引不做持久化，与内存数据库的生命周期保持一致.
+func NewBleveSearcher() (*bleveSearcher, error) {
+	index, err := bleve.NewMemOnly(newIndexMapping())
+	if err != nil {
+		return nil, err
+	}
+	return &bleveSearcher{index: index}, nil
+}
+
+// newIndexMapping 创建文档映射，userID 只做精确匹配，标题和内容做分词检索.
+func newIndexMapping() *mapping.IndexMappingImpl {
+	userID := bleve.NewTextFieldMapping()
+	userID.Analyzer = keyword.Name
+	userID.IncludeInAll = false
+
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = standard.Name
+	text.Store = true
+	text.IncludeTermVectors = true
+
+	doc := bleve.NewDocumentMapping()
+	doc.AddFieldMappingsAt("userID", userID)
+	doc.AddFieldMappingsAt("title", text)
+	doc.AddFieldMappingsAt("content", text)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	return m
+}
+
+// Index 新增或更新文章的索引.
+func (s *bleveSearcher) Index(ctx context.Context, post *model.PostM) error {
+	return s.index.Index(post.PostID, bleveDocument{UserID: post.UserID, Title: post.Title, Content: post.Content})
+}
+
+// Delete 删除指定文章的索引.
+func (s *bleveSearcher) Delete(ctx context.Context, postIDs ...string) error {
+	batch := s.index.NewBatch()
+	for _, postID := range postIDs {
+		batch.Delete(postID)
+	}
+	return s.index.Batch(batch)
+}
+
+// Search 检索文章，关键词匹配标题或内容，并返回 html 高亮片段.
+func (s *bleveSearcher) Search(ctx context.Context, q *Query) (*Result, error) {
+	userQuery := bleve.NewTermQuery(q.UserID)
+	userQuery.SetField("userID")
+
+	titleQuery := bleve.NewMatchQuery(q.Text)
+	titleQuery.SetField("title")
+	contentQuery := bleve.NewMatchQuery(q.Text)
+	contentQuery.SetField("content")
+
+	size := q.Limit
+	if size <= 0 {
+		total, err := s.index.DocCount()
+		if err != nil {
+			return nil, err
+		}
+		size = int(total)
+	}
+
+	rq := bleve.NewSearchRequestOptions(
+		bleve.NewConjunctionQuery(userQuery, bleve.NewDisjunctionQuery(titleQuery, contentQuery)),
+		size, q.Offset, false,
+	)
+	rq.Highlight = bleve.NewHighlightWithStyle(html.Name)
+	rq.Highlight.AddField("title")
+	rq.Highlight.AddField("content")
+
+	ret, err := s.index.SearchInContext(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*Hit, 0, len(ret.Hits))
+	for _, match := range ret.Hits {
+		hits = append(hits, &Hit{
+			PostID:         match.ID,
+			Score:          match.Score,
+			TitleSnippet:   firstFragment(match, "title"),
+			ContentSnippet: firstFragment(match, "content"),
+		})
+	}
+
+	return &Result{Total: int64(ret.Total), Hits: hits}, nil
+}
+
+// firstFragment 返回字段的第一个高亮片段，字段未命中时返回空字符串.
+func firstFragment(match *blevesearch.DocumentMatch, field string) string {
+	// 未命中的字段也可能返回不含高亮的默认片段，需要按命中位置过滤
+	if _, ok := match.Locations[field]; !ok {
+		return ""
+	}
+	if fragments := match.Fragments[field]; len(fragments) > 0 {
+		return fragments[0]
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// splitTerms 将检索关键词按空白拆分，转为小写并去重.
+func splitTerms(text string) [][]rune {
+	seen := make(map[string]struct{})
+	var terms [][]rune
+	for _, field := range strings.Fields(strings.ToLower(text)) {
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		terms = append(terms, []rune(field))
+	}
+	return terms
+}
+
+// highlight 截取 text 中第一个命中关键词附近不超过 fragmentSize 个字符的片段，
+// 对片段做 html 转义，并使用 <mark> 标签包裹命中的关键词. 未命中任何关键词时返回空字符串.
+func highlight(text string, terms [][]rune) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// 找出所有不重叠的命中区间，同一位置优先匹配最长的关键词
+	var spans [][2]int
+	for i := 0; i < len(lower); {
+		matched := 0
+		for _, term := range terms {
+			if len(term) > matched && hasPrefix(lower[i:], term) {
+				matched = len(term)
+			}
+		}
+		if matched == 0 {
+			i++
+			continue
+		}
+		spans = append(spans, [2]int{i, i + matched})
+		i += matched
+	}
+	if len(spans) == 0 {
+		return ""
+	}
+
+	// 片段从第一个命中位置前保留少量上下文
+	start := max(spans[0][0]-fragmentSize/4, 0)
+	end := min(start+fragmentSize, len(runes))
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, span := range spans {
+		if span[0] >= end {
+			break
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:span[0]])))
+		b.WriteString(highlightPreTag)
+		b.WriteString(html.EscapeString(string(runes[span[0]:span[1]])))
+		b.WriteString(highlightPostTag)
+		pos = span[1]
+	}
+	end = max(end, pos)
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// hasPrefix 判断 s 是否以 prefix 开头.
+func hasPrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
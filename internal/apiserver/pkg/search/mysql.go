@@ -0,0 +1,88 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"gorm.io/gorm"
+)
+
+// matchAgainst 是 MySQL 全文检索的匹配表达式，依赖 post 表上 (title, content) 的 FULLTEXT 索引.
+const matchAgainst = "MATCH(title, content) AGAINST(? IN NATURAL LANGUAGE MODE)"
+
+// mysqlSearcher 是基于 MySQL FULLTEXT 索引的 Searcher 实现.
+type mysqlSearcher struct {
+	store store.IStore
+}
+
+var _ Searcher = (*mysqlSearcher)(nil)
+
+// NewMySQLSearcher 创建一个基于 MySQL FULLTEXT 索引的 Searcher.
+func NewMySQLSearcher(store store.IStore) *mysqlSearcher {
+	return &mysqlSearcher{store: store}
+}
+
+// Index 无需执行任何操作，FULLTEXT 索引由 MySQL 在写入 post 表时自动维护.
+func (s *mysqlSearcher) Index(ctx context.Context, post *model.PostM) error {
+	return nil
+}
+
+// Delete 无需执行任何操作，FULLTEXT 索引由 MySQL 在删除 post 表记录时自动维护.
+func (s *mysqlSearcher) Delete(ctx context.Context, postIDs ...string) error {
+	return nil
+}
+
+// Search 使用自然语言模式检索文章，并在 Go 中为命中的关键词生成高亮片段.
+func (s *mysqlSearcher) Search(ctx context.Context, q *Query) (*Result, error) {
+	var total int64
+	if err := s.match(ctx, q).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		PostID  string  `gorm:"column:postID"`
+		Title   string  `gorm:"column:title"`
+		Content string  `gorm:"column:content"`
+		Score   float64 `gorm:"column:score"`
+	}
+	db := s.match(ctx, q).Select("postID, title, content, "+matchAgainst+" AS score", q.Text).Order("score DESC")
+	if q.Limit > 0 {
+		db = db.Offset(q.Offset).Limit(q.Limit)
+	}
+	if err := db.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	terms := splitTerms(q.Text)
+	hits := make([]*Hit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, &Hit{
+			PostID:         row.PostID,
+			Score:          row.Score,
+			TitleSnippet:   highlight(row.Title, terms),
+			ContentSnippet: highlight(row.Content, terms),
+		})
+	}
+
+	return &Result{Total: total, Hits: hits}, nil
+}
+
+// match 返回指定用户下命中检索关键词的文章查询.
+func (s *mysqlSearcher) match(ctx context.Context, q *Query) *gorm.DB {
+	return s.store.DB(ctx).Model(&model.PostM{}).Where("userID = ?", q.UserID).Where(matchAgainst, q.Text)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search 定义了博客全文检索的统一接口，并提供 MySQL FULLTEXT 和内嵌 bleve 索引两种实现.
+package search
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+)
+
+const (
+	// highlightPreTag 和 highlightPostTag 用于包裹高亮片段中命中的关键词.
+	highlightPreTag  = "<mark>"
+	highlightPostTag = "</mark>"
+
+	// fragmentSize 定义高亮片段的最大字符数.
+	fragmentSize = 200
+)
+
+// Searcher 定义了博客全文检索需要实现的方法.
+type Searcher interface {
+	// Index 新增或更新文章的索引.
+	Index(ctx context.Context, post *model.PostM) error
+	// Delete 删除指定文章的索引.
+	Delete(ctx context.Context, postIDs ...string) error
+	// Search 检索文章，结果按相关度倒序排列.
+	Search(ctx context.Context, query *Query) (*Result, error)
+}
+
+// Query 表示一次检索请求.
+type Query struct {
+	// UserID 表示只检索该用户的文章.
+	UserID string
+	// Text 表示检索关键词.
+	Text string
+	// Offset 和 Limit 用于分页，Limit 小于等于 0 时返回全部结果.
+	Offset int
+	Limit  int
+}
+
+// Hit 表示一条命中结果.
+type Hit struct {
+	PostID string
+	Score  float64
+	// TitleSnippet 和 ContentSnippet 是命中关键词被高亮后的片段，未命中时为空.
+	TitleSnippet   string
+	ContentSnippet string
+}
+
+// Result 表示检索结果.
+type Result struct {
+	Total int64
+	Hits  []*Hit
+}
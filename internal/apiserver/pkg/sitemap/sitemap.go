@@ -0,0 +1,143 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sitemap 生成站点地图和 robots.txt.
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+)
+
+// MaxURLs 为单个站点地图文件允许包含的最大地址数，由 sitemaps.org 协议规定.
+// 超过该数量时 /sitemap.xml 返回站点地图索引，各分页通过 /sitemap.xml?page=N 访问.
+const MaxURLs = 50000
+
+const xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// Sitemap 表示站点地图的一页，或者（Pages 大于 1 且未指定分页时）站点地图索引.
+type Sitemap struct {
+	// Pages 为站点地图的总页数.
+	Pages int
+	// Page 为当前页码，为 0 表示请求的是站点地图索引.
+	Page int
+	// URLs 为当前页包含的地址，地址为站内相对路径.
+	URLs []*URL
+}
+
+// URL 表示站点地图中的一个地址.
+type URL struct {
+	Path    string
+	LastMod time.Time
+}
+
+// IsIndex 返回是否需要输出站点地图索引.
+func (s *Sitemap) IsIndex() bool {
+	return s.Page == 0 && s.Pages > 1
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	URLs    []urlXML `xml:"url"`
+}
+
+type urlXML struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Sitemaps []urlXML `xml:"sitemap"`
+}
+
+// Marshal 将站点地图序列化为 XML 文档，baseURL 用于将相对路径补全为绝对地址.
+func Marshal(s *Sitemap, baseURL string) ([]byte, error) {
+	var v any
+	if s.IsIndex() {
+		index := sitemapIndex{Xmlns: xmlns, Sitemaps: make([]urlXML, 0, s.Pages)}
+		for page := 1; page <= s.Pages; page++ {
+			index.Sitemaps = append(index.Sitemaps, urlXML{Loc: fmt.Sprintf("%s/sitemap.xml?page=%d", baseURL, page)})
+		}
+		v = index
+	} else {
+		set := urlSet{Xmlns: xmlns, URLs: make([]urlXML, 0, len(s.URLs))}
+		for _, u := range s.URLs {
+			item := urlXML{Loc: baseURL + u.Path}
+			if !u.LastMod.IsZero() {
+				item.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+			}
+			set.URLs = append(set.URLs, item)
+		}
+		v = set
+	}
+
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// Page 从请求的 page 查询参数中解析页码，未指定时返回 0.
+func Page(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("page")
+	if value == "" {
+		return 0, nil
+	}
+
+	page, err := strconv.Atoi(value)
+	if err != nil || page < 1 {
+		return 0, errno.ErrInvalidArgument.WithMessage("invalid sitemap page: %s", value)
+	}
+	return page, nil
+}
+
+// Serve 将站点地图写入 HTTP 响应，baseURL 为站点的根地址.
+func Serve(w http.ResponseWriter, s *Sitemap, baseURL string) {
+	body, err := Marshal(s, baseURL)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	_, _ = w.Write(body)
+}
+
+// ServeRobots 输出 robots.txt. 只允许爬虫抓取公开接口和订阅源，并声明站点地图的地址.
+func ServeRobots(w http.ResponseWriter, baseURL string) {
+	lines := []string{
+		"User-agent: *",
+		"Allow: /v1/public/",
+		"Allow: /feeds/",
+		"Disallow: /v1/",
+		"Disallow: /debug/",
+		"Disallow: /login",
+		"Disallow: /refresh-token",
+		"",
+		"Sitemap: " + baseURL + "/sitemap.xml",
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(strings.Join(lines, "\n") + "\n"))
+}
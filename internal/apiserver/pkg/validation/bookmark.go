@@ -0,0 +1,86 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/cursor"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxBookmarkLimit 定义阅读列表每页最多返回的收藏数量.
+const maxBookmarkLimit = 100
+
+// ValidateBookmarkRules 返回阅读列表相关请求的校验规则.
+func (v *Validator) ValidateBookmarkRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"Cursor": func(value any) error {
+			if _, err := cursor.Decode(value.(string)); err != nil {
+				return errno.ErrInvalidArgument.WithMessage("cursor is invalid")
+			}
+			return nil
+		},
+		"Limit": func(value any) error {
+			if limit := value.(int64); limit < 0 || limit > maxBookmarkLimit {
+				return errno.ErrInvalidArgument.WithMessage("limit must be between 0 and %d", maxBookmarkLimit)
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateAddBookmarkRequest 校验 AddBookmarkRequest 结构体的有效性.
+// 只能收藏其他用户已发布的公开或不公开列出的博文.
+func (v *Validator) ValidateAddBookmarkRequest(ctx context.Context, rq *apiv1.AddBookmarkRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateBookmarkRules()); err != nil {
+		return err
+	}
+
+	postM, err := v.store.Post().Get(ctx, visibility.LinkableWhere().F("postID", rq.GetPostID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+	if postM.UserID == contextx.UserID(ctx) {
+		return errno.ErrInvalidArgument.WithMessage("cannot bookmark your own post")
+	}
+	return nil
+}
+
+// ValidateRemoveBookmarkRequest 校验 RemoveBookmarkRequest 结构体的有效性.
+func (v *Validator) ValidateRemoveBookmarkRequest(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateBookmarkRules())
+}
+
+// ValidateListBookmarksRequest 校验 ListBookmarksRequest 结构体的有效性.
+func (v *Validator) ValidateListBookmarksRequest(ctx context.Context, rq *apiv1.ListBookmarksRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateBookmarkRules())
+}
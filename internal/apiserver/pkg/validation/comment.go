@@ -0,0 +1,163 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxCommentLength 定义单条评论的最大长度.
+const maxCommentLength = 2000
+
+// ValidateCommentRules 返回评论相关请求的校验规则.
+func (v *Validator) ValidateCommentRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"CommentID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("commentID cannot be empty")
+			}
+			return nil
+		},
+		"ParentID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("parentID cannot be empty")
+			}
+			return nil
+		},
+		"Content": func(value any) error {
+			content := value.(string)
+			if content == "" {
+				return errno.ErrInvalidArgument.WithMessage("content cannot be empty")
+			}
+			if utf8.RuneCountInString(content) > maxCommentLength {
+				return errno.ErrInvalidArgument.WithMessage("content must be at most %d characters", maxCommentLength)
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateCommentRequest 校验 CreateCommentRequest 结构体的有效性.
+// 回复只能针对同一博文下的顶级评论.
+func (v *Validator) ValidateCreateCommentRequest(ctx context.Context, rq *apiv1.CreateCommentRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateCommentRules()); err != nil {
+		return err
+	}
+	if _, err := v.getVisiblePost(ctx, rq.GetPostID()); err != nil {
+		return err
+	}
+
+	if rq.ParentID == nil {
+		return nil
+	}
+
+	parent, err := v.getComment(ctx, rq.GetPostID(), rq.GetParentID())
+	if err != nil {
+		return err
+	}
+	if parent.ParentID != "" {
+		return errno.ErrCommentReplyDepth
+	}
+	return nil
+}
+
+// ValidateUpdateCommentRequest 校验 UpdateCommentRequest 结构体的有效性.
+// 只有评论者本人可以修改评论.
+func (v *Validator) ValidateUpdateCommentRequest(ctx context.Context, rq *apiv1.UpdateCommentRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateCommentRules()); err != nil {
+		return err
+	}
+
+	commentM, err := v.getComment(ctx, rq.GetPostID(), rq.GetCommentID())
+	if err != nil {
+		return err
+	}
+	if commentM.UserID != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("only the comment author can update the comment")
+	}
+	return nil
+}
+
+// ValidateDeleteCommentRequest 校验 DeleteCommentRequest 结构体的有效性.
+// 删除权限由 biz 层校验，因为需要结合博文作者和授权策略判断.
+func (v *Validator) ValidateDeleteCommentRequest(ctx context.Context, rq *apiv1.DeleteCommentRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateCommentRules()); err != nil {
+		return err
+	}
+
+	_, err := v.getComment(ctx, rq.GetPostID(), rq.GetCommentID())
+	return err
+}
+
+// ValidateListCommentRequest 校验 ListCommentRequest 结构体的有效性.
+func (v *Validator) ValidateListCommentRequest(ctx context.Context, rq *apiv1.ListCommentRequest) error {
+	if err := genericvalidation.ValidateSelectedFields(rq, v.ValidateCommentRules(), "PostID"); err != nil {
+		return err
+	}
+
+	_, err := v.getVisiblePost(ctx, rq.GetPostID())
+	return err
+}
+
+// getVisiblePost 获取当前用户可以查看、评论和回应的博文.
+// 博文是否可见由博文的状态和可见范围决定，规则见 visibility 包.
+func (v *Validator) getVisiblePost(ctx context.Context, postID string) (*model.PostM, error) {
+	postM, err := v.store.Post().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, errno.ErrDBRead
+	}
+
+	visible, err := visibility.Check(ctx, v.store, postM, contextx.UserID(ctx))
+	if err != nil {
+		return nil, errno.ErrDBRead
+	}
+	if !visible {
+		return nil, errno.ErrPostNotFound
+	}
+	return postM, nil
+}
+
+// getComment 获取指定博文下的评论.
+func (v *Validator) getComment(ctx context.Context, postID string, commentID string) (*model.CommentM, error) {
+	commentM, err := v.store.Comment().Get(ctx, where.F("postID", postID, "commentID", commentID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrCommentNotFound
+		}
+		return nil, errno.ErrDBRead
+	}
+	return commentM, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateExportRules 返回博文导出相关请求的校验规则.
+func (v *Validator) ValidateExportRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"JobID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("jobID cannot be empty")
+			}
+			return nil
+		},
+		"Format": func(value any) error {
+			if _, ok := apiv1.ExportFormat_name[int32(value.(apiv1.ExportFormat))]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("invalid export format")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateExportJobRequest 校验 CreateExportJobRequest 结构体的有效性.
+func (v *Validator) ValidateCreateExportJobRequest(ctx context.Context, rq *apiv1.CreateExportJobRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateExportRules())
+}
+
+// ValidateGetExportJobRequest 校验 GetExportJobRequest 结构体的有效性.
+func (v *Validator) ValidateGetExportJobRequest(ctx context.Context, rq *apiv1.GetExportJobRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateExportRules())
+}
+
+// ValidateExportPostsRequest 校验 ExportPostsRequest 结构体的有效性. jobID 为空时表示直接导出，因此只校验导出格式.
+func (v *Validator) ValidateExportPostsRequest(ctx context.Context, rq *apiv1.ExportPostsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateExportRules(), "Format")
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/cursor"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxTimelineLimit 定义时间线每页最多返回的文章数量.
+const maxTimelineLimit = 100
+
+// ValidateFollowRules 返回关注关系和时间线相关请求的校验规则.
+func (v *Validator) ValidateFollowRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"Cursor": func(value any) error {
+			if _, _, err := cursor.DecodeAt(value.(string)); err != nil {
+				return errno.ErrInvalidArgument.WithMessage("cursor is invalid")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateFollowUserRequest 校验 FollowUserRequest 结构体的有效性.
+func (v *Validator) ValidateFollowUserRequest(ctx context.Context, rq *apiv1.FollowUserRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateFollowRules()); err != nil {
+		return err
+	}
+	if rq.GetUserID() == contextx.UserID(ctx) {
+		return errno.ErrInvalidArgument.WithMessage("cannot follow yourself")
+	}
+
+	return v.validateUserExists(ctx, rq.GetUserID())
+}
+
+// ValidateUnfollowUserRequest 校验 UnfollowUserRequest 结构体的有效性.
+func (v *Validator) ValidateUnfollowUserRequest(ctx context.Context, rq *apiv1.UnfollowUserRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateFollowRules())
+}
+
+// ValidateListFollowersRequest 校验 ListFollowersRequest 结构体的有效性.
+func (v *Validator) ValidateListFollowersRequest(ctx context.Context, rq *apiv1.ListFollowersRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateFollowRules()); err != nil {
+		return err
+	}
+
+	return v.validateUserExists(ctx, rq.GetUserID())
+}
+
+// ValidateListFollowingRequest 校验 ListFollowingRequest 结构体的有效性.
+func (v *Validator) ValidateListFollowingRequest(ctx context.Context, rq *apiv1.ListFollowingRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateFollowRules()); err != nil {
+		return err
+	}
+
+	return v.validateUserExists(ctx, rq.GetUserID())
+}
+
+// ValidateGetTimelineRequest 校验 GetTimelineRequest 结构体的有效性.
+func (v *Validator) ValidateGetTimelineRequest(ctx context.Context, rq *apiv1.GetTimelineRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateFollowRules()); err != nil {
+		return err
+	}
+	if rq.GetLimit() < 0 || rq.GetLimit() > maxTimelineLimit {
+		return errno.ErrInvalidArgument.WithMessage("limit must be between 0 and %d", maxTimelineLimit)
+	}
+	return nil
+}
+
+// validateUserExists 校验指定用户是否存在.
+func (v *Validator) validateUserExists(ctx context.Context, userID string) error {
+	if _, err := v.store.User().Get(ctx, where.F("userID", userID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrUserNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxFilenameLength 定义媒体文件名的最大长度.
+const maxFilenameLength = 255
+
+// allowedMediaTypes 定义允许上传的媒体类型. 媒体类型根据文件内容嗅探得到，
+// 不包含 SVG 等可以内嵌脚本的类型，避免公开访问媒体文件时引入 XSS 风险.
+var allowedMediaTypes = map[string]struct{}{
+	"image/png":  {},
+	"image/jpeg": {},
+	"image/gif":  {},
+	"image/webp": {},
+}
+
+// ValidateMediaRules 返回媒体文件相关请求的校验规则.
+func (v *Validator) ValidateMediaRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"MediaID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("mediaID cannot be empty")
+			}
+			return nil
+		},
+		"Filename": func(value any) error {
+			filename := value.(string)
+			if filename == "" {
+				return errno.ErrInvalidArgument.WithMessage("filename cannot be empty")
+			}
+			if utf8.RuneCountInString(filename) > maxFilenameLength {
+				return errno.ErrInvalidArgument.WithMessage("filename must be at most %d characters", maxFilenameLength)
+			}
+			if strings.ContainsAny(filename, "/\\") {
+				return errno.ErrInvalidArgument.WithMessage("filename cannot contain path separators")
+			}
+			return nil
+		},
+		"Data": func(value any) error {
+			data := value.([]byte)
+			if len(data) == 0 {
+				return errno.ErrInvalidArgument.WithMessage("data cannot be empty")
+			}
+			if int64(len(data)) > v.maxMediaSize {
+				return errno.ErrMediaTooLarge.WithMessage("media file must be at most %d bytes", v.maxMediaSize)
+			}
+			if _, ok := allowedMediaTypes[http.DetectContentType(data)]; !ok {
+				return errno.ErrMediaTypeNotAllowed.WithMessage("media type %s is not allowed", http.DetectContentType(data))
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateUploadMediaRequest 校验 UploadMediaRequest 结构体的有效性.
+// 只能向当前用户自己的博文上传媒体文件.
+func (v *Validator) ValidateUploadMediaRequest(ctx context.Context, rq *apiv1.UploadMediaRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateMediaRules()); err != nil {
+		return err
+	}
+
+	if _, err := v.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
+
+// ValidateGetMediaRequest 校验 GetMediaRequest 结构体的有效性.
+func (v *Validator) ValidateGetMediaRequest(ctx context.Context, rq *apiv1.GetMediaRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMediaRules())
+}
+
+// ValidateDeleteMediaRequest 校验 DeleteMediaRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteMediaRequest(ctx context.Context, rq *apiv1.DeleteMediaRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMediaRules())
+}
+
+// ValidateGetPublicMediaRequest 校验 GetPublicMediaRequest 结构体的有效性.
+func (v *Validator) ValidateGetPublicMediaRequest(ctx context.Context, rq *apiv1.GetPublicMediaRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMediaRules())
+}
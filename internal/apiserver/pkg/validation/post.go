@@ -16,14 +16,42 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
+	"unicode/utf8"
 
-	validation "github.com/go-ozzo/ozzo-validation/v4"
-	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/fieldmask"
+	"github.com/jwcen/miniblog/internal/pkg/slug"
 	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
 	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
+const (
+	// maxPostTags 定义单篇博客最多可以拥有的标签数量.
+	maxPostTags = 10
+	// maxTagLength 定义单个标签的最大长度.
+	maxTagLength = 32
+	// maxPostShares 定义创建博客时最多可以同时分享给的用户数量.
+	maxPostShares = 50
+)
+
+// updatePostPaths 定义了更新博客时 updateMask 中允许列出的字段.
+var updatePostPaths = []string{"title", "content", "tags", "slug", "contentFormat", "visibility"}
+
+// postStatusTransitions 定义了博客状态之间允许的转换.
+// 键为目标状态，值为允许转换到该目标状态的源状态列表.
+var postStatusTransitions = map[apiv1.PostStatus][]apiv1.PostStatus{
+	apiv1.PostStatus_Published: {apiv1.PostStatus_Draft, apiv1.PostStatus_Archived},
+	apiv1.PostStatus_Draft:     {apiv1.PostStatus_Published},
+	apiv1.PostStatus_Archived:  {apiv1.PostStatus_Draft, apiv1.PostStatus_Published},
+}
+
 // Validate 校验字段的有效性.
 func (v *Validator) ValidatePostRules() genericvalidation.Rules {
 	// 定义各字段的校验逻辑，通过一个 map 实现模块化和简化
@@ -46,17 +74,130 @@ func (v *Validator) ValidatePostRules() genericvalidation.Rules {
 			}
 			return nil
 		},
+		"Slug": func(value any) error {
+			if !slug.Valid(value.(string)) {
+				return errno.ErrInvalidArgument.WithMessage("slug must consist of lowercase letters, digits and single hyphens, and be at most %d characters", slug.MaxLength)
+			}
+			return nil
+		},
+		"Status": func(value any) error {
+			if _, ok := apiv1.PostStatus_name[int32(value.(apiv1.PostStatus))]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("invalid post status")
+			}
+			return nil
+		},
+		"UserID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"Visibility": func(value any) error {
+			if _, ok := apiv1.PostVisibility_name[int32(value.(apiv1.PostVisibility))]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("invalid post visibility")
+			}
+			return nil
+		},
+		"SharedWith": func(value any) error {
+			userIDs := value.([]string)
+			if len(userIDs) > maxPostShares {
+				return errno.ErrInvalidArgument.WithMessage("a post can be shared with at most %d users at creation", maxPostShares)
+			}
+			if slices.Contains(userIDs, "") {
+				return errno.ErrInvalidArgument.WithMessage("userID cannot be empty")
+			}
+			return nil
+		},
+		"Role": func(value any) error {
+			if _, ok := apiv1.CollaboratorRole_name[int32(value.(apiv1.CollaboratorRole))]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("invalid collaborator role")
+			}
+			return nil
+		},
+		"ContentFormat": func(value any) error {
+			if _, ok := apiv1.ContentFormat_name[int32(value.(apiv1.ContentFormat))]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("invalid content format")
+			}
+			return nil
+		},
+		"Tags": func(value any) error {
+			tags := value.([]string)
+			if len(tags) > maxPostTags {
+				return errno.ErrInvalidArgument.WithMessage("a post can have at most %d tags", maxPostTags)
+			}
+			for _, tag := range tags {
+				if utf8.RuneCountInString(tag) > maxTagLength {
+					return errno.ErrInvalidArgument.WithMessage("tag must be at most %d characters", maxTagLength)
+				}
+			}
+			return nil
+		},
 	}
 }
 
 // ValidateCreatePostRequest 校验 CreatePostRequest 结构体的有效性.
 func (v *Validator) ValidateCreatePostRequest(ctx context.Context, rq *apiv1.CreatePostRequest) error {
-	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	if rq.PublishAt != nil {
+		if err := validatePublishAt(rq.GetPublishAt()); err != nil {
+			return err
+		}
+	}
+	if rq.Slug != nil {
+		if err := v.validateSlugAvailable(ctx, rq.GetSlug(), ""); err != nil {
+			return err
+		}
+	}
+	return v.validateShareUsers(ctx, rq.GetSharedWith()...)
 }
 
-// ValidateUpdatePostRequest 校验更新用户请求.
+// ValidateUpdatePostRequest 校验更新用户请求. 只有作者和编辑者可以修改博客，且只有作者可以修改博客的可见范围.
+// updateMask 中列出但未设置的字段会被清空，标题、内容、内容格式和可见范围不能清空.
 func (v *Validator) ValidateUpdatePostRequest(ctx context.Context, rq *apiv1.UpdatePostRequest) error {
-	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+	rules := v.ValidatePostRules()
+	if err := genericvalidation.ValidateAllFields(rq, rules); err != nil {
+		return err
+	}
+	if err := fieldmask.Validate(rq.GetUpdateMask(), updatePostPaths...); err != nil {
+		return err
+	}
+
+	mask := fieldmask.New(rq.GetUpdateMask())
+	if mask.Has("title") && rq.Title == nil {
+		return rules["Title"]("")
+	}
+	if mask.Has("content") && rq.Content == nil {
+		return rules["Content"]("")
+	}
+	// 枚举字段未设置时为零值，清空会把文章改为 Markdown 格式或公开可见，因此要求显式设置
+	if mask.Has("contentFormat") && rq.ContentFormat == nil {
+		return errno.ErrInvalidArgument.WithMessage("contentFormat cannot be empty")
+	}
+	if mask.Has("visibility") && rq.Visibility == nil {
+		return errno.ErrInvalidArgument.WithMessage("visibility cannot be empty")
+	}
+
+	if err := v.validateEditablePost(ctx, rq.GetPostID(), mask.Covers("visibility", rq.Visibility != nil)); err != nil {
+		return err
+	}
+	if rq.Slug != nil && mask.Covers("slug", true) {
+		return v.validateSlugAvailable(ctx, rq.GetSlug(), rq.GetPostID())
+	}
+	return nil
+}
+
+// validateSlugAvailable 校验 slug 没有被 postID 以外的博客使用.
+func (v *Validator) validateSlugAvailable(ctx context.Context, postSlug string, postID string) error {
+	exists, err := v.store.Post().SlugExists(ctx, postSlug, postID)
+	if err != nil {
+		return errno.ErrDBRead
+	}
+	if exists {
+		return errno.ErrPostSlugAlreadyExists
+	}
+	return nil
 }
 
 // ValidateDeletePostRequest 校验 DeletePostRequest 结构体的有效性.
@@ -71,8 +212,111 @@ func (v *Validator) ValidateGetPostRequest(ctx context.Context, rq *apiv1.GetPos
 
 // ValidateListPostRequest 校验 ListPostRequest 结构体的有效性.
 func (v *Validator) ValidateListPostRequest(ctx context.Context, rq *apiv1.ListPostRequest) error {
-	if err := validation.Validate(rq.GetTitle(), validation.Length(5, 100), is.URL); err != nil {
-		return errno.ErrInvalidArgument.WithMessage(err.Error())
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit", "Title", "Tags", "Status", "UserID")
+}
+
+// ValidatePublishPostRequest 校验 PublishPostRequest 结构体的有效性.
+func (v *Validator) ValidatePublishPostRequest(ctx context.Context, rq *apiv1.PublishPostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	return v.validatePostStatusTransition(ctx, rq.GetPostID(), apiv1.PostStatus_Published)
+}
+
+// ValidateUnpublishPostRequest 校验 UnpublishPostRequest 结构体的有效性.
+func (v *Validator) ValidateUnpublishPostRequest(ctx context.Context, rq *apiv1.UnpublishPostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	return v.validatePostStatusTransition(ctx, rq.GetPostID(), apiv1.PostStatus_Draft)
+}
+
+// ValidateArchivePostRequest 校验 ArchivePostRequest 结构体的有效性.
+func (v *Validator) ValidateArchivePostRequest(ctx context.Context, rq *apiv1.ArchivePostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	return v.validatePostStatusTransition(ctx, rq.GetPostID(), apiv1.PostStatus_Archived)
+}
+
+// validatePostStatusTransition 校验当前用户的博客能否从当前状态转换到目标状态.
+func (v *Validator) validatePostStatusTransition(ctx context.Context, postID string, target apiv1.PostStatus) error {
+	postM, err := v.store.Post().Get(ctx, where.T(ctx).F("postID", postID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+
+	current := apiv1.PostStatus(postM.Status)
+	if !slices.Contains(postStatusTransitions[target], current) {
+		return errno.ErrPostStatusTransition.WithMessage("cannot change post status from %s to %s", current.String(), target.String())
+	}
+
+	return nil
+}
+
+// ValidateSchedulePostRequest 校验 SchedulePostRequest 结构体的有效性.
+// 只有草稿状态的博客可以设置或取消定时发布.
+func (v *Validator) ValidateSchedulePostRequest(ctx context.Context, rq *apiv1.SchedulePostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+
+	postM, err := v.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+	if postM.Status != int32(apiv1.PostStatus_Draft) {
+		return errno.ErrPostStatusTransition.WithMessage("only draft posts can be scheduled")
+	}
+
+	// publishAt 为空表示取消定时发布
+	if rq.PublishAt == nil {
+		return nil
+	}
+	return validatePublishAt(rq.GetPublishAt())
+}
+
+// ValidateListTrashedPostsRequest 校验 ListTrashedPostsRequest 结构体的有效性.
+func (v *Validator) ValidateListTrashedPostsRequest(ctx context.Context, rq *apiv1.ListTrashedPostsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+// ValidateRestorePostRequest 校验 RestorePostRequest 结构体的有效性.
+func (v *Validator) ValidateRestorePostRequest(ctx context.Context, rq *apiv1.RestorePostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+// ValidateGetPostStatsRequest 校验 GetPostStatsRequest 结构体的有效性. 只有作者可以查看博客的浏览统计.
+func (v *Validator) ValidateGetPostStatsRequest(ctx context.Context, rq *apiv1.GetPostStatsRequest) error {
+	if err := genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "PostID"); err != nil {
+		return err
+	}
+	if _, _, err := viewcount.ParseRange(rq.StartDate, rq.EndDate, time.Now()); err != nil {
+		return errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	if _, err := v.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
+
+// validatePublishAt 校验定时发布时间是否有效，定时发布时间必须晚于当前时间.
+func validatePublishAt(publishAt *timestamppb.Timestamp) error {
+	if err := publishAt.CheckValid(); err != nil {
+		return errno.ErrInvalidArgument.WithMessage("invalid publishAt: %v", err)
+	}
+	if !publishAt.AsTime().After(time.Now()) {
+		return errno.ErrInvalidArgument.WithMessage("publishAt must be in the future")
 	}
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
+	return nil
 }
@@ -0,0 +1,110 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateAddCollaboratorRequest 校验 AddCollaboratorRequest 结构体的有效性. 只能邀请其他已存在的用户成为自己博客的协作者.
+func (v *Validator) ValidateAddCollaboratorRequest(ctx context.Context, rq *apiv1.AddCollaboratorRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	if err := v.validateOwnPost(ctx, rq.GetPostID()); err != nil {
+		return err
+	}
+	if rq.GetUserID() == contextx.UserID(ctx) {
+		return errno.ErrInvalidArgument.WithMessage("cannot add yourself as a collaborator")
+	}
+
+	if _, err := v.store.User().Get(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		return errno.ErrUserNotFound
+	}
+	return nil
+}
+
+// ValidateRemoveCollaboratorRequest 校验 RemoveCollaboratorRequest 结构体的有效性. 作者可以移除任意协作者，协作者只能移除自己.
+func (v *Validator) ValidateRemoveCollaboratorRequest(ctx context.Context, rq *apiv1.RemoveCollaboratorRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+
+	postM, err := v.getVisiblePost(ctx, rq.GetPostID())
+	if err != nil {
+		return err
+	}
+	if userID := contextx.UserID(ctx); postM.UserID != userID && rq.GetUserID() != userID {
+		return errno.ErrPostPermissionDenied
+	}
+	return nil
+}
+
+// ValidateListCollaboratorsRequest 校验 ListCollaboratorsRequest 结构体的有效性. 只有作者和协作者可以查看协作者列表.
+func (v *Validator) ValidateListCollaboratorsRequest(ctx context.Context, rq *apiv1.ListCollaboratorsRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+
+	_, _, err := v.getCollaborativePost(ctx, rq.GetPostID())
+	return err
+}
+
+// validateEditablePost 校验当前用户是博客的作者或编辑者. ownerOnly 为 true 时只允许作者修改.
+// 当前用户无权查看博客时返回 errno.ErrPostNotFound，有权查看但无权修改时返回 errno.ErrPostPermissionDenied.
+func (v *Validator) validateEditablePost(ctx context.Context, postID string, ownerOnly bool) error {
+	_, role, err := v.getCollaborativePost(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return nil
+	}
+	if ownerOnly || *role != int32(apiv1.CollaboratorRole_Editor) {
+		return errno.ErrPostPermissionDenied
+	}
+	return nil
+}
+
+// getCollaborativePost 获取当前用户作为作者或协作者的博客，同时返回当前用户的协作角色，当前用户是作者时角色为 nil.
+func (v *Validator) getCollaborativePost(ctx context.Context, postID string) (*model.PostM, *int32, error) {
+	postM, err := v.getVisiblePost(ctx, postID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userID := contextx.UserID(ctx)
+	if postM.UserID == userID {
+		return postM, nil, nil
+	}
+
+	roles, err := v.store.PostCollaborator().Roles(ctx, userID, postM.PostID)
+	if err != nil {
+		return nil, nil, errno.ErrDBRead
+	}
+	role, ok := roles[postM.PostID]
+	if !ok {
+		return nil, nil, errno.ErrPostPermissionDenied
+	}
+	return postM, &role, nil
+}
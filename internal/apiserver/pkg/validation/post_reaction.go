@@ -0,0 +1,100 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"slices"
+
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidatePostReactionRules 返回博文点赞和表情回应相关请求的校验规则.
+func (v *Validator) ValidatePostReactionRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"Reaction": func(value any) error {
+			if !slices.Contains(known.Reactions, value.(string)) {
+				return errno.ErrInvalidArgument.WithMessage("reaction must be one of %v", known.Reactions)
+			}
+			return nil
+		},
+		"Limit": func(value any) error {
+			if value.(int64) < 0 {
+				return errno.ErrInvalidArgument.WithMessage("limit cannot be negative")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateLikePostRequest 校验 LikePostRequest 结构体的有效性.
+func (v *Validator) ValidateLikePostRequest(ctx context.Context, rq *apiv1.LikePostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostReactionRules()); err != nil {
+		return err
+	}
+
+	_, err := v.getVisiblePost(ctx, rq.GetPostID())
+	return err
+}
+
+// ValidateUnlikePostRequest 校验 UnlikePostRequest 结构体的有效性.
+func (v *Validator) ValidateUnlikePostRequest(ctx context.Context, rq *apiv1.UnlikePostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostReactionRules()); err != nil {
+		return err
+	}
+
+	_, err := v.getVisiblePost(ctx, rq.GetPostID())
+	return err
+}
+
+// ValidateAddPostReactionRequest 校验 AddPostReactionRequest 结构体的有效性.
+func (v *Validator) ValidateAddPostReactionRequest(ctx context.Context, rq *apiv1.AddPostReactionRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostReactionRules()); err != nil {
+		return err
+	}
+
+	_, err := v.getVisiblePost(ctx, rq.GetPostID())
+	return err
+}
+
+// ValidateRemovePostReactionRequest 校验 RemovePostReactionRequest 结构体的有效性.
+func (v *Validator) ValidateRemovePostReactionRequest(ctx context.Context, rq *apiv1.RemovePostReactionRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostReactionRules()); err != nil {
+		return err
+	}
+
+	_, err := v.getVisiblePost(ctx, rq.GetPostID())
+	return err
+}
+
+// ValidateListPostLikersRequest 校验 ListPostLikersRequest 结构体的有效性.
+func (v *Validator) ValidateListPostLikersRequest(ctx context.Context, rq *apiv1.ListPostLikersRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostReactionRules()); err != nil {
+		return err
+	}
+
+	_, err := v.getVisiblePost(ctx, rq.GetPostID())
+	return err
+}
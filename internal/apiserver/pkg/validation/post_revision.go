@@ -0,0 +1,106 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+)
+
+// ValidatePostRevisionRules 返回博客历史版本相关请求的校验规则.
+func (v *Validator) ValidatePostRevisionRules() genericvalidation.Rules {
+	validateRevision := func(value any) error {
+		if value.(int64) < 1 {
+			return errno.ErrInvalidArgument.WithMessage("revision must be greater than 0")
+		}
+		return nil
+	}
+
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"Revision":     validateRevision,
+		"FromRevision": validateRevision,
+		"ToRevision":   validateRevision,
+	}
+}
+
+// ValidateListPostRevisionsRequest 校验 ListPostRevisionsRequest 结构体的有效性.
+func (v *Validator) ValidateListPostRevisionsRequest(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) error {
+	if err := genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRevisionRules(), "PostID"); err != nil {
+		return err
+	}
+
+	_, _, err := v.getCollaborativePost(ctx, rq.GetPostID())
+	return err
+}
+
+// ValidateGetPostRevisionRequest 校验 GetPostRevisionRequest 结构体的有效性.
+func (v *Validator) ValidateGetPostRevisionRequest(ctx context.Context, rq *apiv1.GetPostRevisionRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRevisionRules()); err != nil {
+		return err
+	}
+	if _, _, err := v.getCollaborativePost(ctx, rq.GetPostID()); err != nil {
+		return err
+	}
+	return v.validatePostRevisionExists(ctx, rq.GetPostID(), rq.GetRevision())
+}
+
+// ValidateDiffPostRevisionsRequest 校验 DiffPostRevisionsRequest 结构体的有效性.
+func (v *Validator) ValidateDiffPostRevisionsRequest(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRevisionRules()); err != nil {
+		return err
+	}
+	if _, _, err := v.getCollaborativePost(ctx, rq.GetPostID()); err != nil {
+		return err
+	}
+	if err := v.validatePostRevisionExists(ctx, rq.GetPostID(), rq.GetFromRevision()); err != nil {
+		return err
+	}
+	return v.validatePostRevisionExists(ctx, rq.GetPostID(), rq.GetToRevision())
+}
+
+// ValidateRestorePostRevisionRequest 校验 RestorePostRevisionRequest 结构体的有效性. 只有作者和编辑者可以回滚博客.
+func (v *Validator) ValidateRestorePostRevisionRequest(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRevisionRules()); err != nil {
+		return err
+	}
+	if err := v.validateEditablePost(ctx, rq.GetPostID(), false); err != nil {
+		return err
+	}
+	return v.validatePostRevisionExists(ctx, rq.GetPostID(), rq.GetRevision())
+}
+
+// validatePostRevisionExists 校验博客是否存在指定的历史版本，调用方负责校验当前用户是否可以访问博客.
+func (v *Validator) validatePostRevisionExists(ctx context.Context, postID string, revision int64) error {
+	_, err := v.store.PostRevision().Get(ctx, where.F("postID", postID, "revision", revision))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostRevisionNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// maxSearchQueryLength 定义检索关键词的最大长度.
+const maxSearchQueryLength = 256
+
+// ValidatePostSearchRules 校验字段的有效性.
+func (v *Validator) ValidatePostSearchRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"Query": func(value any) error {
+			query := value.(string)
+			if strings.TrimSpace(query) == "" {
+				return errno.ErrInvalidArgument.WithMessage("query cannot be empty")
+			}
+			if utf8.RuneCountInString(query) > maxSearchQueryLength {
+				return errno.ErrInvalidArgument.WithMessage("query must be at most %d characters", maxSearchQueryLength)
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateSearchPostsRequest 校验 SearchPostsRequest 结构体的有效性.
+func (v *Validator) ValidateSearchPostsRequest(ctx context.Context, rq *apiv1.SearchPostsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostSearchRules(), "Query")
+}
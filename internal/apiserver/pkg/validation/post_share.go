@@ -0,0 +1,84 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateSharePostRequest 校验 SharePostRequest 结构体的有效性. 只能将自己的博客分享给其他已存在的用户.
+func (v *Validator) ValidateSharePostRequest(ctx context.Context, rq *apiv1.SharePostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	if err := v.validateOwnPost(ctx, rq.GetPostID()); err != nil {
+		return err
+	}
+	return v.validateShareUsers(ctx, rq.GetUserID())
+}
+
+// ValidateUnsharePostRequest 校验 UnsharePostRequest 结构体的有效性.
+func (v *Validator) ValidateUnsharePostRequest(ctx context.Context, rq *apiv1.UnsharePostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+	return v.validateOwnPost(ctx, rq.GetPostID())
+}
+
+// validateOwnPost 校验博客存在且属于当前用户.
+func (v *Validator) validateOwnPost(ctx context.Context, postID string) error {
+	if _, err := v.store.Post().Get(ctx, where.T(ctx).F("postID", postID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
+
+// validateShareUsers 校验被分享的用户都已存在，且不包括当前用户自己.
+func (v *Validator) validateShareUsers(ctx context.Context, userIDs ...string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if slices.Contains(userIDs, contextx.UserID(ctx)) {
+		return errno.ErrInvalidArgument.WithMessage("cannot share a post with yourself")
+	}
+
+	_, userList, err := v.store.User().List(ctx, where.F("userID", userIDs))
+	if err != nil {
+		return errno.ErrDBRead
+	}
+	found := make(map[string]struct{}, len(userList))
+	for _, userM := range userList {
+		found[userM.UserID] = struct{}{}
+	}
+	for _, userID := range userIDs {
+		if _, ok := found[userID]; !ok {
+			return errno.ErrUserNotFound
+		}
+	}
+	return nil
+}
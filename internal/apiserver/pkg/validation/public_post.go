@@ -0,0 +1,70 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/visibility"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// ValidateGetPublicPostRequest 校验 GetPublicPostRequest 结构体的有效性.
+// 未发布或无法通过链接访问的博客与不存在的博客返回相同的错误，避免泄露这些博客的存在.
+func (v *Validator) ValidateGetPublicPostRequest(ctx context.Context, rq *apiv1.GetPublicPostRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidatePostRules()); err != nil {
+		return err
+	}
+
+	if _, err := v.store.Post().Get(ctx, visibility.LinkableWhere().F("postID", rq.GetPostID())); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrPostNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
+
+// ValidateListPublicPostsRequest 校验 ListPublicPostsRequest 结构体的有效性.
+func (v *Validator) ValidateListPublicPostsRequest(ctx context.Context, rq *apiv1.ListPublicPostsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit", "Tags")
+}
+
+// ValidateListAuthorPublicPostsRequest 校验 ListAuthorPublicPostsRequest 结构体的有效性.
+func (v *Validator) ValidateListAuthorPublicPostsRequest(ctx context.Context, rq *apiv1.ListAuthorPublicPostsRequest) error {
+	if err := genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Username"); err != nil {
+		return err
+	}
+
+	if _, err := v.store.User().Get(ctx, where.F("username", rq.GetUsername())); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrUserNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
+
+// ValidateGetPostBySlugRequest 校验 GetPostBySlugRequest 结构体的有效性.
+// slug 是否存在由 biz 层判断，因为请求的可能是文章曾经使用过的 slug.
+func (v *Validator) ValidateGetPostBySlugRequest(ctx context.Context, rq *apiv1.GetPostBySlugRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	genericvalidation "github.com/onexstack/onexstack/pkg/validation"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// maxSeriesTitleLength 定义系列标题的最大长度.
+	maxSeriesTitleLength = 256
+	// maxSeriesDescriptionLength 定义系列简介的最大长度.
+	maxSeriesDescriptionLength = 1024
+	// maxSeriesPosts 定义单个系列最多可以包含的博文数量.
+	maxSeriesPosts = 100
+)
+
+// ValidateSeriesRules 返回系列相关请求的校验规则.
+func (v *Validator) ValidateSeriesRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"SeriesID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("seriesID cannot be empty")
+			}
+			return nil
+		},
+		"Title": func(value any) error {
+			title := value.(string)
+			if title == "" {
+				return errno.ErrInvalidArgument.WithMessage("title cannot be empty")
+			}
+			if utf8.RuneCountInString(title) > maxSeriesTitleLength {
+				return errno.ErrInvalidArgument.WithMessage("title must be at most %d characters", maxSeriesTitleLength)
+			}
+			return nil
+		},
+		"Description": func(value any) error {
+			if utf8.RuneCountInString(value.(string)) > maxSeriesDescriptionLength {
+				return errno.ErrInvalidArgument.WithMessage("description must be at most %d characters", maxSeriesDescriptionLength)
+			}
+			return nil
+		},
+		"PostIDs": func(value any) error {
+			postIDs := value.([]string)
+			if len(postIDs) > maxSeriesPosts {
+				return errno.ErrInvalidArgument.WithMessage("a series can have at most %d posts", maxSeriesPosts)
+			}
+			seen := sets.New[string]()
+			for _, postID := range postIDs {
+				if postID == "" {
+					return errno.ErrInvalidArgument.WithMessage("postIDs cannot contain empty values")
+				}
+				if seen.Has(postID) {
+					return errno.ErrInvalidArgument.WithMessage("postIDs cannot contain duplicate values")
+				}
+				seen.Insert(postID)
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateSeriesRequest 校验 CreateSeriesRequest 结构体的有效性.
+func (v *Validator) ValidateCreateSeriesRequest(ctx context.Context, rq *apiv1.CreateSeriesRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules()); err != nil {
+		return err
+	}
+	return v.validateSeriesPosts(ctx, "", rq.GetPostIDs())
+}
+
+// ValidateUpdateSeriesRequest 校验 UpdateSeriesRequest 结构体的有效性.
+func (v *Validator) ValidateUpdateSeriesRequest(ctx context.Context, rq *apiv1.UpdateSeriesRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules()); err != nil {
+		return err
+	}
+	if err := v.validateSeriesExists(ctx, rq.GetSeriesID()); err != nil {
+		return err
+	}
+	return v.validateSeriesPosts(ctx, rq.GetSeriesID(), rq.GetPostIDs())
+}
+
+// ValidateReorderSeriesRequest 校验 ReorderSeriesRequest 结构体的有效性.
+// 调整后的顺序必须恰好包含系列中的全部博文，不能借此增加或移除博文.
+func (v *Validator) ValidateReorderSeriesRequest(ctx context.Context, rq *apiv1.ReorderSeriesRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules()); err != nil {
+		return err
+	}
+	if err := v.validateSeriesExists(ctx, rq.GetSeriesID()); err != nil {
+		return err
+	}
+
+	postList, err := v.store.SeriesPost().ListPosts(ctx, rq.GetSeriesID())
+	if err != nil {
+		return errno.ErrDBRead
+	}
+	current := sets.New[string]()
+	for _, post := range postList {
+		current.Insert(post.PostID)
+	}
+	if !current.Equal(sets.New(rq.GetPostIDs()...)) {
+		return errno.ErrInvalidArgument.WithMessage("postIDs must contain exactly the posts in the series")
+	}
+	return nil
+}
+
+// ValidateGetSeriesRequest 校验 GetSeriesRequest 结构体的有效性.
+func (v *Validator) ValidateGetSeriesRequest(ctx context.Context, rq *apiv1.GetSeriesRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules())
+}
+
+// validateSeriesExists 校验当前用户拥有指定的系列.
+func (v *Validator) validateSeriesExists(ctx context.Context, seriesID string) error {
+	if _, err := v.store.Series().Get(ctx, where.T(ctx).F("seriesID", seriesID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrSeriesNotFound
+		}
+		return errno.ErrDBRead
+	}
+	return nil
+}
+
+// validateSeriesPosts 校验博文都属于当前用户，并且没有加入 seriesID 以外的系列.
+func (v *Validator) validateSeriesPosts(ctx context.Context, seriesID string, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	count, _, err := v.store.Post().List(ctx, where.T(ctx).F("postID", postIDs))
+	if err != nil {
+		return errno.ErrDBRead
+	}
+	if count != int64(len(postIDs)) {
+		return errno.ErrPostNotFound
+	}
+
+	_, memberList, err := v.store.SeriesPost().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return errno.ErrDBRead
+	}
+	for _, member := range memberList {
+		if member.SeriesID != seriesID {
+			return errno.ErrPostInAnotherSeries.WithMessage("post %s already belongs to another series", member.PostID)
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/jwcen/miniblog/internal/pkg/contextx"
 	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/fieldmask"
 	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 )
 
@@ -91,12 +92,38 @@ func (v *Validator) ValidateCreateUserRequest(ctx context.Context, rq *apiv1.Cre
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
 
-// ValidateUpdateUserRequest 校验更新用户请求.
+// ValidateUpdateUserRequest 校验更新用户请求. 指定 updateMask 时，校验列出的字段更新后的值，列出但未设置的字段会被清空.
 func (v *Validator) ValidateUpdateUserRequest(ctx context.Context, rq *apiv1.UpdateUserRequest) error {
 	if rq.GetUserID() != contextx.UserID(ctx) {
 		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
 	}
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "UserID")
+	rules := v.ValidateUserRules()
+	if err := genericvalidation.ValidateSelectedFields(rq, rules, "UserID"); err != nil {
+		return err
+	}
+	if err := fieldmask.Validate(rq.GetUpdateMask(), "username", "nickname", "email", "phone"); err != nil {
+		return err
+	}
+
+	mask := fieldmask.New(rq.GetUpdateMask())
+	for _, field := range []struct {
+		path  string
+		rule  string
+		value string
+	}{
+		{"username", "Username", rq.GetUsername()},
+		{"nickname", "Nickname", rq.GetNickname()},
+		{"email", "Email", rq.GetEmail()},
+		{"phone", "Phone", rq.GetPhone()},
+	} {
+		if !mask.Has(field.path) {
+			continue
+		}
+		if err := rules[field.rule](field.value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ValidateDeleteUserRequest 校验 DeleteUserRequest 结构体的有效性.
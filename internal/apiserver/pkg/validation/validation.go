@@ -36,10 +36,12 @@ var ProviderSet = wire.NewSet(New)
 
 type Validator struct {
 	store store.IStore
+	// maxMediaSize 为单个媒体文件允许的最大字节数.
+	maxMediaSize int64
 }
 
-func New(store store.IStore) *Validator {
-	return &Validator{store: store}
+func New(store store.IStore, maxMediaSize int64) *Validator {
+	return &Validator{store: store, maxMediaSize: maxMediaSize}
 }
 
 // isValidUsername 校验用户名是否合法.
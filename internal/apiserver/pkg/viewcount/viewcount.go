@@ -0,0 +1,232 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package viewcount 在进程内缓冲博文的浏览次数和访客，定期批量写入数据库，避免每次读取博文都写一次数据库.
+// 缓冲的数据在服务优雅关闭时会全部写入，进程异常退出时最多丢失一个写入周期内的数据.
+package viewcount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/hll"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+)
+
+const (
+	// flushInterval 是将缓冲的浏览统计写入数据库的时间间隔.
+	flushInterval = 30 * time.Second
+
+	// DayLayout 是浏览统计中日期的格式. 统计按 UTC 日期划分.
+	DayLayout = "2006-01-02"
+
+	// DefaultRangeDays 是未指定开始日期时查询的天数.
+	DefaultRangeDays = 30
+
+	// MaxRangeDays 是单次查询允许的最大天数.
+	MaxRangeDays = 366
+)
+
+// ParseRange 解析浏览统计的查询日期范围，返回开始和结束日期（均包含）.
+// 结束日期默认为 now 所在的 UTC 日期，开始日期默认为结束日期前 DefaultRangeDays-1 天.
+func ParseRange(startDate *string, endDate *string, now time.Time) (time.Time, time.Time, error) {
+	end, err := time.Parse(DayLayout, now.UTC().Format(DayLayout))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if endDate != nil {
+		if end, err = time.Parse(DayLayout, *endDate); err != nil {
+			return time.Time{}, time.Time{}, errors.New("endDate must be in YYYY-MM-DD format")
+		}
+	}
+
+	start := end.AddDate(0, 0, 1-DefaultRangeDays)
+	if startDate != nil {
+		if start, err = time.Parse(DayLayout, *startDate); err != nil {
+			return time.Time{}, time.Time{}, errors.New("startDate must be in YYYY-MM-DD format")
+		}
+	}
+
+	if start.After(end) {
+		return time.Time{}, time.Time{}, errors.New("startDate must not be after endDate")
+	}
+	if end.Sub(start) >= MaxRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range must not exceed %d days", MaxRangeDays)
+	}
+	return start, end, nil
+}
+
+// key 标识一篇博文在某一天的统计.
+type key struct {
+	postID string
+	day    string
+}
+
+// entry 是尚未写入数据库的统计数据.
+type entry struct {
+	views    int64
+	visitors *hll.Sketch
+}
+
+// Counter 缓冲博文的浏览统计，并在后台定期写入数据库.
+type Counter struct {
+	store store.IStore
+	clock clock.WithTicker
+
+	mu      sync.Mutex
+	pending map[key]*entry
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// NewCounter 创建一个 *Counter 实例.
+func NewCounter(store store.IStore, clock clock.WithTicker) *Counter {
+	return &Counter{
+		store:   store,
+		clock:   clock,
+		pending: make(map[key]*entry),
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+}
+
+// Record 记录一次博文浏览. visitor 用于估算独立访客数，为空时只累加浏览次数.
+func (c *Counter) Record(postID string, visitor string) {
+	k := key{postID: postID, day: c.clock.Now().UTC().Format(DayLayout)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.pending[k]
+	if !ok {
+		e = &entry{visitors: hll.New()}
+		c.pending[k] = e
+	}
+	e.views++
+	if visitor != "" {
+		e.visitors.Insert(visitor)
+	}
+}
+
+// Flush 将缓冲的浏览统计写入数据库. 写入失败的统计会重新放回缓冲区，在下次写入时重试.
+func (c *Counter) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[key]*entry)
+	c.mu.Unlock()
+
+	var errs []error
+	for k, e := range pending {
+		if err := c.flush(ctx, k, e); err != nil {
+			c.requeue(k, e)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Start 启动定期写入任务.
+func (c *Counter) Start() {
+	c.startOnce.Do(func() {
+		// 在启动协程之前创建 ticker，确保调用方推进时钟时 ticker 已经存在
+		ticker := c.clock.NewTicker(flushInterval)
+		go c.run(ticker)
+	})
+}
+
+// Stop 停止定期写入任务，并将缓冲区中剩余的统计写入数据库.
+func (c *Counter) Stop(ctx context.Context) {
+	// 任务未启动时直接标记为已退出，之后再调用 Start 也不会启动任务
+	c.startOnce.Do(func() { close(c.doneCh) })
+	c.stopOnce.Do(func() { close(c.stopCh) })
+
+	select {
+	case <-c.doneCh:
+	case <-ctx.Done():
+		log.Errorw("Timed out waiting for view counter to stop", "err", ctx.Err())
+	}
+
+	if err := c.Flush(ctx); err != nil {
+		log.Errorw("Failed to flush post views on stop", "err", err)
+	}
+}
+
+func (c *Counter) run(ticker clock.Ticker) {
+	defer close(c.doneCh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-ticker.C():
+			if err := c.Flush(context.Background()); err != nil {
+				log.Errorw("Failed to flush post views", "err", err)
+			}
+		}
+	}
+}
+
+// flush 将一篇博文一天的统计合并到数据库中已有的统计.
+// 访客草图需要读出后合并再写回，因此先锁定统计记录，避免多个实例同时写入时丢失彼此的访客.
+func (c *Counter) flush(ctx context.Context, k key, e *entry) error {
+	return c.store.TX(ctx, func(ctx context.Context) error {
+		visitors := hll.New()
+		viewM, err := c.store.PostView().GetForUpdate(ctx, k.postID, k.day)
+		if err != nil {
+			return err
+		}
+		if len(viewM.VisitorSketch) > 0 {
+			saved, err := hll.FromBytes(viewM.VisitorSketch)
+			if err != nil {
+				// 草图损坏时只影响独立访客数的估算，不影响浏览次数的统计
+				log.Warnw("Discarding invalid visitor sketch", "postID", k.postID, "day", k.day, "err", err)
+			} else {
+				visitors = saved
+			}
+		}
+		visitors.Merge(e.visitors)
+
+		return c.store.PostView().Add(ctx, &model.PostViewM{
+			PostID:        k.postID,
+			Day:           k.day,
+			Views:         e.views,
+			Visitors:      int64(visitors.Estimate()),
+			VisitorSketch: visitors.Bytes(),
+		})
+	})
+}
+
+// requeue 将写入失败的统计合并回缓冲区.
+func (c *Counter) requeue(k key, e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cur, ok := c.pending[k]; ok {
+		cur.views += e.views
+		cur.visitors.Merge(e.visitors)
+		return
+	}
+	c.pending[k] = e
+}
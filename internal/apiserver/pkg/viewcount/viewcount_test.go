@@ -0,0 +1,191 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package viewcount
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+)
+
+var (
+	dbOnce sync.Once
+	testDB *gorm.DB
+)
+
+// newTestStore 返回基于 SQLite 内存数据库的 store 实例，并清空浏览统计.
+func newTestStore(t *testing.T) store.IStore {
+	t.Helper()
+
+	dbOnce.Do(func() {
+		db, err := gorm.Open(sqlite.Open("file:viewcount?mode=memory&cache=shared"), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(&model.PostViewM{}))
+		testDB = db
+	})
+	require.NoError(t, testDB.Where("1 = 1").Delete(&model.PostViewM{}).Error)
+
+	return store.NewStore(testDB)
+}
+
+func getView(t *testing.T, s store.IStore, postID string, day string) *model.PostViewM {
+	t.Helper()
+
+	viewM, err := s.PostView().Get(context.Background(), where.F("postID", postID, "day", day))
+	require.NoError(t, err)
+	return viewM
+}
+
+func TestCounterFlush(t *testing.T) {
+	s := newTestStore(t)
+	clk := clocktesting.NewFakeClock(time.Date(2025, 3, 1, 23, 0, 0, 0, time.UTC))
+	c := NewCounter(s, clk)
+
+	for i := 0; i < 10; i++ {
+		c.Record("post-a", "visitor-"+strconv.Itoa(i%4))
+	}
+	c.Record("post-b", "")
+	require.NoError(t, c.Flush(context.Background()))
+
+	viewM := getView(t, s, "post-a", "2025-03-01")
+	assert.EqualValues(t, 10, viewM.Views)
+	assert.EqualValues(t, 4, viewM.Visitors)
+	viewM = getView(t, s, "post-b", "2025-03-01")
+	assert.EqualValues(t, 1, viewM.Views)
+	assert.Zero(t, viewM.Visitors)
+
+	// 再次写入时累加浏览次数，并与已保存的访客合并
+	for i := 0; i < 6; i++ {
+		c.Record("post-a", "visitor-"+strconv.Itoa(i))
+	}
+	require.NoError(t, c.Flush(context.Background()))
+	viewM = getView(t, s, "post-a", "2025-03-01")
+	assert.EqualValues(t, 16, viewM.Views)
+	assert.EqualValues(t, 6, viewM.Visitors)
+
+	// 跨过 UTC 零点后的浏览计入下一天
+	clk.Step(2 * time.Hour)
+	c.Record("post-a", "visitor-0")
+	require.NoError(t, c.Flush(context.Background()))
+	viewM = getView(t, s, "post-a", "2025-03-02")
+	assert.EqualValues(t, 1, viewM.Views)
+	assert.EqualValues(t, 1, viewM.Visitors)
+
+	// 没有缓冲数据时不写入数据库
+	require.NoError(t, c.Flush(context.Background()))
+	count, _, err := s.PostView().List(context.Background(), where.F("postID", "post-a"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
+func TestCounterRequeue(t *testing.T) {
+	s := newTestStore(t)
+	c := NewCounter(s, clocktesting.NewFakeClock(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	c.Record("post-a", "visitor-1")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, c.Flush(ctx))
+
+	// 写入失败的统计保留在缓冲区中，与之后的浏览一起写入
+	c.Record("post-a", "visitor-2")
+	require.NoError(t, c.Flush(context.Background()))
+	viewM := getView(t, s, "post-a", "2025-03-01")
+	assert.EqualValues(t, 2, viewM.Views)
+	assert.EqualValues(t, 2, viewM.Visitors)
+}
+
+func TestCounterStartStop(t *testing.T) {
+	s := newTestStore(t)
+	clk := clocktesting.NewFakeClock(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	c := NewCounter(s, clk)
+	c.Start()
+
+	c.Record("post-a", "visitor-1")
+	clk.Step(flushInterval)
+	assert.Eventually(t, func() bool {
+		_, err := s.PostView().Get(context.Background(), where.F("postID", "post-a"))
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// 停止时写入缓冲区中剩余的统计
+	c.Record("post-a", "visitor-2")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	c.Stop(ctx)
+
+	viewM := getView(t, s, "post-a", "2025-03-01")
+	assert.EqualValues(t, 2, viewM.Views)
+}
+
+func TestCounterStopWithoutStart(t *testing.T) {
+	s := newTestStore(t)
+	c := NewCounter(s, clocktesting.NewFakeClock(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	c.Record("post-a", "")
+	c.Stop(context.Background())
+
+	_, err := s.PostView().Get(context.Background(), where.F("postID", "post-a"))
+	assert.False(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestParseRange(t *testing.T) {
+	now := time.Date(2025, 3, 10, 23, 0, 0, 0, time.FixedZone("UTC-8", -8*3600))
+	date := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		start     *string
+		end       *string
+		wantStart string
+		wantEnd   string
+		wantErr   bool
+	}{
+		{name: "default", wantStart: "2025-02-10", wantEnd: "2025-03-11"},
+		{name: "end only", end: date("2025-01-31"), wantStart: "2025-01-02", wantEnd: "2025-01-31"},
+		{name: "start only", start: date("2025-03-01"), wantStart: "2025-03-01", wantEnd: "2025-03-11"},
+		{name: "single day", start: date("2025-03-01"), end: date("2025-03-01"), wantStart: "2025-03-01", wantEnd: "2025-03-01"},
+		{name: "max range", start: date("2024-01-01"), end: date("2024-12-31"), wantStart: "2024-01-01", wantEnd: "2024-12-31"},
+		{name: "too long", start: date("2024-01-01"), end: date("2025-01-01"), wantErr: true},
+		{name: "reversed", start: date("2025-03-02"), end: date("2025-03-01"), wantErr: true},
+		{name: "bad start", start: date("2025/03/01"), wantErr: true},
+		{name: "bad end", end: date("yesterday"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := ParseRange(tt.start, tt.end, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStart, start.Format(DayLayout))
+			assert.Equal(t, tt.wantEnd, end.Format(DayLayout))
+		})
+	}
+}
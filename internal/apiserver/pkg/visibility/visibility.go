@@ -0,0 +1,132 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package visibility 实现博文可见范围的判断规则.
+//
+// 作者本人始终可以查看自己的博文. 其他用户能否查看取决于博文的可见范围：
+// Public 和 Unlisted 的博文发布后可以查看，其中只有 Public 的博文会出现在列表中；
+// Private 的博文只有作者本人可以查看；Shared 的博文只有被分享的用户可以查看，且不受博文状态的限制，
+// 以便作者在发布前将草稿分享给他人审阅. 博文的协作者不受可见范围和状态的限制，始终可以查看博文.
+// 可见范围只影响读取，修改博文的权限由作者和协作者角色决定.
+package visibility
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+var (
+	published = int32(apiv1.PostStatus_Published)
+	public    = int32(apiv1.PostVisibility_Public)
+	unlisted  = int32(apiv1.PostVisibility_Unlisted)
+	shared    = int32(apiv1.PostVisibility_Shared)
+)
+
+// Grant 表示博文作者授予用户的访问权限.
+type Grant int
+
+const (
+	// GrantNone 表示用户没有任何授权.
+	GrantNone Grant = iota
+	// GrantShared 表示博文已分享给用户，只在博文的可见范围为 Shared 时生效.
+	GrantShared
+	// GrantCollaborator 表示用户是博文的协作者，不受博文可见范围和状态的限制.
+	GrantCollaborator
+)
+
+// CanView 判断 viewerID 对应的用户能否查看博文，viewerID 为空表示匿名用户.
+// grant 表示作者授予该用户的访问权限，用户同时是协作者和被分享的用户时应传入 GrantCollaborator.
+func CanView(postM *model.PostM, viewerID string, grant Grant) bool {
+	if viewerID == "" {
+		grant = GrantNone
+	}
+	if (viewerID != "" && postM.UserID == viewerID) || grant == GrantCollaborator {
+		return true
+	}
+
+	switch postM.Visibility {
+	case public, unlisted:
+		return postM.Status == published
+	case shared:
+		return grant == GrantShared
+	default:
+		return false
+	}
+}
+
+// Listed 判断博文能否出现在公开列表、订阅源和站点地图等列表中.
+func Listed(postM *model.PostM) bool {
+	return postM.Visibility == public && postM.Status == published
+}
+
+// Check 判断 viewerID 对应的用户能否查看博文. 只有在需要时才查询协作者和分享记录.
+func Check(ctx context.Context, s store.IStore, postM *model.PostM, viewerID string) (bool, error) {
+	if viewerID == "" || postM.UserID == viewerID || CanView(postM, viewerID, GrantNone) {
+		return CanView(postM, viewerID, GrantNone), nil
+	}
+
+	roles, err := s.PostCollaborator().Roles(ctx, viewerID, postM.PostID)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := roles[postM.PostID]; ok {
+		return true, nil
+	}
+	if postM.Visibility != shared {
+		return false, nil
+	}
+
+	granted, err := s.PostShare().Granted(ctx, postM.PostID, viewerID)
+	if err != nil {
+		return false, err
+	}
+	return granted && CanView(postM, viewerID, GrantShared), nil
+}
+
+// ListedWhere 返回只匹配可以出现在列表中的博文的查询条件，与 Listed 的判断规则一致.
+func ListedWhere() *where.Options {
+	return where.F("status", published, "visibility", public)
+}
+
+// LinkableWhere 返回匹配知道链接即可查看的博文的查询条件，即已发布的 Public 和 Unlisted 博文.
+func LinkableWhere() *where.Options {
+	return where.F("status", published, "visibility", []int32{public, unlisted})
+}
+
+// ViewableBy 为查询条件添加 viewerID 可以在列表中看到的其他用户博文的过滤条件：
+// 已发布的 Public 博文、分享给 viewerID 的 Shared 博文，以及 viewerID 参与协作的博文.
+// 除非 viewerID 是协作者，Unlisted 博文不会出现在列表中.
+func ViewableBy(ctx context.Context, s store.IStore, whr *where.Options, viewerID string) *where.Options {
+	grants := s.DB(ctx).Model(&model.PostShareM{}).Select("postID").Where("userID = ?", viewerID)
+	collaborations := s.DB(ctx).Model(&model.PostCollaboratorM{}).Select("postID").Where("userID = ?", viewerID)
+	return whr.Q("((status = ? AND visibility = ?) OR (visibility = ? AND postID IN (?)) OR postID IN (?))",
+		published, public, shared, grants, collaborations)
+}
+
+// SharedWith 为查询条件添加只匹配分享给 viewerID 的 Shared 博文的过滤条件.
+func SharedWith(ctx context.Context, s store.IStore, whr *where.Options, viewerID string) *where.Options {
+	grants := s.DB(ctx).Model(&model.PostShareM{}).Select("postID").Where("userID = ?", viewerID)
+	return whr.Q("visibility = ? AND userID <> ? AND postID IN (?)", shared, viewerID, grants)
+}
+
+// Collaborating 为查询条件添加只匹配 viewerID 作为协作者参与的其他用户博文的过滤条件.
+func Collaborating(ctx context.Context, s store.IStore, whr *where.Options, viewerID string) *where.Options {
+	collaborations := s.DB(ctx).Model(&model.PostCollaboratorM{}).Select("postID").Where("userID = ?", viewerID)
+	return whr.Q("userID <> ? AND postID IN (?)", viewerID, collaborations)
+}
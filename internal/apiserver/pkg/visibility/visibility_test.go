@@ -0,0 +1,81 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package visibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	ownerID        = "user-owner"
+	collaboratorID = "user-collaborator"
+	granteeID      = "user-grantee"
+	otherID        = "user-other"
+)
+
+func TestCanView(t *testing.T) {
+	tests := []struct {
+		visibility   apiv1.PostVisibility
+		status       apiv1.PostStatus
+		owner        bool
+		collaborator bool
+		grantee      bool
+		other        bool
+		anonymous    bool
+		listed       bool
+	}{
+		{apiv1.PostVisibility_Public, apiv1.PostStatus_Draft, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Public, apiv1.PostStatus_Published, true, true, true, true, true, true},
+		{apiv1.PostVisibility_Public, apiv1.PostStatus_Archived, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Unlisted, apiv1.PostStatus_Draft, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Unlisted, apiv1.PostStatus_Published, true, true, true, true, true, false},
+		{apiv1.PostVisibility_Unlisted, apiv1.PostStatus_Archived, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Private, apiv1.PostStatus_Draft, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Private, apiv1.PostStatus_Published, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Private, apiv1.PostStatus_Archived, true, true, false, false, false, false},
+		{apiv1.PostVisibility_Shared, apiv1.PostStatus_Draft, true, true, true, false, false, false},
+		{apiv1.PostVisibility_Shared, apiv1.PostStatus_Published, true, true, true, false, false, false},
+		{apiv1.PostVisibility_Shared, apiv1.PostStatus_Archived, true, true, true, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.visibility.String()+"/"+tt.status.String(), func(t *testing.T) {
+			postM := &model.PostM{UserID: ownerID, Visibility: int32(tt.visibility), Status: int32(tt.status)}
+
+			// 作者本人无需任何授权，匿名用户即使传入授权也不生效
+			assert.Equal(t, tt.owner, CanView(postM, ownerID, GrantNone), "owner")
+			assert.Equal(t, tt.collaborator, CanView(postM, collaboratorID, GrantCollaborator), "collaborator")
+			assert.Equal(t, tt.grantee, CanView(postM, granteeID, GrantShared), "grantee")
+			assert.Equal(t, tt.other, CanView(postM, otherID, GrantNone), "other")
+			assert.Equal(t, tt.anonymous, CanView(postM, "", GrantNone), "anonymous")
+			assert.Equal(t, tt.anonymous, CanView(postM, "", GrantCollaborator), "anonymous with grant")
+			assert.Equal(t, tt.listed, Listed(postM), "listed")
+		})
+	}
+}
+
+func TestCanView_UnknownVisibility(t *testing.T) {
+	postM := &model.PostM{UserID: ownerID, Visibility: 99, Status: int32(apiv1.PostStatus_Published)}
+
+	assert.True(t, CanView(postM, ownerID, GrantNone))
+	assert.True(t, CanView(postM, collaboratorID, GrantCollaborator))
+	assert.False(t, CanView(postM, granteeID, GrantShared))
+	assert.False(t, Listed(postM))
+}
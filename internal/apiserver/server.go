@@ -29,13 +29,20 @@ import (
 	"github.com/onexstack/onexstack/pkg/token"
 	"gorm.io/gorm"
 	"gorm.io/driver/sqlite"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 
 	"github.com/jwcen/miniblog/internal/apiserver/biz"
 	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/search"
 	"github.com/jwcen/miniblog/internal/apiserver/pkg/validation"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/apiserver/worker"
 	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
 	"github.com/jwcen/miniblog/internal/pkg/log"
 	"github.com/jwcen/miniblog/internal/pkg/server"
 )
@@ -65,6 +72,10 @@ type Config struct {
 	TLSOptions   *genericoptions.TLSOptions
 	MySQLOptions *genericoptions.MySQLOptions
 	EnableMemoryStore bool
+	BlobOptions       *blob.Options
+	MaxMediaSize      int64
+	TrashRetention    time.Duration
+	TrustedProxies    httpx.TrustedProxies
 }
 
 // UnionServer 定义一个联合服务器. 根据 ServerMode 决定要启动的服务器类型.
@@ -77,8 +88,10 @@ type Config struct {
 //     根据是否开启 TLS，来判断启动 HTTP 或者 HTTPS；
 //
 // HTTP 反向代理服务器依赖 gRPC 服务器，所以在开启 HTTP 反向代理服务器时，会先启动 gRPC 服务器.
+// 此外，联合服务器还负责启动和停止定时发布等后台任务.
 type UnionServer struct {
-	srv server.Server
+	srv     server.Server
+	workers []worker.Worker
 }
 
 // ServerConfig 包含服务器的核心依赖和配置.
@@ -101,6 +114,16 @@ func (cfg *Config) NewServerConfig() (*ServerConfig, error) {
 
 	store := store.NewStore(db)
 
+	searcher, err := cfg.NewSearcher(store)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := cfg.BlobOptions.NewBlobStore()
+	if err != nil {
+		return nil, err
+	}
+
 	authz, err := authz.NewAuthz(store.DB(context.TODO()))
 	if err != nil {
 		return nil, err
@@ -108,8 +131,8 @@ func (cfg *Config) NewServerConfig() (*ServerConfig, error) {
 
 	return &ServerConfig{
 		cfg:       cfg,
-		biz:       biz.NewBiz(store, authz),
-		val:       validation.New(store),
+		biz:       biz.NewBiz(store, authz, searcher, render.NewRenderer(), blobs, viewcount.NewCounter(store, clock.RealClock{})),
+		val:       validation.New(store, cfg.MaxMediaSize),
 		retriever: &UserRetriever{store},
 		authz:     authz,
 	}, nil
@@ -134,7 +157,7 @@ func (cfg *Config) NewDB() (*gorm.DB, error) {
     }
 
 	// 自动迁移数据库结构
-    if err := db.AutoMigrate(&model.UserM{}, &model.PostM{}, &model.CasbinRuleM{}); err != nil {
+    if err := db.AutoMigrate(&model.UserM{}, &model.PostM{}, &model.PostCollaboratorM{}, &model.PostTagM{}, &model.PostRevisionM{}, &model.PostSlugM{}, &model.PostShareM{}, &model.CommentM{}, &model.MediaM{}, &model.PostReactionM{}, &model.PostReactionCountM{}, &model.BookmarkM{}, &model.FollowM{}, &model.SeriesM{}, &model.SeriesPostM{}, &model.PostViewM{}, &model.ExportJobM{}, &model.ImportMappingM{}, &model.CasbinRuleM{}); err != nil {
         log.Errorw("Failed to migrate database schema", "err", err)
         return nil, err
     }
@@ -148,6 +171,7 @@ func (cfg *Config) NewDB() (*gorm.DB, error) {
         {PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/ListUser"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
         {PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1/users"), V2: ptr.To("GET"), V3: ptr.To("deny")},
         {PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1/users/*"), V2: ptr.To("DELETE"), V3: ptr.To("deny")},
+        {PType: ptr.To("p"), V0: &userR, V1: ptr.To(known.CommentObject), V2: ptr.To(known.ActionModerate), V3: ptr.To("deny")},
     }
 
     if err := db.Create(&casbinRules).Error; err != nil {
@@ -175,31 +199,50 @@ func (cfg *Config) NewDB() (*gorm.DB, error) {
     return db, nil
 }
 
-// NewUnionServer 根据配置创建联合服务器.
-func (cfg *Config) NewUnionServer() (*UnionServer, error) {
-	// 注册租户解析函数，通过上下文获取用户 ID
+// NewSearcher 根据配置创建全文检索实例.
+// 使用 MySQL 时基于 post 表上的 FULLTEXT 索引检索，使用内存数据库时基于内嵌的 bleve 内存索引检索.
+func (cfg *Config) NewSearcher(store store.IStore) (search.Searcher, error) {
+	if !cfg.EnableMemoryStore {
+		return search.NewMySQLSearcher(store), nil
+	}
+
+	return search.NewBleveSearcher()
+}
+
+// registerTenant 注册租户解析函数，通过上下文获取用户 ID.
+func registerTenant() {
 	//nolint: gocritic
 	where.RegisterTenant("userID", func(ctx context.Context) string {
 		return contextx.UserID(ctx)
 	})
+}
+
+// NewUnionServer 根据配置创建联合服务器.
+func (cfg *Config) NewUnionServer() (*UnionServer, error) {
+	registerTenant()
 
 	token.Init(cfg.JWTKey, known.XUserID, cfg.Expiration)
 	
 	log.Infow("Initializing federation server", "server-mode", cfg.ServerMode, "enable-memory-store", cfg.EnableMemoryStore)
 
-	srv, err := InitializeWebServer(cfg)
+	srv, err := InitializeUnionServer(cfg)
 	if err != nil {
 		return nil, err
 	}
 
 	log.Infow("Initializing federation server", "server-mode", cfg.ServerMode)
 
-	return &UnionServer{srv: srv}, nil
+	return srv, nil
 }
 
 // Run 运行应用.
 func (s *UnionServer) Run() error {
-	s.srv.RunOrDie()
+	for _, w := range s.workers {
+		w.Start()
+	}
+
+	// RunOrDie 会阻塞直到服务器退出，所以需要在协程中启动，以便监听退出信号
+	go s.srv.RunOrDie()
 
 	quit := make(chan os.Signal, 1)
 	// / 当执行 kill 命令时（不带参数），默认会发送 syscall.SIGTERM 信号
@@ -213,14 +256,24 @@ func (s *UnionServer) Run() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// 先关闭依赖的服务，再关闭被依赖的服务
-	s.srv.GracefulStop(ctx)
+	s.GracefulStop(ctx)
 
 	log.Infow("Server exited")
 
 	return nil
 }
 
+// GracefulStop 优雅关闭服务器和后台任务.
+func (s *UnionServer) GracefulStop(ctx context.Context) {
+	// 先关闭依赖的服务，再关闭被依赖的服务
+	s.srv.GracefulStop(ctx)
+
+	// 服务器不再接收请求后，再停止后台任务
+	for _, w := range s.workers {
+		w.Stop(ctx)
+	}
+}
+
 // UserRetriever 定义一个用户数据获取器. 用来获取用户信息.
 type UserRetriever struct {
 	store store.IStore
@@ -235,6 +288,27 @@ func ProvideDB(cfg *Config) (*gorm.DB, error) {
 	return cfg.NewDB()
 }
 
+// ProvideSearcher 根据配置提供一个全文检索实例.
+func ProvideSearcher(cfg *Config, store store.IStore) (search.Searcher, error) {
+	return cfg.NewSearcher(store)
+}
+
+// ProvideBlobStore 根据配置提供一个媒体文件存储实例.
+func ProvideBlobStore(cfg *Config) (blob.BlobStore, error) {
+	return cfg.BlobOptions.NewBlobStore()
+}
+
+// ProvidePostPurger 根据配置的回收站保留时长提供一个回收站清理任务实例.
+func ProvidePostPurger(cfg *Config, store store.IStore, blobs blob.BlobStore, clock clock.WithTicker) *worker.PostPurger {
+	return worker.NewPostPurger(store, blobs, clock, cfg.TrashRetention)
+}
+
+// ProvideWorkers 提供随联合服务器一同启动和停止的后台任务列表.
+// 浏览计数器在服务器停止接收请求后退出，退出时会写入缓冲区中剩余的浏览统计.
+func ProvideWorkers(publisher *worker.PostPublisher, purger *worker.PostPurger, exporter *worker.PostExporter, views *viewcount.Counter) []worker.Worker {
+	return []worker.Worker{publisher, purger, exporter, views}
+}
+
 func NewWebServer(serverMode string, serverConfig *ServerConfig) (server.Server, error) {
 	// 根据服务模式创建对应的服务实例
 	// 实际企业开发中，可以根据需要只选择一种服务器模式.
@@ -0,0 +1,65 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// BookmarkStore 定义了 bookmark 模块在 store 层所实现的方法.
+type BookmarkStore interface {
+	Create(ctx context.Context, obj *model.BookmarkM) error
+	Update(ctx context.Context, obj *model.BookmarkM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.BookmarkM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.BookmarkM, error)
+
+	BookmarkExpansion
+}
+
+// BookmarkExpansion 定义了阅读列表操作的附加方法.
+type BookmarkExpansion interface {
+	// Add 添加收藏，博文已被收藏时不做任何修改.
+	Add(ctx context.Context, obj *model.BookmarkM) error
+}
+
+type bookmarkStore struct {
+	store *datastore
+	*genericstore.Store[model.BookmarkM]
+}
+
+// 确保 bookmarkStore 实现了 BookmarkStore 接口.
+var _ BookmarkStore = (*bookmarkStore)(nil)
+
+func newBookmarkStore(store *datastore) *bookmarkStore {
+	return &bookmarkStore{
+		store: store,
+		Store: genericstore.NewStore[model.BookmarkM](store, NewLogger()),
+	}
+}
+
+// Add 依赖 (userID, postID) 唯一索引忽略重复收藏.
+func (s *bookmarkStore) Add(ctx context.Context, obj *model.BookmarkM) error {
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(obj).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to insert bookmark into database", "object", obj)
+		return err
+	}
+	return nil
+}
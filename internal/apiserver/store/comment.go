@@ -0,0 +1,50 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// CommentStore 定义了 comment 模块在 store 层所实现的方法.
+type CommentStore interface {
+	Create(ctx context.Context, obj *model.CommentM) error
+	Update(ctx context.Context, obj *model.CommentM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.CommentM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.CommentM, error)
+
+	CommentExpansion
+}
+
+// CommentExpansion 定义了评论操作的附加方法.
+type CommentExpansion interface{}
+
+type commentStore struct {
+	*genericstore.Store[model.CommentM]
+}
+
+// 确保 commentStore 实现了 CommentStore 接口.
+var _ CommentStore = (*commentStore)(nil)
+
+func newCommentStore(store *datastore) *commentStore {
+	return &commentStore{
+		Store: genericstore.NewStore[model.CommentM](store, NewLogger()),
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+// ExportJobStore 定义了 export_job 模块在 store 层所实现的方法.
+type ExportJobStore interface {
+	Create(ctx context.Context, obj *model.ExportJobM) error
+	Update(ctx context.Context, obj *model.ExportJobM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.ExportJobM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.ExportJobM, error)
+
+	ExportJobExpansion
+}
+
+// ExportJobExpansion 定义了导出任务操作的附加方法.
+type ExportJobExpansion interface {
+	// Claim 领取最早创建的一个等待执行的导出任务，并将其标记为执行中，没有可领取的任务时返回 nil.
+	// 执行中的任务在 staleBefore 之前没有更新过时，视为执行任务的实例已经退出，可以被重新领取.
+	Claim(ctx context.Context, staleBefore time.Time) (*model.ExportJobM, error)
+}
+
+type exportJobStore struct {
+	store *datastore
+	*genericstore.Store[model.ExportJobM]
+}
+
+// 确保 exportJobStore 实现了 ExportJobStore 接口.
+var _ ExportJobStore = (*exportJobStore)(nil)
+
+func newExportJobStore(store *datastore) *exportJobStore {
+	return &exportJobStore{
+		store: store,
+		Store: genericstore.NewStore[model.ExportJobM](store, NewLogger()),
+	}
+}
+
+// Claim 使用条件更新领取任务，多个实例同时领取同一个任务时只有一个实例能够成功.
+func (s *exportJobStore) Claim(ctx context.Context, staleBefore time.Time) (*model.ExportJobM, error) {
+	claimable := func(db *gorm.DB) *gorm.DB {
+		return db.Where("(status = ? OR (status = ? AND updatedAt < ?))",
+			int32(apiv1.ExportJobStatus_Pending), int32(apiv1.ExportJobStatus_Running), staleBefore)
+	}
+
+	for {
+		// 使用 Find 而不是 First，没有可领取的任务是常见情况，不应作为错误记录日志
+		var jobList []*model.ExportJobM
+		if err := s.store.DB(ctx).Scopes(claimable).Order("id").Limit(1).Find(&jobList).Error; err != nil {
+			NewLogger().Error(ctx, err, "Failed to find claimable export job")
+			return nil, err
+		}
+		if len(jobList) == 0 {
+			return nil, nil
+		}
+		jobM := jobList[0]
+
+		now := time.Now()
+		ret := s.store.DB(ctx).
+			Model(&model.ExportJobM{}).
+			Where("id = ?", jobM.ID).
+			Scopes(claimable).
+			Updates(map[string]any{"status": int32(apiv1.ExportJobStatus_Running), "updatedAt": now})
+		if ret.Error != nil {
+			NewLogger().Error(ctx, ret.Error, "Failed to claim export job", "jobID", jobM.JobID)
+			return nil, ret.Error
+		}
+		// 任务已被其它实例领取时继续查找下一个任务
+		if ret.RowsAffected == 1 {
+			jobM.Status = int32(apiv1.ExportJobStatus_Running)
+			jobM.UpdatedAt = now
+			return jobM, nil
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// FollowStore 定义了 follow 模块在 store 层所实现的方法.
+type FollowStore interface {
+	Create(ctx context.Context, obj *model.FollowM) error
+	Update(ctx context.Context, obj *model.FollowM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.FollowM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.FollowM, error)
+
+	FollowExpansion
+}
+
+// FollowExpansion 定义了关注关系操作的附加方法.
+type FollowExpansion interface {
+	// Add 添加关注关系，关注关系已存在时不做任何修改.
+	Add(ctx context.Context, obj *model.FollowM) error
+	// CountFollowers 统计指定用户的粉丝数量，返回以 userID 为键的数量.
+	CountFollowers(ctx context.Context, userIDs ...string) (map[string]int64, error)
+	// CountFollowing 统计指定用户关注的用户数量，返回以 userID 为键的数量.
+	CountFollowing(ctx context.Context, userIDs ...string) (map[string]int64, error)
+}
+
+// followCount 表示某个用户及其关注关系的数量.
+type followCount struct {
+	UserID string `gorm:"column:userID"`
+	Total  int64  `gorm:"column:total"`
+}
+
+type followStore struct {
+	store *datastore
+	*genericstore.Store[model.FollowM]
+}
+
+// 确保 followStore 实现了 FollowStore 接口.
+var _ FollowStore = (*followStore)(nil)
+
+func newFollowStore(store *datastore) *followStore {
+	return &followStore{
+		store: store,
+		Store: genericstore.NewStore[model.FollowM](store, NewLogger()),
+	}
+}
+
+// Add 依赖 (followerID, followeeID) 唯一索引忽略重复关注.
+func (s *followStore) Add(ctx context.Context, obj *model.FollowM) error {
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(obj).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to insert follow into database", "object", obj)
+		return err
+	}
+	return nil
+}
+
+// CountFollowers 按被关注者分组统计粉丝数量.
+func (s *followStore) CountFollowers(ctx context.Context, userIDs ...string) (map[string]int64, error) {
+	return s.count(ctx, "followeeID", userIDs)
+}
+
+// CountFollowing 按关注者分组统计关注的用户数量.
+func (s *followStore) CountFollowing(ctx context.Context, userIDs ...string) (map[string]int64, error) {
+	return s.count(ctx, "followerID", userIDs)
+}
+
+// count 按指定列分组统计关注关系数量，两个方向的统计都可以命中索引.
+func (s *followStore) count(ctx context.Context, column string, userIDs []string) (map[string]int64, error) {
+	ret := make(map[string]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return ret, nil
+	}
+
+	var counts []*followCount
+	err := s.store.DB(ctx).
+		Model(&model.FollowM{}).
+		Select(column+" AS userID, COUNT(*) AS total").
+		Where(column+" IN ?", userIDs).
+		Group(column).
+		Scan(&counts).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to count follows from database", "column", column, "userIDs", userIDs)
+		return nil, err
+	}
+
+	for _, count := range counts {
+		ret[count.UserID] = count.Total
+	}
+	return ret, nil
+}
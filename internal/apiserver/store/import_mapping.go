@@ -0,0 +1,70 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// ImportMappingStore 定义了 import_mapping 模块在 store 层所实现的方法.
+// 映射记录用于在重复导入时跳过已经导入过的数据，创建后不可修改，因此不提供 Update 方法.
+type ImportMappingStore interface {
+	Create(ctx context.Context, obj *model.ImportMappingM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.ImportMappingM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.ImportMappingM, error)
+
+	ImportMappingExpansion
+}
+
+// ImportMappingExpansion 定义了导入映射操作的附加方法.
+type ImportMappingExpansion interface {
+	// TargetIDs 返回指定类型的全部映射，键为来源标识，值为导入后生成的资源 ID.
+	TargetIDs(ctx context.Context, kind string) (map[string]string, error)
+}
+
+type importMappingStore struct {
+	store *datastore
+	*genericstore.Store[model.ImportMappingM]
+}
+
+// 确保 importMappingStore 实现了 ImportMappingStore 接口.
+var _ ImportMappingStore = (*importMappingStore)(nil)
+
+func newImportMappingStore(store *datastore) *importMappingStore {
+	return &importMappingStore{
+		store: store,
+		Store: genericstore.NewStore[model.ImportMappingM](store, NewLogger()),
+	}
+}
+
+// TargetIDs 一次性加载指定类型的全部映射，避免导入时逐条查询.
+func (s *importMappingStore) TargetIDs(ctx context.Context, kind string) (map[string]string, error) {
+	var mappingList []*model.ImportMappingM
+	if err := s.store.DB(ctx).Where("kind = ?", kind).Find(&mappingList).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to list import mappings", "kind", kind)
+		return nil, err
+	}
+
+	targetIDs := make(map[string]string, len(mappingList))
+	for _, mappingM := range mappingList {
+		targetIDs[mappingM.SourceID] = mappingM.TargetID
+	}
+	return targetIDs, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// MediaStore 定义了 media 模块在 store 层所实现的方法.
+type MediaStore interface {
+	Create(ctx context.Context, obj *model.MediaM) error
+	Update(ctx context.Context, obj *model.MediaM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.MediaM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.MediaM, error)
+
+	MediaExpansion
+}
+
+// MediaExpansion 定义了媒体文件操作的附加方法.
+type MediaExpansion interface{}
+
+type mediaStore struct {
+	*genericstore.Store[model.MediaM]
+}
+
+// 确保 mediaStore 实现了 MediaStore 接口.
+var _ MediaStore = (*mediaStore)(nil)
+
+func newMediaStore(store *datastore) *mediaStore {
+	return &mediaStore{
+		Store: genericstore.NewStore[model.MediaM](store, NewLogger()),
+	}
+}
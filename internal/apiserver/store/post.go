@@ -16,10 +16,14 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 	genericstore "github.com/onexstack/onexstack/pkg/store"
 	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
 )
 
 // PostStore 定义了 post 模块在 store 层所实现的方法.
@@ -34,12 +38,28 @@ type PostStore interface {
 }
 
 // PostExpansion 定义了帖子操作的附加方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	// PublishDue 发布所有定时发布时间不晚于 now 的草稿博文.
+	PublishDue(ctx context.Context, now time.Time) (int64, error)
+	// SlugExists 判断 slug 是否已被 excludePostID 以外的博文使用，包括博文曾经使用过的 slug.
+	SlugExists(ctx context.Context, slug string, excludePostID string) (bool, error)
+	// ListPublished 按 id 升序分页列出已发布的博文，只查询 postID、slug 和 updatedAt 字段.
+	ListPublished(ctx context.Context, offset int, limit int) (int64, []*model.PostM, error)
+	// ListTrashed 按删除时间倒序列出回收站中的博文.
+	ListTrashed(ctx context.Context, opts *where.Options) (int64, []*model.PostM, error)
+	// Restore 将回收站中的博文恢复为未删除状态，返回被恢复的博文数量.
+	Restore(ctx context.Context, opts *where.Options) (int64, error)
+	// Purge 从数据库中永久删除回收站中的博文.
+	Purge(ctx context.Context, opts *where.Options) error
+	// LastModified 返回匹配的博文（包括草稿和回收站中的博文）最近的修改或删除时间.
+	LastModified(ctx context.Context, opts *where.Options) (time.Time, error)
+}
 
 // transactionKey 用于在 context.Context 中存储事务上下文的键.
 type transactionKey struct{}
 
 type postStore struct {
+	store *datastore
 	*genericstore.Store[model.PostM]
 }
 
@@ -48,6 +68,159 @@ var _ PostStore = (*postStore)(nil)
 
 func newPostStore(store *datastore) *postStore {
 	return &postStore{
+		store: store,
 		Store: genericstore.NewStore[model.PostM](store, NewLogger()),
 	}
 }
+
+// Update 更新博文并将版本号加一. 只有数据库中的版本号仍与 obj.Version 一致时才会更新，
+// 否则说明博文在读取之后已被其他请求修改，返回 errno.ErrVersionConflict.
+func (s *postStore) Update(ctx context.Context, obj *model.PostM) error {
+	version := obj.Version
+	obj.Version++
+	ret := s.store.DB(ctx).Model(obj).Where("version = ?", version).Select("*").Updates(obj)
+	if ret.Error != nil {
+		obj.Version = version
+		NewLogger().Error(ctx, ret.Error, "Failed to update object in database", "object", obj)
+		return ret.Error
+	}
+	if ret.RowsAffected == 0 {
+		obj.Version = version
+		return errno.ErrVersionConflict
+	}
+	return nil
+}
+
+// PublishDue 将到期的定时博文修改为已发布状态，并清空定时发布时间，返回被发布的博文数量.
+// 发布时间记为定时发布时间，而非实际执行时间，以免受调度延迟的影响.
+func (s *postStore) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	ret := s.store.DB(ctx).
+		Model(&model.PostM{}).
+		Where("status = ? AND publishAt <= ?", int32(apiv1.PostStatus_Draft), now).
+		Updates(map[string]any{
+			"status":      int32(apiv1.PostStatus_Published),
+			"publishedAt": gorm.Expr("publishAt"),
+			"publishAt":   nil,
+			"version":     gorm.Expr("version + 1"),
+		})
+	if ret.Error != nil {
+		NewLogger().Error(ctx, ret.Error, "Failed to publish due posts", "now", now)
+		return 0, ret.Error
+	}
+	return ret.RowsAffected, nil
+}
+
+// SlugExists 判断 slug 是否已被其它博文占用. 博文曾经使用过的 slug 会重定向到该博文，因此同样视为已占用.
+// 回收站中的博文恢复后仍使用原来的 slug，因此同样需要检查.
+func (s *postStore) SlugExists(ctx context.Context, slug string, excludePostID string) (bool, error) {
+	for _, m := range []any{&model.PostM{}, &model.PostSlugM{}} {
+		var count int64
+		err := s.store.DB(ctx).
+			Unscoped().
+			Model(m).
+			Where("slug = ? AND postID <> ?", slug, excludePostID).
+			Count(&count).Error
+		if err != nil {
+			NewLogger().Error(ctx, err, "Failed to check post slug", "slug", slug)
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListPublished 分页列出已发布的公开博文. 站点地图等场景只需要博文的地址和修改时间，
+// 因此只查询必要的字段，避免加载博文内容. 按 id 升序排列，保证新发布的博文不会影响已有分页的内容.
+func (s *postStore) ListPublished(ctx context.Context, offset int, limit int) (count int64, ret []*model.PostM, err error) {
+	err = s.store.DB(ctx).
+		Model(&model.PostM{}).
+		Select("id", "postID", "slug", "updatedAt").
+		Where("status = ? AND visibility = ?", int32(apiv1.PostStatus_Published), int32(apiv1.PostVisibility_Public)).
+		Order("id").
+		Offset(offset).
+		Limit(limit).
+		Find(&ret).
+		Offset(-1).
+		Limit(-1).
+		Count(&count).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list published posts", "offset", offset, "limit", limit)
+	}
+	return
+}
+
+// ListTrashed 列出回收站中的博文. 博文模型启用了软删除，默认查询会过滤已删除的博文，因此需要使用 Unscoped.
+func (s *postStore) ListTrashed(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostM, err error) {
+	err = s.store.DB(ctx, opts).
+		Unscoped().
+		Where("deletedAt IS NOT NULL").
+		Order("deletedAt DESC, id DESC").
+		Find(&ret).
+		Offset(-1).
+		Limit(-1).
+		Count(&count).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list trashed posts", "conditions", opts)
+	}
+	return
+}
+
+// Restore 清空回收站中博文的删除时间. 只会恢复已删除的博文，返回值为 0 表示没有匹配的博文.
+func (s *postStore) Restore(ctx context.Context, opts *where.Options) (int64, error) {
+	ret := s.store.DB(ctx, opts).
+		Unscoped().
+		Model(&model.PostM{}).
+		Where("deletedAt IS NOT NULL").
+		Update("deletedAt", nil)
+	if ret.Error != nil {
+		NewLogger().Error(ctx, ret.Error, "Failed to restore trashed posts", "conditions", opts)
+		return 0, ret.Error
+	}
+	return ret.RowsAffected, nil
+}
+
+// Purge 永久删除回收站中的博文. 只会删除已删除的博文，避免误删仍在使用的博文.
+func (s *postStore) Purge(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).
+		Unscoped().
+		Where("deletedAt IS NOT NULL").
+		Delete(&model.PostM{}).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to purge trashed posts", "conditions", opts)
+		return err
+	}
+	return nil
+}
+
+// LastModified 返回匹配的博文最近的修改或删除时间，没有匹配的博文时返回零值.
+// 博文取消发布、修改可见范围或移入回收站时都会更新修改时间或删除时间，因此该时间不会因为博文离开订阅源而变早，
+// 可以作为订阅源的 Last-Modified.
+func (s *postStore) LastModified(ctx context.Context, opts *where.Options) (time.Time, error) {
+	var last time.Time
+	for _, column := range []string{"updatedAt", "deletedAt"} {
+		var posts []*model.PostM
+		err := s.store.DB(ctx, opts).
+			Unscoped().
+			Select(column).
+			Where(column + " IS NOT NULL").
+			Order(column + " DESC").
+			Limit(1).
+			Find(&posts).Error
+		if err != nil {
+			NewLogger().Error(ctx, err, "Failed to get last modified time of posts", "conditions", opts)
+			return time.Time{}, err
+		}
+		if len(posts) == 0 {
+			continue
+		}
+		if posts[0].UpdatedAt.After(last) {
+			last = posts[0].UpdatedAt
+		}
+		if posts[0].DeletedAt.Valid && posts[0].DeletedAt.Time.After(last) {
+			last = posts[0].DeletedAt.Time
+		}
+	}
+	return last, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// PostCollaboratorStore 定义了 post_collaborator 模块在 store 层所实现的方法.
+type PostCollaboratorStore interface {
+	Create(ctx context.Context, obj *model.PostCollaboratorM) error
+	Update(ctx context.Context, obj *model.PostCollaboratorM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostCollaboratorM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostCollaboratorM, error)
+
+	PostCollaboratorExpansion
+}
+
+// PostCollaboratorExpansion 定义了博文协作者操作的附加方法.
+type PostCollaboratorExpansion interface {
+	// Upsert 添加协作者，用户已是协作者时修改其角色，保留最初的邀请时间.
+	Upsert(ctx context.Context, obj *model.PostCollaboratorM) error
+	// Roles 返回用户在指定博文中的协作角色，键为博文 ID. 用户不是协作者的博文不会出现在结果中.
+	Roles(ctx context.Context, userID string, postIDs ...string) (map[string]int32, error)
+}
+
+type postCollaboratorStore struct {
+	store *datastore
+	*genericstore.Store[model.PostCollaboratorM]
+}
+
+// 确保 postCollaboratorStore 实现了 PostCollaboratorStore 接口.
+var _ PostCollaboratorStore = (*postCollaboratorStore)(nil)
+
+func newPostCollaboratorStore(store *datastore) *postCollaboratorStore {
+	return &postCollaboratorStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostCollaboratorM](store, NewLogger()),
+	}
+}
+
+// Upsert 依赖 (postID, userID) 唯一索引，冲突时只更新角色和修改时间.
+func (s *postCollaboratorStore) Upsert(ctx context.Context, obj *model.PostCollaboratorM) error {
+	err := s.store.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "postID"}, {Name: "userID"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updatedAt"}),
+	}).Create(obj).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to upsert post collaborator", "object", obj)
+		return err
+	}
+	return nil
+}
+
+// Roles 使用 Find 查询协作记录，避免 Get 在用户不是协作者时打印错误日志.
+func (s *postCollaboratorStore) Roles(ctx context.Context, userID string, postIDs ...string) (map[string]int32, error) {
+	roles := make(map[string]int32, len(postIDs))
+	if userID == "" || len(postIDs) == 0 {
+		return roles, nil
+	}
+
+	var collaboratorList []*model.PostCollaboratorM
+	err := s.store.DB(ctx).Where("userID = ? AND postID IN ?", userID, postIDs).Find(&collaboratorList).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list post collaborators", "userID", userID, "postIDs", postIDs)
+		return nil, err
+	}
+	for _, collaboratorM := range collaboratorList {
+		roles[collaboratorM.PostID] = collaboratorM.Role
+	}
+	return roles, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// PostReactionStore 定义了 post_reaction 模块在 store 层所实现的方法.
+type PostReactionStore interface {
+	Create(ctx context.Context, obj *model.PostReactionM) error
+	Update(ctx context.Context, obj *model.PostReactionM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostReactionM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostReactionM, error)
+
+	PostReactionExpansion
+}
+
+// PostReactionExpansion 定义了博文回应操作的附加方法.
+type PostReactionExpansion interface {
+	// Add 添加回应，回应已存在时不做任何修改. 返回值表示是否新增了回应.
+	Add(ctx context.Context, obj *model.PostReactionM) (bool, error)
+	// Remove 移除回应. 返回值表示是否删除了回应.
+	Remove(ctx context.Context, postID string, userID string, reaction string) (bool, error)
+}
+
+type postReactionStore struct {
+	store *datastore
+	*genericstore.Store[model.PostReactionM]
+}
+
+// 确保 postReactionStore 实现了 PostReactionStore 接口.
+var _ PostReactionStore = (*postReactionStore)(nil)
+
+func newPostReactionStore(store *datastore) *postReactionStore {
+	return &postReactionStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostReactionM](store, NewLogger()),
+	}
+}
+
+// Add 依赖 (postID, reaction, userID) 唯一索引保证同一用户的同一回应只记录一次，并发请求也不会重复记录.
+func (s *postReactionStore) Add(ctx context.Context, obj *model.PostReactionM) (bool, error) {
+	result := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(obj)
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to insert reaction into database", "object", obj)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Remove 根据受影响的行数判断回应是否存在，保证并发请求中只有一个请求会删除成功.
+func (s *postReactionStore) Remove(ctx context.Context, postID string, userID string, reaction string) (bool, error) {
+	result := s.store.DB(ctx).
+		Where("postID = ? AND userID = ? AND reaction = ?", postID, userID, reaction).
+		Delete(&model.PostReactionM{})
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to delete reaction from database", "postID", postID, "reaction", reaction)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostReactionCountStore 定义了 post_reaction_count 模块在 store 层所实现的方法.
+type PostReactionCountStore interface {
+	Create(ctx context.Context, obj *model.PostReactionCountM) error
+	Update(ctx context.Context, obj *model.PostReactionCountM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostReactionCountM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostReactionCountM, error)
+
+	PostReactionCountExpansion
+}
+
+// PostReactionCountExpansion 定义了博文回应计数操作的附加方法.
+type PostReactionCountExpansion interface {
+	// Increment 将指定博文指定回应的计数加 1，计数记录不存在时创建.
+	Increment(ctx context.Context, postID string, reaction string) error
+	// Decrement 将指定博文指定回应的计数减 1，计数不会小于 0.
+	Decrement(ctx context.Context, postID string, reaction string) error
+}
+
+type postReactionCountStore struct {
+	store *datastore
+	*genericstore.Store[model.PostReactionCountM]
+}
+
+// 确保 postReactionCountStore 实现了 PostReactionCountStore 接口.
+var _ PostReactionCountStore = (*postReactionCountStore)(nil)
+
+func newPostReactionCountStore(store *datastore) *postReactionCountStore {
+	return &postReactionCountStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostReactionCountM](store, NewLogger()),
+	}
+}
+
+// Increment 在数据库中原子地累加计数，避免先读后写在并发请求下丢失更新.
+func (s *postReactionCountStore) Increment(ctx context.Context, postID string, reaction string) error {
+	now := time.Now()
+	obj := model.PostReactionCountM{PostID: postID, Reaction: reaction, Total: 1, UpdatedAt: now}
+	err := s.store.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "postID"}, {Name: "reaction"}},
+		DoUpdates: clause.Assignments(map[string]any{"total": gorm.Expr("total + 1"), "updatedAt": now}),
+	}).Create(&obj).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to increment reaction count in database", "postID", postID, "reaction", reaction)
+		return err
+	}
+	return nil
+}
+
+// Decrement 在数据库中原子地扣减计数.
+func (s *postReactionCountStore) Decrement(ctx context.Context, postID string, reaction string) error {
+	err := s.store.DB(ctx).
+		Model(&model.PostReactionCountM{}).
+		Where("postID = ? AND reaction = ? AND total > 0", postID, reaction).
+		Updates(map[string]any{"total": gorm.Expr("total - 1"), "updatedAt": time.Now()}).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to decrement reaction count in database", "postID", postID, "reaction", reaction)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+)
+
+// newTestStore 返回基于 SQLite 内存数据库的 store 实例. 每个测试使用独立的数据库.
+func newTestStore(t *testing.T) *datastore {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&model.PostM{}, &model.PostReactionM{}, &model.PostReactionCountM{}, &model.SeriesPostM{}))
+
+	return &datastore{core: db}
+}
+
+func TestPostReactionStore_AddRemove(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+
+	newReaction := func() *model.PostReactionM {
+		return &model.PostReactionM{PostID: "post-1", UserID: "user-1", Reaction: "like", CreatedAt: time.Now()}
+	}
+
+	added, err := s.PostReaction().Add(ctx, newReaction())
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// 重复添加同一回应不会新增记录
+	added, err = s.PostReaction().Add(ctx, newReaction())
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	count, _, err := s.PostReaction().List(ctx, where.F("postID", "post-1"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	removed, err := s.PostReaction().Remove(ctx, "post-1", "user-1", "like")
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = s.PostReaction().Remove(ctx, "post-1", "user-1", "like")
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestPostReactionCountStore_IncrementDecrement(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+
+	total := func() int64 {
+		countM, err := s.PostReactionCount().Get(ctx, where.F("postID", "post-1", "reaction", "like"))
+		require.NoError(t, err)
+		return countM.Total
+	}
+
+	require.NoError(t, s.PostReactionCount().Increment(ctx, "post-1", "like"))
+	assert.EqualValues(t, 1, total())
+	require.NoError(t, s.PostReactionCount().Increment(ctx, "post-1", "like"))
+	assert.EqualValues(t, 2, total())
+
+	// 计数扣减到 0 后不会变为负数
+	for range 3 {
+		require.NoError(t, s.PostReactionCount().Decrement(ctx, "post-1", "like"))
+	}
+	assert.EqualValues(t, 0, total())
+
+	// 其他回应的计数互不影响
+	require.NoError(t, s.PostReactionCount().Increment(ctx, "post-1", "rocket"))
+	count, _, err := s.PostReactionCount().List(ctx, where.F("postID", "post-1"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
+func TestPostReactionCountStore_RollbackWithReaction(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+
+	// 事务失败时，回应记录和计数一起回滚
+	err := s.TX(ctx, func(ctx context.Context) error {
+		reactionM := model.PostReactionM{PostID: "post-1", UserID: "user-1", Reaction: "like", CreatedAt: time.Now()}
+		if _, err := s.PostReaction().Add(ctx, &reactionM); err != nil {
+			return err
+		}
+		if err := s.PostReactionCount().Increment(ctx, "post-1", "like"); err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	count, _, err := s.PostReaction().List(ctx, where.F("postID", "post-1"))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	count, _, err = s.PostReactionCount().List(ctx, where.F("postID", "post-1"))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
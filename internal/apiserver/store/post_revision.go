@@ -0,0 +1,74 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+// PostRevisionStore 定义了 post_revision 模块在 store 层所实现的方法.
+// 博文历史版本创建后不可修改，因此不提供 Update 方法.
+type PostRevisionStore interface {
+	Create(ctx context.Context, obj *model.PostRevisionM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostRevisionM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostRevisionM, error)
+
+	PostRevisionExpansion
+}
+
+// PostRevisionExpansion 定义了博文历史版本操作的附加方法.
+type PostRevisionExpansion interface {
+	// LatestRevision 返回指定博文的最新版本号，博文没有历史版本时返回 0.
+	LatestRevision(ctx context.Context, postID string) (int64, error)
+}
+
+type postRevisionStore struct {
+	store *datastore
+	*genericstore.Store[model.PostRevisionM]
+}
+
+// 确保 postRevisionStore 实现了 PostRevisionStore 接口.
+var _ PostRevisionStore = (*postRevisionStore)(nil)
+
+func newPostRevisionStore(store *datastore) *postRevisionStore {
+	return &postRevisionStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostRevisionM](store, NewLogger()),
+	}
+}
+
+// LatestRevision 查询指定博文的最新版本号.
+func (s *postRevisionStore) LatestRevision(ctx context.Context, postID string) (int64, error) {
+	var revisionM model.PostRevisionM
+	err := s.store.DB(ctx).
+		Where("postID = ?", postID).
+		Order("revision DESC").
+		Take(&revisionM).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		NewLogger().Error(ctx, err, "Failed to retrieve latest post revision", "postID", postID)
+		return 0, err
+	}
+	return revisionM.Revision, nil
+}
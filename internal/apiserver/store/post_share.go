@@ -0,0 +1,103 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// PostShareStore 定义了 post_share 模块在 store 层所实现的方法.
+// 分享记录只有创建和删除两种操作，因此不提供 Update 方法.
+type PostShareStore interface {
+	Create(ctx context.Context, obj *model.PostShareM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostShareM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostShareM, error)
+
+	PostShareExpansion
+}
+
+// PostShareExpansion 定义了博文分享操作的附加方法.
+type PostShareExpansion interface {
+	// Add 添加分享记录，博文已分享给该用户时不做任何修改.
+	Add(ctx context.Context, objs ...*model.PostShareM) error
+	// Granted 返回博文是否已分享给指定用户.
+	Granted(ctx context.Context, postID string, userID string) (bool, error)
+	// UserIDs 返回每篇博文被分享给的用户 ID 列表，键为博文 ID.
+	UserIDs(ctx context.Context, postIDs []string) (map[string][]string, error)
+}
+
+type postShareStore struct {
+	store *datastore
+	*genericstore.Store[model.PostShareM]
+}
+
+// 确保 postShareStore 实现了 PostShareStore 接口.
+var _ PostShareStore = (*postShareStore)(nil)
+
+func newPostShareStore(store *datastore) *postShareStore {
+	return &postShareStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostShareM](store, NewLogger()),
+	}
+}
+
+// Add 依赖 (postID, userID) 唯一索引忽略重复分享.
+func (s *postShareStore) Add(ctx context.Context, objs ...*model.PostShareM) error {
+	if len(objs) == 0 {
+		return nil
+	}
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(objs).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to insert post shares into database", "objects", objs)
+		return err
+	}
+	return nil
+}
+
+// Granted 使用 Count 判断分享记录是否存在，避免 Get 在记录不存在时打印错误日志.
+func (s *postShareStore) Granted(ctx context.Context, postID string, userID string) (bool, error) {
+	var count int64
+	err := s.store.DB(ctx).Model(&model.PostShareM{}).
+		Where("postID = ? AND userID = ?", postID, userID).
+		Count(&count).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to count post shares", "postID", postID, "userID", userID)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// UserIDs 一次性查询多篇博文的分享记录，按分享时间先后排列.
+func (s *postShareStore) UserIDs(ctx context.Context, postIDs []string) (map[string][]string, error) {
+	userIDs := make(map[string][]string, len(postIDs))
+	if len(postIDs) == 0 {
+		return userIDs, nil
+	}
+
+	var shareList []*model.PostShareM
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Order("id").Find(&shareList).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to list post shares", "postIDs", postIDs)
+		return nil, err
+	}
+	for _, shareM := range shareList {
+		userIDs[shareM.PostID] = append(userIDs[shareM.PostID], shareM.UserID)
+	}
+	return userIDs, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// PostSlugStore 定义了 post_slug 模块在 store 层所实现的方法.
+// 历史 slug 只用于旧链接的重定向，创建后不可修改，因此不提供 Update 方法.
+type PostSlugStore interface {
+	Create(ctx context.Context, obj *model.PostSlugM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostSlugM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostSlugM, error)
+
+	PostSlugExpansion
+}
+
+// PostSlugExpansion 定义了博文历史 slug 操作的附加方法.
+type PostSlugExpansion interface{}
+
+type postSlugStore struct {
+	*genericstore.Store[model.PostSlugM]
+}
+
+// 确保 postSlugStore 实现了 PostSlugStore 接口.
+var _ PostSlugStore = (*postSlugStore)(nil)
+
+func newPostSlugStore(store *datastore) *postSlugStore {
+	return &postSlugStore{
+		Store: genericstore.NewStore[model.PostSlugM](store, NewLogger()),
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// PostTagStore 定义了 post_tag 模块在 store 层所实现的方法.
+type PostTagStore interface {
+	Create(ctx context.Context, obj *model.PostTagM) error
+	Update(ctx context.Context, obj *model.PostTagM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostTagM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostTagM, error)
+
+	PostTagExpansion
+}
+
+// PostTagExpansion 定义了博文标签操作的附加方法.
+type PostTagExpansion interface {
+	// CountByName 按标签名称分组，统计每个标签下的博文数量.
+	CountByName(ctx context.Context, opts *where.Options) ([]*TagCount, error)
+}
+
+// TagCount 表示某个标签及其关联的博文数量.
+type TagCount struct {
+	Name      string `gorm:"column:name"`
+	PostCount int64  `gorm:"column:postCount"`
+}
+
+type postTagStore struct {
+	store *datastore
+	*genericstore.Store[model.PostTagM]
+}
+
+// 确保 postTagStore 实现了 PostTagStore 接口.
+var _ PostTagStore = (*postTagStore)(nil)
+
+func newPostTagStore(store *datastore) *postTagStore {
+	return &postTagStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostTagM](store, NewLogger()),
+	}
+}
+
+// CountByName 按标签名称统计博文数量，结果按博文数量倒序排列.
+func (s *postTagStore) CountByName(ctx context.Context, opts *where.Options) ([]*TagCount, error) {
+	var ret []*TagCount
+	err := s.store.DB(ctx, opts).
+		Model(&model.PostTagM{}).
+		Select("name, COUNT(*) AS postCount").
+		Group("name").
+		Order("postCount DESC, name ASC").
+		Scan(&ret).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to count tags from database", "conditions", opts)
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostViewStore 定义了 post_view 模块在 store 层所实现的方法.
+type PostViewStore interface {
+	Create(ctx context.Context, obj *model.PostViewM) error
+	Update(ctx context.Context, obj *model.PostViewM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostViewM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostViewM, error)
+
+	PostViewExpansion
+}
+
+// PostViewExpansion 定义了博文浏览统计操作的附加方法.
+type PostViewExpansion interface {
+	// Add 将浏览次数累加到指定博文指定日期的统计中，统计记录不存在时创建.
+	// 独立访客数和访客草图直接使用 obj 中的值覆盖，调用方需要先合并已有的草图.
+	Add(ctx context.Context, obj *model.PostViewM) error
+	// GetForUpdate 获取并锁定指定博文指定日期的统计，统计记录不存在时先创建一条空记录.
+	// 需要在事务中调用，锁定持续到事务结束，以便多个实例依次合并访客草图.
+	GetForUpdate(ctx context.Context, postID string, day string) (*model.PostViewM, error)
+}
+
+type postViewStore struct {
+	store *datastore
+	*genericstore.Store[model.PostViewM]
+}
+
+// 确保 postViewStore 实现了 PostViewStore 接口.
+var _ PostViewStore = (*postViewStore)(nil)
+
+func newPostViewStore(store *datastore) *postViewStore {
+	return &postViewStore{
+		store: store,
+		Store: genericstore.NewStore[model.PostViewM](store, NewLogger()),
+	}
+}
+
+// GetForUpdate 先插入空的统计记录（已存在时忽略），再使用 SELECT ... FOR UPDATE 锁定该记录，
+// 保证记录不存在时并发的写入者也会互相等待.
+func (s *postViewStore) GetForUpdate(ctx context.Context, postID string, day string) (*model.PostViewM, error) {
+	empty := model.PostViewM{PostID: postID, Day: day, UpdatedAt: time.Now()}
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&empty).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to create post views in database", "postID", postID, "day", day)
+		return nil, err
+	}
+
+	var viewM model.PostViewM
+	err := s.store.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("postID = ? AND day = ?", postID, day).First(&viewM).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to lock post views in database", "postID", postID, "day", day)
+		return nil, err
+	}
+	return &viewM, nil
+}
+
+// Add 在数据库中原子地累加浏览次数，避免多个实例同时写入时丢失更新.
+func (s *postViewStore) Add(ctx context.Context, obj *model.PostViewM) error {
+	obj.UpdatedAt = time.Now()
+	err := s.store.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "postID"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"views":         gorm.Expr("views + ?", obj.Views),
+			"visitors":      obj.Visitors,
+			"visitorSketch": obj.VisitorSketch,
+			"updatedAt":     obj.UpdatedAt,
+		}),
+	}).Create(obj).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to add post views in database", "postID", obj.PostID, "day", obj.Day)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// SeriesStore 定义了 series 模块在 store 层所实现的方法.
+type SeriesStore interface {
+	Create(ctx context.Context, obj *model.SeriesM) error
+	Update(ctx context.Context, obj *model.SeriesM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.SeriesM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.SeriesM, error)
+
+	SeriesExpansion
+}
+
+// SeriesExpansion 定义了系列操作的附加方法.
+type SeriesExpansion interface{}
+
+type seriesStore struct {
+	*genericstore.Store[model.SeriesM]
+}
+
+// 确保 seriesStore 实现了 SeriesStore 接口.
+var _ SeriesStore = (*seriesStore)(nil)
+
+func newSeriesStore(store *datastore) *seriesStore {
+	return &seriesStore{
+		Store: genericstore.NewStore[model.SeriesM](store, NewLogger()),
+	}
+}
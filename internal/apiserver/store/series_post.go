@@ -0,0 +1,70 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	genericstore "github.com/onexstack/onexstack/pkg/store"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// SeriesPostStore 定义了 series_post 模块在 store 层所实现的方法.
+type SeriesPostStore interface {
+	Create(ctx context.Context, obj *model.SeriesPostM) error
+	Update(ctx context.Context, obj *model.SeriesPostM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.SeriesPostM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.SeriesPostM, error)
+
+	SeriesPostExpansion
+}
+
+// SeriesPostExpansion 定义了系列博文操作的附加方法.
+type SeriesPostExpansion interface {
+	// ListPosts 按系列中的位置列出系列中未删除的博文，只查询 postID、title、slug 和 status 字段.
+	ListPosts(ctx context.Context, seriesID string) ([]*model.PostM, error)
+}
+
+type seriesPostStore struct {
+	store *datastore
+	*genericstore.Store[model.SeriesPostM]
+}
+
+// 确保 seriesPostStore 实现了 SeriesPostStore 接口.
+var _ SeriesPostStore = (*seriesPostStore)(nil)
+
+func newSeriesPostStore(store *datastore) *seriesPostStore {
+	return &seriesPostStore{
+		store: store,
+		Store: genericstore.NewStore[model.SeriesPostM](store, NewLogger()),
+	}
+}
+
+// ListPosts 联合查询系列博文表和博文表. 回收站中的博文会保留在系列中以便恢复，但不会被列出.
+func (s *seriesPostStore) ListPosts(ctx context.Context, seriesID string) (ret []*model.PostM, err error) {
+	err = s.store.DB(ctx).
+		Model(&model.PostM{}).
+		Select("post.postID", "post.userID", "post.title", "post.slug", "post.status", "post.visibility").
+		Joins("JOIN series_post ON series_post.postID = post.postID").
+		Where("series_post.seriesID = ?", seriesID).
+		Order("series_post.position").
+		Find(&ret).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list series posts", "seriesID", seriesID)
+	}
+	return
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+)
+
+func TestSeriesPostStore_ListPosts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+
+	postIDs := make([]string, 0, 3)
+	for _, title := range []string{"one", "two", "three"} {
+		postM := model.PostM{UserID: "user-1", Title: title, Content: "content"}
+		require.NoError(t, s.Post().Create(ctx, &postM))
+		postIDs = append(postIDs, postM.PostID)
+	}
+
+	// 系列中的顺序与博文的创建顺序无关
+	for position, postID := range []string{postIDs[2], postIDs[0], postIDs[1]} {
+		memberM := model.SeriesPostM{SeriesID: "series-1", PostID: postID, Position: int64(position)}
+		require.NoError(t, s.SeriesPost().Create(ctx, &memberM))
+	}
+	require.NoError(t, s.SeriesPost().Create(ctx, &model.SeriesPostM{SeriesID: "series-2", PostID: "post-other"}))
+
+	titles := func() []string {
+		postList, err := s.SeriesPost().ListPosts(ctx, "series-1")
+		require.NoError(t, err)
+
+		ret := make([]string, 0, len(postList))
+		for _, post := range postList {
+			assert.Empty(t, post.Content, "content should not be loaded")
+			ret = append(ret, post.Title)
+		}
+		return ret
+	}
+	assert.Equal(t, []string{"three", "one", "two"}, titles())
+
+	// 回收站中的博文不会被列出，但仍保留在系列中
+	require.NoError(t, s.Post().Delete(ctx, where.F("postID", postIDs[0])))
+	assert.Equal(t, []string{"three", "two"}, titles())
+
+	count, _, err := s.SeriesPost().List(ctx, where.F("seriesID", "series-1"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
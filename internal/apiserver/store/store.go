@@ -44,6 +44,22 @@ type IStore interface {
 
 	User() UserStore
 	Post() PostStore
+	PostCollaborator() PostCollaboratorStore
+	PostTag() PostTagStore
+	PostRevision() PostRevisionStore
+	PostSlug() PostSlugStore
+	PostShare() PostShareStore
+	Comment() CommentStore
+	Media() MediaStore
+	PostReaction() PostReactionStore
+	PostReactionCount() PostReactionCountStore
+	Bookmark() BookmarkStore
+	Follow() FollowStore
+	Series() SeriesStore
+	SeriesPost() SeriesPostStore
+	PostView() PostViewStore
+	ExportJob() ExportJobStore
+	ImportMapping() ImportMappingStore
 }
 
 // datastore 是 IStore 的具体实现.
@@ -100,3 +116,83 @@ func (store *datastore) User() UserStore {
 func (store *datastore) Post() PostStore {
 	return newPostStore(store)
 }
+
+// PostCollaborator 返回一个实现了 PostCollaboratorStore 接口的实例.
+func (store *datastore) PostCollaborator() PostCollaboratorStore {
+	return newPostCollaboratorStore(store)
+}
+
+// PostTag 返回一个实现了 PostTagStore 接口的实例.
+func (store *datastore) PostTag() PostTagStore {
+	return newPostTagStore(store)
+}
+
+// PostRevision 返回一个实现了 PostRevisionStore 接口的实例.
+func (store *datastore) PostRevision() PostRevisionStore {
+	return newPostRevisionStore(store)
+}
+
+// PostSlug 返回一个实现了 PostSlugStore 接口的实例.
+func (store *datastore) PostSlug() PostSlugStore {
+	return newPostSlugStore(store)
+}
+
+// PostShare 返回一个实现了 PostShareStore 接口的实例.
+func (store *datastore) PostShare() PostShareStore {
+	return newPostShareStore(store)
+}
+
+// Comment 返回一个实现了 CommentStore 接口的实例.
+func (store *datastore) Comment() CommentStore {
+	return newCommentStore(store)
+}
+
+// Media 返回一个实现了 MediaStore 接口的实例.
+func (store *datastore) Media() MediaStore {
+	return newMediaStore(store)
+}
+
+// PostReaction 返回一个实现了 PostReactionStore 接口的实例.
+func (store *datastore) PostReaction() PostReactionStore {
+	return newPostReactionStore(store)
+}
+
+// PostReactionCount 返回一个实现了 PostReactionCountStore 接口的实例.
+func (store *datastore) PostReactionCount() PostReactionCountStore {
+	return newPostReactionCountStore(store)
+}
+
+// Bookmark 返回一个实现了 BookmarkStore 接口的实例.
+func (store *datastore) Bookmark() BookmarkStore {
+	return newBookmarkStore(store)
+}
+
+// Follow 返回一个实现了 FollowStore 接口的实例.
+func (store *datastore) Follow() FollowStore {
+	return newFollowStore(store)
+}
+
+// Series 返回一个实现了 SeriesStore 接口的实例.
+func (store *datastore) Series() SeriesStore {
+	return newSeriesStore(store)
+}
+
+// SeriesPost 返回一个实现了 SeriesPostStore 接口的实例.
+func (store *datastore) SeriesPost() SeriesPostStore {
+	return newSeriesPostStore(store)
+}
+
+// PostView 返回一个实现了 PostViewStore 接口的实例.
+func (store *datastore) PostView() PostViewStore {
+	return newPostViewStore(store)
+}
+
+// ExportJob 返回一个实现了 ExportJobStore 接口的实例.
+func (store *datastore) ExportJob() ExportJobStore {
+	return newExportJobStore(store)
+}
+
+// ImportMapping 返回一个实现了 ImportMappingStore 接口的实例.
+func (store *datastore) ImportMapping() ImportMappingStore {
+	return newImportMappingStore(store)
+}
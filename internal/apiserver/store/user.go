@@ -18,6 +18,8 @@ import (
 	"context"
 
 	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
 	genericstore "github.com/onexstack/onexstack/pkg/store"
 	"github.com/onexstack/onexstack/pkg/store/where"
 )
@@ -34,9 +36,13 @@ type UserStore interface {
 }
 
 // UserExpansion 定义了用户操作的附加方法.
-type UserExpansion interface{}
+type UserExpansion interface {
+	// ListAuthors 按 id 升序分页列出至少有一篇已发布博文的用户，只查询 userID、username 和 updatedAt 字段.
+	ListAuthors(ctx context.Context, offset int, limit int) (int64, []*model.UserM, error)
+}
 
 type userStore struct {
+	store *datastore
 	*genericstore.Store[model.UserM]
 }
 
@@ -44,6 +50,49 @@ var _ UserStore = (*userStore)(nil)
 
 func newUserStore(store *datastore) *userStore {
 	return &userStore{
+		store: store,
 		Store: genericstore.NewStore[model.UserM](store, NewLogger()),
 	}
 }
+
+// Update 更新用户并将版本号加一. 只有数据库中的版本号仍与 obj.Version 一致时才会更新，
+// 否则说明用户信息在读取之后已被其他请求修改，返回 errno.ErrVersionConflict.
+func (s *userStore) Update(ctx context.Context, obj *model.UserM) error {
+	version := obj.Version
+	obj.Version++
+	ret := s.store.DB(ctx).Model(obj).Where("version = ?", version).Select("*").Updates(obj)
+	if ret.Error != nil {
+		obj.Version = version
+		NewLogger().Error(ctx, ret.Error, "Failed to update object in database", "object", obj)
+		return ret.Error
+	}
+	if ret.RowsAffected == 0 {
+		obj.Version = version
+		return errno.ErrVersionConflict
+	}
+	return nil
+}
+
+// ListAuthors 分页列出有已发布的公开博文的用户，这些用户的公开主页才有可供展示的内容.
+func (s *userStore) ListAuthors(ctx context.Context, offset int, limit int) (count int64, ret []*model.UserM, err error) {
+	published := s.store.DB(ctx).
+		Model(&model.PostM{}).
+		Select("userID").
+		Where("status = ? AND visibility = ?", int32(apiv1.PostStatus_Published), int32(apiv1.PostVisibility_Public))
+
+	err = s.store.DB(ctx).
+		Model(&model.UserM{}).
+		Select("id", "userID", "username", "updatedAt").
+		Where("userID IN (?)", published).
+		Order("id").
+		Offset(offset).
+		Limit(limit).
+		Find(&ret).
+		Offset(-1).
+		Limit(-1).
+		Count(&count).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list authors", "offset", offset, "limit", limit)
+	}
+	return
+}
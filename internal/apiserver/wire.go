@@ -6,25 +6,33 @@ package apiserver
 import (
 	"github.com/google/wire"
 	"github.com/jwcen/miniblog/internal/apiserver/biz"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
 	"github.com/jwcen/miniblog/internal/apiserver/pkg/validation"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/apiserver/worker"
 	ginmw "github.com/jwcen/miniblog/internal/pkg/middleware/gin"
-	"github.com/jwcen/miniblog/internal/pkg/server"
-	"github.com/onexstack/onexstack/pkg/authn"
+	auth "github.com/onexstack/onexstack/pkg/authz"
 )
 
-func InitializeWebServer(*Config) (server.Server, error) {
+func InitializeUnionServer(*Config) (*UnionServer, error) {
 	wire.Build(
-		wire.NewSet(NewWebServer, wire.FieldsOf(new(*Config), "ServerMode")),
+		wire.Struct(new(UnionServer), "*"),
+		wire.NewSet(NewWebServer, wire.FieldsOf(new(*Config), "ServerMode", "MaxMediaSize")),
 		wire.Struct(new(ServerConfig), "*"), // * 表示注入全部字段
 		wire.NewSet(store.ProviderSet, biz.ProviderSet),
-		ProvideDB, // 提供数据库实例
+		ProvideDB,            // 提供数据库实例
+		ProvideSearcher,      // 提供全文检索实例
+		render.NewRenderer,   // 提供内容渲染实例
+		ProvideBlobStore,     // 提供媒体文件存储实例
+		viewcount.NewCounter, // 提供博文浏览计数器
 		validation.ProviderSet,
 		wire.NewSet(
 			wire.Struct(new(UserRetriever), "*"),
 			wire.Bind(new(ginmw.UserRetriever), new(*UserRetriever)),
 		),
 		auth.ProviderSet,
+		wire.NewSet(worker.ProviderSet, ProvidePostPurger, ProvideWorkers),
 	)
 	return nil, nil
 }
@@ -8,27 +8,43 @@ package apiserver
 
 import (
 	"github.com/jwcen/miniblog/internal/apiserver/biz"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
 	"github.com/jwcen/miniblog/internal/apiserver/pkg/validation"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/jwcen/miniblog/internal/apiserver/store"
-	"github.com/jwcen/miniblog/internal/pkg/server"
-	auth "github.com/onexstack/onexstack/pkg/authz"
+	"github.com/jwcen/miniblog/internal/apiserver/worker"
+	"github.com/onexstack/onexstack/pkg/authz"
+	"k8s.io/utils/clock"
 )
 
 // Injectors from wire.go:
 
-func InitializeWebServer(config *Config) (server.Server, error) {
+func InitializeUnionServer(config *Config) (*UnionServer, error) {
 	string2 := config.ServerMode
 	db, err := ProvideDB(config)
 	if err != nil {
 		return nil, err
 	}
 	datastore := store.NewStore(db)
-	authz, err := auth.NewAuthz(db)
+	v := authz.DefaultOptions()
+	authzAuthz, err := authz.NewAuthz(db, v...)
 	if err != nil {
 		return nil, err
 	}
-	bizBiz := biz.NewBiz(datastore, authz)
-	validator := validation.New(datastore)
+	searcher, err := ProvideSearcher(config, datastore)
+	if err != nil {
+		return nil, err
+	}
+	renderer := render.NewRenderer()
+	blobStore, err := ProvideBlobStore(config)
+	if err != nil {
+		return nil, err
+	}
+	withTicker := _wireRealClockValue
+	counter := viewcount.NewCounter(datastore, withTicker)
+	bizBiz := biz.NewBiz(datastore, authzAuthz, searcher, renderer, blobStore, counter)
+	int64_2 := config.MaxMediaSize
+	validator := validation.New(datastore, int64_2)
 	userRetriever := &UserRetriever{
 		store: datastore,
 	}
@@ -37,11 +53,23 @@ func InitializeWebServer(config *Config) (server.Server, error) {
 		biz:       bizBiz,
 		val:       validator,
 		retriever: userRetriever,
-		authz:     authz,
+		authz:     authzAuthz,
 	}
-	serverServer, err := NewWebServer(string2, serverConfig)
+	server, err := NewWebServer(string2, serverConfig)
 	if err != nil {
 		return nil, err
 	}
-	return serverServer, nil
+	postPublisher := worker.NewPostPublisher(datastore, withTicker)
+	postPurger := ProvidePostPurger(config, datastore, blobStore, withTicker)
+	postExporter := worker.NewPostExporter(datastore, blobStore, withTicker)
+	v2 := ProvideWorkers(postPublisher, postPurger, postExporter, counter)
+	unionServer := &UnionServer{
+		srv:     server,
+		workers: v2,
+	}
+	return unionServer, nil
 }
+
+var (
+	_wireRealClockValue = clock.RealClock{}
+)
@@ -0,0 +1,265 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/export"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const (
+	// exportInterval 是检查等待执行的导出任务的时间间隔.
+	exportInterval = 5 * time.Second
+	// exportStaleAfter 是导出任务的最长执行时间，超过该时间仍未完成的任务视为执行任务的实例已经退出，会被重新执行.
+	exportStaleAfter = 30 * time.Minute
+	// exportCleanupBatchSize 是每次删除的过期导出任务数量上限.
+	exportCleanupBatchSize = 100
+	// maxExportErrorLength 是保存到数据库中的失败原因的最大长度.
+	maxExportErrorLength = 1024
+)
+
+// PostExporter 在后台执行博文导出任务，将生成的导出文件保存到对象存储中，并删除超过保留期的导出任务和导出文件.
+// 任务通过数据库领取，多个实例可以同时运行.
+type PostExporter struct {
+	store    store.IStore
+	blobs    blob.BlobStore
+	exporter *export.Exporter
+	clock    clock.WithTicker
+
+	// ctx 在停止任务时取消，用于中断正在执行的导出任务
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// 确保 PostExporter 实现了 Worker 接口.
+var _ Worker = (*PostExporter)(nil)
+
+// NewPostExporter 创建一个 *PostExporter 实例.
+func NewPostExporter(store store.IStore, blobs blob.BlobStore, clock clock.WithTicker) *PostExporter {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PostExporter{
+		store:    store,
+		blobs:    blobs,
+		exporter: export.NewExporter(store),
+		clock:    clock,
+		ctx:      ctx,
+		cancel:   cancel,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start 启动导出任务. 启动时会立即执行一次，以处理服务停止期间创建的导出任务.
+func (p *PostExporter) Start() {
+	p.startOnce.Do(func() {
+		// 在启动协程之前创建 ticker，确保调用方推进时钟时 ticker 已经存在
+		ticker := p.clock.NewTicker(exportInterval)
+		go p.run(ticker)
+	})
+}
+
+// Stop 停止导出任务，中断正在执行的导出并等待其退出. 被中断的任务会重新标记为等待执行.
+func (p *PostExporter) Stop(ctx context.Context) {
+	// 任务未启动时直接标记为已退出，之后再调用 Start 也不会启动任务
+	p.startOnce.Do(func() { close(p.doneCh) })
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+		p.cancel()
+	})
+
+	select {
+	case <-p.doneCh:
+	case <-ctx.Done():
+		log.Errorw("Timed out waiting for post exporter to stop", "err", ctx.Err())
+	}
+}
+
+// RunPending 依次执行所有等待执行的导出任务，返回执行的任务数量. 单个任务失败时记录失败原因，不会返回错误.
+func (p *PostExporter) RunPending(ctx context.Context) (int, error) {
+	var count int
+	for ctx.Err() == nil {
+		jobM, err := p.store.ExportJob().Claim(ctx, p.clock.Now().Add(-exportStaleAfter))
+		if err != nil {
+			return count, err
+		}
+		if jobM == nil {
+			break
+		}
+
+		p.runJob(ctx, jobM)
+		count++
+	}
+	return count, nil
+}
+
+// CleanupExpired 删除完成时间超过保留期的导出任务及其导出文件，返回删除的任务数量.
+func (p *PostExporter) CleanupExpired(ctx context.Context) (int64, error) {
+	cutoff := p.clock.Now().Add(-export.Retention)
+
+	var total int64
+	for {
+		_, jobList, err := p.store.ExportJob().List(ctx, where.L(exportCleanupBatchSize).Q("finishedAt <= ?", cutoff))
+		if err != nil {
+			return total, err
+		}
+		if len(jobList) == 0 {
+			break
+		}
+
+		jobIDs := make([]string, 0, len(jobList))
+		for _, jobM := range jobList {
+			jobIDs = append(jobIDs, jobM.JobID)
+		}
+		if err := p.store.ExportJob().Delete(ctx, where.F("jobID", jobIDs)); err != nil {
+			return total, err
+		}
+		// 数据库记录已删除，文件删除失败只记录日志
+		for _, jobM := range jobList {
+			if jobM.StorageKey == "" {
+				continue
+			}
+			if err := p.blobs.Delete(ctx, jobM.StorageKey); err != nil {
+				log.Errorw("Failed to delete export file", "jobID", jobM.JobID, "key", jobM.StorageKey, "err", err)
+			}
+		}
+
+		total += int64(len(jobList))
+		if len(jobList) < exportCleanupBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Infow("Deleted expired export jobs", "count", total)
+	}
+	return total, nil
+}
+
+// runJob 执行导出任务并保存任务结果.
+func (p *PostExporter) runJob(ctx context.Context, jobM *model.ExportJobM) {
+	postCount, size, key, err := p.export(ctx, jobM)
+	if err != nil && ctx.Err() != nil {
+		// 服务停止导致导出中断时，将任务重新标记为等待执行，由下次启动的实例继续执行
+		jobM.Status = int32(apiv1.ExportJobStatus_Pending)
+		if err := p.store.ExportJob().Update(context.WithoutCancel(ctx), jobM); err != nil {
+			log.Errorw("Failed to requeue interrupted export job", "jobID", jobM.JobID, "err", err)
+		}
+		return
+	}
+
+	jobM.FinishedAt = ptr.To(p.clock.Now())
+	if err != nil {
+		log.Errorw("Failed to export posts", "jobID", jobM.JobID, "userID", jobM.UserID, "err", err)
+		jobM.Status = int32(apiv1.ExportJobStatus_Failed)
+		jobM.Error = truncate(err.Error(), maxExportErrorLength)
+	} else {
+		jobM.Status = int32(apiv1.ExportJobStatus_Succeeded)
+		jobM.PostCount = postCount
+		jobM.Size = size
+		jobM.StorageKey = key
+	}
+	if err := p.store.ExportJob().Update(ctx, jobM); err != nil {
+		log.Errorw("Failed to save export job result", "jobID", jobM.JobID, "err", err)
+		if key != "" {
+			if err := p.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+				log.Errorw("Failed to delete export file", "jobID", jobM.JobID, "key", key, "err", err)
+			}
+		}
+	}
+}
+
+// export 将导出文件先写入临时文件以得到文件大小，再保存到对象存储中，返回导出的博文数量、文件大小和存储键.
+func (p *PostExporter) export(ctx context.Context, jobM *model.ExportJobM) (int64, int64, string, error) {
+	f, err := os.CreateTemp("", "miniblog-export-*")
+	if err != nil {
+		return 0, 0, "", err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	format := apiv1.ExportFormat(jobM.Format)
+	postCount, err := p.exporter.Export(ctx, jobM.UserID, format, f)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, 0, "", err
+	}
+
+	key := "exports/" + jobM.UserID + "/" + jobM.JobID + export.Extension(format)
+	if err := p.blobs.Put(ctx, key, f, size, export.ContentType(format)); err != nil {
+		return 0, 0, "", err
+	}
+	return postCount, size, key, nil
+}
+
+func (p *PostExporter) run(ticker clock.Ticker) {
+	defer close(p.doneCh)
+	defer ticker.Stop()
+
+	p.tick()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C():
+			p.tick()
+		}
+	}
+}
+
+func (p *PostExporter) tick() {
+	if _, err := p.RunPending(p.ctx); err != nil && p.ctx.Err() == nil {
+		log.Errorw("Failed to run export jobs", "err", err)
+	}
+	if _, err := p.CleanupExpired(p.ctx); err != nil && p.ctx.Err() == nil {
+		log.Errorw("Failed to delete expired export jobs", "err", err)
+	}
+}
+
+// truncate 将字符串截断为最多 n 个字节，不会截断多字节字符.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/export"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// createExportJob 创建一个导出任务，返回任务 ID.
+func createExportJob(t *testing.T, s store.IStore, jobM *model.ExportJobM) string {
+	t.Helper()
+
+	require.NoError(t, s.ExportJob().Create(context.Background(), jobM))
+	return jobM.JobID
+}
+
+func getExportJob(t *testing.T, s store.IStore, jobID string) *model.ExportJobM {
+	t.Helper()
+
+	jobM, err := s.ExportJob().Get(context.Background(), where.F("jobID", jobID))
+	require.NoError(t, err)
+	return jobM
+}
+
+func TestPostExporter_RunPending(t *testing.T) {
+	s := newTestStore(t)
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	now := time.Now()
+	clk := clocktesting.NewFakeClock(now)
+	ctx := context.Background()
+
+	userM := model.UserM{Username: "alice", Password: "miniblog1234", Phone: "alice"}
+	require.NoError(t, s.User().Create(ctx, &userM))
+	require.NoError(t, s.Post().Create(ctx, &model.PostM{UserID: userM.UserID, Title: "hello", Slug: "hello", Content: "content"}))
+
+	pending := createExportJob(t, s, &model.ExportJobM{UserID: userM.UserID})
+	missingUser := createExportJob(t, s, &model.ExportJobM{UserID: "user-missing", Format: int32(apiv1.ExportFormat_TarGz)})
+	running := createExportJob(t, s, &model.ExportJobM{UserID: userM.UserID, Status: int32(apiv1.ExportJobStatus_Running)})
+	stale := createExportJob(t, s, &model.ExportJobM{UserID: userM.UserID, Status: int32(apiv1.ExportJobStatus_Running)})
+	err = s.DB(ctx).Model(&model.ExportJobM{}).Where("jobID = ?", stale).UpdateColumn("updatedAt", now.Add(-time.Hour)).Error
+	require.NoError(t, err)
+
+	p := NewPostExporter(s, blobs, clk)
+	count, err := p.RunPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	for _, jobID := range []string{pending, stale} {
+		jobM := getExportJob(t, s, jobID)
+		assert.EqualValues(t, apiv1.ExportJobStatus_Succeeded, jobM.Status)
+		assert.EqualValues(t, 1, jobM.PostCount)
+		assert.Equal(t, "exports/"+userM.UserID+"/"+jobID+".zip", jobM.StorageKey)
+		require.NotNil(t, jobM.FinishedAt)
+
+		rc, err := blobs.Get(ctx, jobM.StorageKey)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.EqualValues(t, len(data), jobM.Size)
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		assert.Len(t, zr.File, 2)
+	}
+
+	jobM := getExportJob(t, s, missingUser)
+	assert.EqualValues(t, apiv1.ExportJobStatus_Failed, jobM.Status)
+	assert.NotEmpty(t, jobM.Error)
+	assert.Empty(t, jobM.StorageKey)
+
+	// 仍在执行期限内的任务不会被重复执行
+	jobM = getExportJob(t, s, running)
+	assert.EqualValues(t, apiv1.ExportJobStatus_Running, jobM.Status)
+	assert.Nil(t, jobM.FinishedAt)
+}
+
+func TestPostExporter_CleanupExpired(t *testing.T) {
+	s := newTestStore(t)
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	now := time.Date(2024, 12, 12, 8, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+	ctx := context.Background()
+
+	key := "exports/user-test/expired.zip"
+	require.NoError(t, blobs.Put(ctx, key, bytes.NewReader([]byte("data")), 4, "application/zip"))
+	expired := createExportJob(t, s, &model.ExportJobM{
+		UserID:     "user-test",
+		Status:     int32(apiv1.ExportJobStatus_Succeeded),
+		StorageKey: key,
+		FinishedAt: ptr.To(now.Add(-export.Retention - time.Minute)),
+	})
+	failed := createExportJob(t, s, &model.ExportJobM{
+		UserID:     "user-test",
+		Status:     int32(apiv1.ExportJobStatus_Failed),
+		FinishedAt: ptr.To(now.Add(-export.Retention - time.Minute)),
+	})
+	recent := createExportJob(t, s, &model.ExportJobM{
+		UserID:     "user-test",
+		Status:     int32(apiv1.ExportJobStatus_Succeeded),
+		FinishedAt: ptr.To(now.Add(-time.Hour)),
+	})
+	pending := createExportJob(t, s, &model.ExportJobM{UserID: "user-test"})
+
+	count, err := NewPostExporter(s, blobs, clk).CleanupExpired(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+
+	for _, jobID := range []string{expired, failed} {
+		_, err := s.ExportJob().Get(ctx, where.F("jobID", jobID))
+		assert.Error(t, err, jobID)
+	}
+	for _, jobID := range []string{recent, pending} {
+		getExportJob(t, s, jobID)
+	}
+	_, err = blobs.Get(ctx, key)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestPostExporter_StartStop(t *testing.T) {
+	s := newTestStore(t)
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	clk := clocktesting.NewFakeClock(time.Now())
+
+	userM := model.UserM{Username: "alice", Password: "miniblog1234", Phone: "alice"}
+	require.NoError(t, s.User().Create(context.Background(), &userM))
+	// 模拟服务停止期间创建的导出任务
+	missed := createExportJob(t, s, &model.ExportJobM{UserID: userM.UserID})
+
+	p := NewPostExporter(s, blobs, clk)
+	p.Start()
+
+	// 启动时立即执行等待中的任务
+	assert.Eventually(t, isExported(s, missed), 5*time.Second, 10*time.Millisecond)
+
+	// 时钟推进一个周期后，执行新创建的任务
+	later := createExportJob(t, s, &model.ExportJobM{UserID: userM.UserID})
+	clk.Step(exportInterval)
+	assert.Eventually(t, isExported(s, later), 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p.Stop(ctx)
+	require.NoError(t, ctx.Err(), "exporter should stop before timeout")
+}
+
+// isExported 返回判断导出任务是否已完成的函数，用于 assert.Eventually.
+func isExported(s store.IStore, jobID string) func() bool {
+	return func() bool {
+		jobM, err := s.ExportJob().Get(context.Background(), where.F("jobID", jobID))
+		return err == nil && jobM.Status == int32(apiv1.ExportJobStatus_Succeeded)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+)
+
+// publishInterval 是检查到期定时博文的时间间隔.
+const publishInterval = 10 * time.Second
+
+// PostPublisher 定期将到期的定时博文修改为已发布状态.
+// 定时发布的状态全部保存在数据库中，服务重启后会继续发布重启期间到期的博文.
+type PostPublisher struct {
+	store store.IStore
+	clock clock.WithTicker
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// 确保 PostPublisher 实现了 Worker 接口.
+var _ Worker = (*PostPublisher)(nil)
+
+// NewPostPublisher 创建一个 *PostPublisher 实例.
+func NewPostPublisher(store store.IStore, clock clock.WithTicker) *PostPublisher {
+	return &PostPublisher{
+		store:  store,
+		clock:  clock,
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+// Start 启动定时发布任务. 启动时会立即执行一次发布，以处理服务停止期间到期的博文.
+func (p *PostPublisher) Start() {
+	p.startOnce.Do(func() {
+		// 在启动协程之前创建 ticker，确保调用方推进时钟时 ticker 已经存在
+		ticker := p.clock.NewTicker(publishInterval)
+		go p.run(ticker)
+	})
+}
+
+// Stop 停止定时发布任务，并等待正在执行的发布操作完成.
+func (p *PostPublisher) Stop(ctx context.Context) {
+	// 任务未启动时直接标记为已退出，之后再调用 Start 也不会启动任务
+	p.startOnce.Do(func() { close(p.doneCh) })
+	p.stopOnce.Do(func() { close(p.stopCh) })
+
+	select {
+	case <-p.doneCh:
+	case <-ctx.Done():
+		log.Errorw("Timed out waiting for post publisher to stop", "err", ctx.Err())
+	}
+}
+
+// PublishDue 发布所有到期的定时博文，返回被发布的博文数量.
+func (p *PostPublisher) PublishDue(ctx context.Context) (int64, error) {
+	count, err := p.store.Post().PublishDue(ctx, p.clock.Now())
+	if err != nil {
+		return 0, err
+	}
+	if count > 0 {
+		log.Infow("Published scheduled posts", "count", count)
+	}
+	return count, nil
+}
+
+func (p *PostPublisher) run(ticker clock.Ticker) {
+	defer close(p.doneCh)
+	defer ticker.Stop()
+
+	p.publish()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C():
+			p.publish()
+		}
+	}
+}
+
+func (p *PostPublisher) publish() {
+	if _, err := p.PublishDue(context.Background()); err != nil {
+		log.Errorw("Failed to publish scheduled posts", "err", err)
+	}
+}
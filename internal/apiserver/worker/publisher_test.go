@@ -0,0 +1,184 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+var (
+	dbOnce sync.Once
+	testDB *gorm.DB
+)
+
+// testModels 是后台任务测试中用到的博文及其关联数据、用户和导出任务的模型.
+var testModels = []any{
+	&model.PostM{},
+	&model.PostTagM{},
+	&model.PostRevisionM{},
+	&model.PostSlugM{},
+	&model.PostShareM{},
+	&model.PostCollaboratorM{},
+	&model.CommentM{},
+	&model.ImportMappingM{},
+	&model.MediaM{},
+	&model.PostReactionM{},
+	&model.PostReactionCountM{},
+	&model.BookmarkM{},
+	&model.SeriesPostM{},
+	&model.PostViewM{},
+	&model.UserM{},
+	&model.ExportJobM{},
+}
+
+// newTestStore 返回基于 SQLite 内存数据库的 store 实例，并清空 testModels 中的全部数据.
+func newTestStore(t *testing.T) store.IStore {
+	t.Helper()
+
+	dbOnce.Do(func() {
+		db, err := gorm.Open(sqlite.Open("file:worker?mode=memory&cache=shared"), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(testModels...))
+		testDB = db
+	})
+	for _, m := range testModels {
+		require.NoError(t, testDB.Unscoped().Where("1 = 1").Delete(m).Error)
+	}
+
+	return store.NewStore(testDB)
+}
+
+// createPost 创建一篇测试博文，返回博文 ID.
+func createPost(t *testing.T, s store.IStore, status apiv1.PostStatus, publishAt *time.Time) string {
+	t.Helper()
+
+	postM := model.PostM{
+		UserID:    "user-test",
+		Title:     "title",
+		Content:   "content",
+		Status:    int32(status),
+		PublishAt: publishAt,
+	}
+	require.NoError(t, s.Post().Create(context.Background(), &postM))
+	return postM.PostID
+}
+
+func getPost(t *testing.T, s store.IStore, postID string) *model.PostM {
+	t.Helper()
+
+	postM, err := s.Post().Get(context.Background(), where.F("postID", postID))
+	require.NoError(t, err)
+	return postM
+}
+
+// isPublished 返回一个判断博文是否已发布的条件函数，用于 assert.Eventually.
+func isPublished(s store.IStore, postID string) func() bool {
+	return func() bool {
+		postM, err := s.Post().Get(context.Background(), where.F("postID", postID))
+		return err == nil && postM.Status == int32(apiv1.PostStatus_Published)
+	}
+}
+
+func TestPostPublisher_PublishDue(t *testing.T) {
+	s := newTestStore(t)
+	now := time.Date(2024, 12, 12, 8, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+
+	due := createPost(t, s, apiv1.PostStatus_Draft, ptr.To(now.Add(-time.Minute)))
+	onTime := createPost(t, s, apiv1.PostStatus_Draft, ptr.To(now))
+	future := createPost(t, s, apiv1.PostStatus_Draft, ptr.To(now.Add(time.Hour)))
+	draft := createPost(t, s, apiv1.PostStatus_Draft, nil)
+	archived := createPost(t, s, apiv1.PostStatus_Archived, ptr.To(now.Add(-time.Minute)))
+
+	p := NewPostPublisher(s, clk)
+	count, err := p.PublishDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	for _, postID := range []string{due, onTime} {
+		postM := getPost(t, s, postID)
+		assert.Equal(t, int32(apiv1.PostStatus_Published), postM.Status, postID)
+		assert.Nil(t, postM.PublishAt, postID)
+		require.NotNil(t, postM.PublishedAt, postID)
+	}
+	assert.True(t, getPost(t, s, onTime).PublishedAt.Equal(now))
+
+	assert.Equal(t, int32(apiv1.PostStatus_Draft), getPost(t, s, future).Status)
+	assert.Equal(t, int32(apiv1.PostStatus_Draft), getPost(t, s, draft).Status)
+	assert.Equal(t, int32(apiv1.PostStatus_Archived), getPost(t, s, archived).Status)
+
+	// 再次执行时不会重复发布
+	count, err = p.PublishDue(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestPostPublisher_StartStop(t *testing.T) {
+	s := newTestStore(t)
+	now := time.Date(2024, 12, 12, 8, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+
+	// 模拟服务停止期间已经到期的博文
+	missed := createPost(t, s, apiv1.PostStatus_Draft, ptr.To(now.Add(-time.Hour)))
+	later := createPost(t, s, apiv1.PostStatus_Draft, ptr.To(now.Add(publishInterval)))
+
+	p := NewPostPublisher(s, clk)
+	p.Start()
+
+	// 启动时立即发布已到期的博文
+	assert.Eventually(t, isPublished(s, missed), 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(apiv1.PostStatus_Draft), getPost(t, s, later).Status)
+
+	// 时钟推进一个周期后，发布新到期的博文
+	clk.Step(publishInterval)
+	assert.Eventually(t, isPublished(s, later), 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p.Stop(ctx)
+	require.NoError(t, ctx.Err(), "publisher should stop before timeout")
+
+	// 重复停止不会阻塞
+	p.Stop(ctx)
+}
+
+func TestPostPublisher_StopWithoutStart(t *testing.T) {
+	p := NewPostPublisher(newTestStore(t), clocktesting.NewFakeClock(time.Now()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p.Stop(ctx)
+	require.NoError(t, ctx.Err())
+
+	// 停止后再启动不会运行任务
+	p.Start()
+	assert.False(t, p.clock.(*clocktesting.FakeClock).HasWaiters())
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"k8s.io/utils/clock"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/log"
+)
+
+const (
+	// purgeInterval 是检查回收站中过期博文的时间间隔.
+	purgeInterval = time.Hour
+	// purgeBatchSize 是每个事务中永久删除的博文数量上限，避免单个事务过大.
+	purgeBatchSize = 100
+)
+
+// PostPurger 定期永久删除在回收站中超过保留期的博文，以及博文的评论、标签、历史版本、系列成员关系和媒体文件等关联数据.
+type PostPurger struct {
+	store     store.IStore
+	blobs     blob.BlobStore
+	clock     clock.WithTicker
+	retention time.Duration
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// 确保 PostPurger 实现了 Worker 接口.
+var _ Worker = (*PostPurger)(nil)
+
+// NewPostPurger 创建一个 *PostPurger 实例. retention 为博文在回收站中的保留时长.
+func NewPostPurger(store store.IStore, blobs blob.BlobStore, clock clock.WithTicker, retention time.Duration) *PostPurger {
+	return &PostPurger{
+		store:     store,
+		blobs:     blobs,
+		clock:     clock,
+		retention: retention,
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start 启动清理任务. 启动时会立即执行一次清理，以处理服务停止期间过期的博文.
+func (p *PostPurger) Start() {
+	p.startOnce.Do(func() {
+		// 在启动协程之前创建 ticker，确保调用方推进时钟时 ticker 已经存在
+		ticker := p.clock.NewTicker(purgeInterval)
+		go p.run(ticker)
+	})
+}
+
+// Stop 停止清理任务，并等待正在执行的清理操作完成.
+func (p *PostPurger) Stop(ctx context.Context) {
+	// 任务未启动时直接标记为已退出，之后再调用 Start 也不会启动任务
+	p.startOnce.Do(func() { close(p.doneCh) })
+	p.stopOnce.Do(func() { close(p.stopCh) })
+
+	select {
+	case <-p.doneCh:
+	case <-ctx.Done():
+		log.Errorw("Timed out waiting for post purger to stop", "err", ctx.Err())
+	}
+}
+
+// PurgeExpired 永久删除所有在回收站中超过保留期的博文，返回被删除的博文数量.
+func (p *PostPurger) PurgeExpired(ctx context.Context) (int64, error) {
+	cutoff := p.clock.Now().Add(-p.retention)
+
+	var total int64
+	for {
+		_, postList, err := p.store.Post().ListTrashed(ctx, where.L(purgeBatchSize).Q("deletedAt <= ?", cutoff))
+		if err != nil {
+			return total, err
+		}
+		if len(postList) == 0 {
+			break
+		}
+
+		postIDs := make([]string, 0, len(postList))
+		for _, post := range postList {
+			postIDs = append(postIDs, post.PostID)
+		}
+		if err := p.purge(ctx, postIDs); err != nil {
+			return total, err
+		}
+
+		total += int64(len(postIDs))
+		if len(postList) < purgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Infow("Purged expired trashed posts", "count", total)
+	}
+	return total, nil
+}
+
+// purge 在事务中永久删除博文及其关联数据，事务提交后再删除媒体文件内容.
+func (p *PostPurger) purge(ctx context.Context, postIDs []string) error {
+	var mediaList []*model.MediaM
+	err := p.store.TX(ctx, func(ctx context.Context) (err error) {
+		_, commentList, err := p.store.Comment().List(ctx, where.F("postID", postIDs))
+		if err != nil {
+			return err
+		}
+		// 删除博文和评论的导入映射，重新导入时才会重新创建被永久删除的博文和评论
+		targetIDs := append([]string{}, postIDs...)
+		for _, comment := range commentList {
+			targetIDs = append(targetIDs, comment.CommentID)
+		}
+		if err := p.store.ImportMapping().Delete(ctx, where.F("targetID", targetIDs)); err != nil {
+			return err
+		}
+		if err := p.store.Comment().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if _, mediaList, err = p.store.Media().List(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.Media().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostSlug().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostShare().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostCollaborator().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostReaction().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostReactionCount().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.Bookmark().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostRevision().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostTag().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.SeriesPost().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := p.store.PostView().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		return p.store.Post().Purge(ctx, where.F("postID", postIDs))
+	})
+	if err != nil {
+		return err
+	}
+
+	// 数据库记录已删除，文件删除失败只记录日志
+	for _, mediaM := range mediaList {
+		if err := p.blobs.Delete(ctx, mediaM.StorageKey); err != nil {
+			log.Errorw("Failed to delete media file", "mediaID", mediaM.MediaID, "key", mediaM.StorageKey, "err", err)
+		}
+	}
+	return nil
+}
+
+func (p *PostPurger) run(ticker clock.Ticker) {
+	defer close(p.doneCh)
+	defer ticker.Stop()
+
+	p.purgeExpired()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C():
+			p.purgeExpired()
+		}
+	}
+}
+
+func (p *PostPurger) purgeExpired() {
+	if _, err := p.PurgeExpired(context.Background()); err != nil {
+		log.Errorw("Failed to purge expired trashed posts", "err", err)
+	}
+}
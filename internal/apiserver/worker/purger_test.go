@@ -0,0 +1,191 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/blob"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const testRetention = 7 * 24 * time.Hour
+
+// trashPost 将博文移入回收站，并将删除时间设置为 deletedAt.
+func trashPost(t *testing.T, s store.IStore, postID string, deletedAt time.Time) {
+	t.Helper()
+
+	err := s.DB(context.Background()).
+		Model(&model.PostM{}).
+		Where("postID = ?", postID).
+		Update("deletedAt", deletedAt).Error
+	require.NoError(t, err)
+}
+
+// createAttachments 为博文创建标签、评论、分享、协作者、收藏、系列成员关系、导入映射和媒体文件，返回媒体文件的存储键.
+func createAttachments(t *testing.T, s store.IStore, blobs blob.BlobStore, postID string) string {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, s.PostTag().Create(ctx, &model.PostTagM{UserID: "user-test", PostID: postID, Name: "go"}))
+	commentM := model.CommentM{PostID: postID, UserID: "user-other", Content: "comment"}
+	require.NoError(t, s.Comment().Create(ctx, &commentM))
+	require.NoError(t, s.ImportMapping().Create(ctx, &model.ImportMappingM{Kind: "wp_post", SourceID: postID, TargetID: postID}))
+	require.NoError(t, s.ImportMapping().Create(ctx, &model.ImportMappingM{Kind: "wp_comment", SourceID: postID, TargetID: commentM.CommentID}))
+	require.NoError(t, s.PostShare().Create(ctx, &model.PostShareM{PostID: postID, UserID: "user-other"}))
+	require.NoError(t, s.PostCollaborator().Create(ctx, &model.PostCollaboratorM{PostID: postID, UserID: "user-other"}))
+	require.NoError(t, s.Bookmark().Create(ctx, &model.BookmarkM{UserID: "user-other", PostID: postID}))
+	require.NoError(t, s.SeriesPost().Create(ctx, &model.SeriesPostM{SeriesID: "series-test", PostID: postID}))
+	require.NoError(t, s.PostView().Create(ctx, &model.PostViewM{PostID: postID, Day: "2025-01-01", Views: 1}))
+
+	key := "media/" + postID
+	require.NoError(t, blobs.Put(ctx, key, bytes.NewReader([]byte("data")), 4, "text/plain"))
+	require.NoError(t, s.Media().Create(ctx, &model.MediaM{UserID: "user-test", PostID: postID, StorageKey: key}))
+	return key
+}
+
+// countMappings 统计博文及其评论的导入映射数.
+func countMappings(t *testing.T, s store.IStore, postID string) int64 {
+	t.Helper()
+
+	var count int64
+	require.NoError(t, s.DB(context.Background()).Model(&model.ImportMappingM{}).Where("sourceID = ?", postID).Count(&count).Error)
+	return count
+}
+
+// countRows 统计表中属于指定博文的记录数，包括回收站中的博文.
+func countRows(t *testing.T, s store.IStore, m any, postID string) int64 {
+	t.Helper()
+
+	var count int64
+	require.NoError(t, s.DB(context.Background()).Unscoped().Model(m).Where("postID = ?", postID).Count(&count).Error)
+	return count
+}
+
+func TestPostPurger_PurgeExpired(t *testing.T) {
+	s := newTestStore(t)
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	now := time.Date(2024, 12, 12, 8, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+
+	live := createPost(t, s, apiv1.PostStatus_Published, nil)
+	recent := createPost(t, s, apiv1.PostStatus_Published, nil)
+	expired := createPost(t, s, apiv1.PostStatus_Published, nil)
+	liveKey := createAttachments(t, s, blobs, live)
+	recentKey := createAttachments(t, s, blobs, recent)
+	expiredKey := createAttachments(t, s, blobs, expired)
+	trashPost(t, s, recent, now.Add(-testRetention).Add(time.Minute))
+	trashPost(t, s, expired, now.Add(-testRetention))
+
+	p := NewPostPurger(s, blobs, clk, testRetention)
+	count, err := p.PurgeExpired(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	for _, m := range []any{&model.PostM{}, &model.PostTagM{}, &model.CommentM{}, &model.PostShareM{}, &model.PostCollaboratorM{}, &model.BookmarkM{}, &model.SeriesPostM{}, &model.PostViewM{}, &model.MediaM{}} {
+		assert.Zero(t, countRows(t, s, m, expired), "%T of expired post", m)
+		assert.Equal(t, int64(1), countRows(t, s, m, recent), "%T of recently trashed post", m)
+		assert.Equal(t, int64(1), countRows(t, s, m, live), "%T of live post", m)
+	}
+	assert.Zero(t, countMappings(t, s, expired))
+	assert.Equal(t, int64(2), countMappings(t, s, recent))
+	assert.Equal(t, int64(2), countMappings(t, s, live))
+
+	_, err = blobs.Get(context.Background(), expiredKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	for _, key := range []string{liveKey, recentKey} {
+		rc, err := blobs.Get(context.Background(), key)
+		require.NoError(t, err, key)
+		rc.Close()
+	}
+
+	// 保留期过后，最近删除的博文同样会被清理
+	clk.Step(time.Minute)
+	count, err = p.PurgeExpired(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Zero(t, countRows(t, s, &model.PostM{}, recent))
+	assert.Equal(t, int64(1), countRows(t, s, &model.PostM{}, live))
+}
+
+func TestPostPurger_PurgeExpiredInBatches(t *testing.T) {
+	s := newTestStore(t)
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	now := time.Date(2024, 12, 12, 8, 0, 0, 0, time.UTC)
+
+	for i := 0; i < purgeBatchSize+1; i++ {
+		trashPost(t, s, createPost(t, s, apiv1.PostStatus_Draft, nil), now.Add(-2*testRetention))
+	}
+
+	p := NewPostPurger(s, blobs, clocktesting.NewFakeClock(now), testRetention)
+	count, err := p.PurgeExpired(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(purgeBatchSize+1), count)
+
+	total, _, err := s.Post().ListTrashed(context.Background(), where.NewWhere())
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestPostPurger_StartStop(t *testing.T) {
+	s := newTestStore(t)
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	now := time.Date(2024, 12, 12, 8, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+
+	missed := createPost(t, s, apiv1.PostStatus_Draft, nil)
+	later := createPost(t, s, apiv1.PostStatus_Draft, nil)
+	trashPost(t, s, missed, now.Add(-testRetention))
+	trashPost(t, s, later, now.Add(-testRetention).Add(purgeInterval))
+
+	// 条件函数在其他协程中执行，不能调用 require
+	isPurged := func(postID string) func() bool {
+		return func() bool {
+			var count int64
+			err := s.DB(context.Background()).Unscoped().Model(&model.PostM{}).Where("postID = ?", postID).Count(&count).Error
+			return err == nil && count == 0
+		}
+	}
+
+	p := NewPostPurger(s, blobs, clk, testRetention)
+	p.Start()
+
+	// 启动时立即清理已过期的博文
+	assert.Eventually(t, isPurged(missed), 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(1), countRows(t, s, &model.PostM{}, later))
+
+	// 时钟推进一个周期后，清理新过期的博文
+	clk.Step(purgeInterval)
+	assert.Eventually(t, isPurged(later), 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p.Stop(ctx)
+	require.NoError(t, ctx.Err(), "purger should stop before timeout")
+}
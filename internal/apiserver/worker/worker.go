@@ -0,0 +1,38 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	"github.com/google/wire"
+	"k8s.io/utils/clock"
+)
+
+// ProviderSet 是一个 Wire 的 Provider 集合，用于声明依赖注入的规则.
+// 后台任务默认使用真实时钟，测试时可以注入 k8s.io/utils/clock/testing 中的假时钟.
+var ProviderSet = wire.NewSet(
+	NewPostPublisher,
+	NewPostExporter,
+	wire.InterfaceValue(new(clock.WithTicker), clock.RealClock{}),
+)
+
+// Worker 定义了随服务器一同启动和停止的后台任务.
+type Worker interface {
+	// Start 在后台启动任务，调用后立即返回.
+	Start()
+	// Stop 通知任务退出，并等待任务退出或 ctx 超时.
+	Stop(ctx context.Context)
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wpimport
+
+// Report 是导入的对账报告，记录 WXR 文件中每类数据的数量以及导入结果.
+type Report struct {
+	Users    Counts
+	Posts    Counts
+	Comments Counts
+
+	// CreatedUsers 是新创建的用户，包含随机生成的初始密码.
+	CreatedUsers []*CreatedUser
+	// MatchedUsers 是与已有用户关联的作者.
+	MatchedUsers []*MatchedUser
+	// Unsupported 是不支持导入的内容，例如页面、附件和导航菜单.
+	Unsupported []*Entry
+	// Skipped 是按规则跳过的数据，例如回收站中的文章和未审核的评论.
+	Skipped []*Entry
+	// Warnings 是导入成功但有部分信息丢失的数据.
+	Warnings []*Entry
+	// Failures 是导入失败的数据.
+	Failures []*Entry
+}
+
+// Counts 是一类数据的导入统计. Total 等于其余各项之和.
+type Counts struct {
+	// Total 是 WXR 文件中的数据总数.
+	Total int
+	// Created 是本次导入新创建的数据数.
+	Created int
+	// Existing 是之前已经导入过、本次跳过的数据数.
+	Existing int
+	// Matched 是与已有数据关联的数据数，只用于用户.
+	Matched int
+	// Skipped 是按规则跳过的数据数.
+	Skipped int
+	// Failed 是导入失败的数据数.
+	Failed int
+}
+
+// CreatedUser 表示为 WordPress 作者新创建的用户.
+type CreatedUser struct {
+	Login    string
+	Username string
+	Password string
+}
+
+// MatchedUser 表示与已有用户关联的 WordPress 作者.
+type MatchedUser struct {
+	Login    string
+	Username string
+}
+
+// Entry 表示报告中的一条数据.
+type Entry struct {
+	// Kind 是数据类型，不支持导入的内容为 WordPress 中的内容类型.
+	Kind string
+	// SourceID 是数据的来源标识.
+	SourceID string
+	// Title 是数据所属文章的标题.
+	Title string
+	// Reason 是跳过、警告或失败的原因.
+	Reason string
+}
+
+// Failed 返回导入失败的数据总数.
+func (r *Report) Failed() int {
+	return r.Users.Failed + r.Posts.Failed + r.Comments.Failed
+}
+
+func (r *Report) skip(kind, sourceID, title, reason string) {
+	r.Skipped = append(r.Skipped, &Entry{Kind: kind, SourceID: sourceID, Title: title, Reason: reason})
+}
+
+func (r *Report) warn(kind, sourceID, title, reason string) {
+	r.Warnings = append(r.Warnings, &Entry{Kind: kind, SourceID: sourceID, Title: title, Reason: reason})
+}
+
+func (r *Report) fail(kind, sourceID, title string, err error) {
+	r.Failures = append(r.Failures, &Entry{Kind: kind, SourceID: sourceID, Title: title, Reason: message(err)})
+}
@@ -0,0 +1,682 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wpimport 将 WordPress 导出的 WXR 文件导入为博客的用户、文章、标签和评论.
+// 导入通过 biz 层完成，与接口创建的数据遵循相同的业务规则. 每条导入的数据都会在 import_mapping 表中
+// 记录来源标识，重复导入同一个文件时已经导入过的数据会被跳过，只导入新增的文章和评论.
+package wpimport
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/apiserver/biz"
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/frontmatter"
+	"github.com/jwcen/miniblog/internal/pkg/slug"
+	"github.com/jwcen/miniblog/internal/pkg/wxr"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+// import_mapping 表中 WordPress 数据的类型.
+const (
+	KindUser    = "wp_user"
+	KindPost    = "wp_post"
+	KindComment = "wp_comment"
+)
+
+const (
+	// 与文章接口的标签限制保持一致，超出限制的标签不会被导入.
+	maxTags      = 10
+	maxTagLength = 32
+	// maxNicknameLength 是用户昵称的最大长度.
+	maxNicknameLength = 30
+	// maxUsernameLength 是用户名的最大长度.
+	maxUsernameLength = 20
+	// maxTitleLength 是文章标题的最大长度.
+	maxTitleLength = 256
+)
+
+// invalidUsernameRegex 匹配用户名中不允许出现的字符.
+var invalidUsernameRegex = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// Options 是导入选项.
+type Options struct {
+	// Authors 将 WordPress 作者的登录名映射为已有用户的用户名. 未映射的作者优先匹配同名用户，没有同名用户时创建新用户.
+	Authors map[string]string
+	// GuestUsername 是访客评论导入到的用户. WordPress 允许未注册的访客评论，这些评论以该用户的身份导入，
+	// 并在评论内容前注明原评论者. 为空时不导入访客评论.
+	GuestUsername string
+}
+
+// Importer 将 WXR 文件导入为博客数据.
+type Importer struct {
+	biz   biz.IBiz
+	store store.IStore
+	opts  Options
+}
+
+// New 创建一个 *Importer 实例.
+func New(biz biz.IBiz, store store.IStore, opts Options) *Importer {
+	return &Importer{biz: biz, store: store, opts: opts}
+}
+
+// Import 导入 WXR 文件中的作者、文章和评论，返回对账报告. 单条数据导入失败不会中断导入，失败原因记录在报告中；
+// 只有读取映射等影响整体导入的错误才会返回 error.
+// ctx 必须是管理员身份，文章会被导入到对应作者的账号下.
+func (im *Importer) Import(ctx context.Context, export *wxr.Export) (*Report, error) {
+	run := &importRun{
+		Importer: im,
+		export:   export,
+		report:   &Report{},
+		authors:  make(map[string]*wxr.Author),
+		authorID: make(map[int64]string),
+		users:    make(map[string]*model.UserM),
+	}
+	for _, author := range export.Authors {
+		run.authors[author.Login] = author
+		run.authorID[author.ID] = author.Login
+	}
+
+	var err error
+	if run.mappings.users, err = im.store.ImportMapping().TargetIDs(ctx, KindUser); err != nil {
+		return nil, err
+	}
+	if run.mappings.posts, err = im.store.ImportMapping().TargetIDs(ctx, KindPost); err != nil {
+		return nil, err
+	}
+	if run.mappings.comments, err = im.store.ImportMapping().TargetIDs(ctx, KindComment); err != nil {
+		return nil, err
+	}
+
+	for _, author := range export.Authors {
+		run.report.Users.Total++
+		// 作者导入失败的原因记录在报告中，该作者的文章会导入失败
+		_, _ = run.user(ctx, author.Login)
+	}
+	for _, item := range export.Items {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		run.importItem(ctx, item)
+	}
+	return run.report, nil
+}
+
+// importRun 保存一次导入过程中的状态.
+type importRun struct {
+	*Importer
+
+	export *wxr.Export
+	report *Report
+
+	// authors 以登录名为键保存 WXR 文件中的作者，authorID 将作者 ID 映射为登录名.
+	authors  map[string]*wxr.Author
+	authorID map[int64]string
+	// users 以登录名为键缓存已经解析的用户，解析失败的作者值为 nil.
+	users    map[string]*model.UserM
+	userErrs map[string]error
+	// guest 是访客评论导入到的用户.
+	guest *model.UserM
+	// mappings 是已经导入过的数据，键为来源标识，值为导入后生成的资源 ID.
+	mappings struct {
+		users    map[string]string
+		posts    map[string]string
+		comments map[string]string
+	}
+}
+
+// user 返回 WordPress 作者对应的用户，第一次解析作者时按以下顺序查找或创建用户：
+// 之前导入时创建的用户、Options.Authors 中映射的用户、同名用户、新创建的用户.
+func (r *importRun) user(ctx context.Context, login string) (*model.UserM, error) {
+	if userM, ok := r.users[login]; ok {
+		return userM, nil
+	}
+	if err, ok := r.userErrs[login]; ok {
+		return nil, err
+	}
+
+	userM, err := r.resolveUser(ctx, login)
+	if err != nil {
+		if r.userErrs == nil {
+			r.userErrs = make(map[string]error)
+		}
+		r.userErrs[login] = err
+		r.report.Users.Failed++
+		r.report.fail(KindUser, login, login, err)
+		return nil, err
+	}
+	r.users[login] = userM
+	return userM, nil
+}
+
+func (r *importRun) resolveUser(ctx context.Context, login string) (*model.UserM, error) {
+	author := r.authors[login]
+	if author == nil {
+		// WXR 1.0 不包含作者列表，只能从文章的 dc:creator 中获取登录名
+		author = &wxr.Author{Login: login}
+		r.authors[login] = author
+		r.report.Users.Total++
+	}
+
+	sourceID := r.authorSourceID(login)
+	if userID, ok := r.mappings.users[sourceID]; ok {
+		userM, err := r.findUser(ctx, where.F("userID", userID))
+		if err != nil {
+			return nil, err
+		}
+		if userM != nil {
+			r.report.Users.Existing++
+			return userM, nil
+		}
+		// 之前创建的用户已被删除时，删除失效的映射并重新创建用户
+		if err := r.forgetMappings(ctx, KindUser, sourceID); err != nil {
+			return nil, err
+		}
+	}
+
+	if username, ok := r.opts.Authors[login]; ok {
+		userM, err := r.findUser(ctx, where.F("username", username))
+		if err != nil {
+			return nil, err
+		}
+		if userM == nil {
+			return nil, fmt.Errorf("mapped user %q not found", username)
+		}
+		r.report.Users.Matched++
+		r.report.MatchedUsers = append(r.report.MatchedUsers, &MatchedUser{Login: login, Username: username})
+		return userM, nil
+	}
+
+	username := usernameFor(login)
+	userM, err := r.findUser(ctx, where.F("username", username))
+	if err != nil {
+		return nil, err
+	}
+	if userM != nil {
+		r.report.Users.Matched++
+		r.report.MatchedUsers = append(r.report.MatchedUsers, &MatchedUser{Login: login, Username: username})
+		return userM, nil
+	}
+
+	password, err := randomPassword()
+	if err != nil {
+		return nil, err
+	}
+	nickname := truncate(strings.TrimSpace(author.DisplayName), maxNicknameLength)
+	rq := &apiv1.CreateUserRequest{
+		Username: username,
+		Password: password,
+		Email:    author.Email,
+		// 手机号是必填且唯一的字段，WordPress 中没有对应的数据，使用由来源标识生成的占位值，用户登录后应自行修改
+		Phone: placeholderPhone(sourceID),
+	}
+	if nickname != "" {
+		rq.Nickname = ptr.To(nickname)
+	}
+	resp, err := r.biz.UserV1().Create(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.saveMapping(ctx, KindUser, sourceID, resp.GetUserID()); err != nil {
+		return nil, err
+	}
+
+	r.report.Users.Created++
+	r.report.CreatedUsers = append(r.report.CreatedUsers, &CreatedUser{Login: login, Username: username, Password: password})
+	return r.findUser(ctx, where.F("userID", resp.GetUserID()))
+}
+
+// importItem 导入一篇文章及其评论. 已经导入过的文章会被跳过，但文章中新增的评论仍会被导入.
+// 导入过的文章已被永久删除时重新导入，文章在回收站中时跳过.
+func (r *importRun) importItem(ctx context.Context, item *wxr.Item) {
+	sourceID := r.postSourceID(item)
+	if !item.IsPost() {
+		r.report.Unsupported = append(r.report.Unsupported, &Entry{Kind: item.PostType, SourceID: sourceID, Title: item.Title})
+		return
+	}
+
+	r.report.Posts.Total++
+	if item.Status == wxr.PostStatusTrash {
+		r.report.Posts.Skipped++
+		r.report.skip(KindPost, sourceID, item.Title, "post is in the trash")
+		r.skipComments(item)
+		return
+	}
+
+	postID, ok := r.mappings.posts[sourceID]
+	if ok {
+		postM, err := r.findPost(ctx, postID)
+		if err != nil {
+			r.report.Posts.Failed++
+			r.report.fail(KindPost, sourceID, item.Title, err)
+			r.skipComments(item)
+			return
+		}
+		switch {
+		case postM == nil:
+			// 之前导入的文章已被永久删除，删除文章及其评论的失效映射后重新导入
+			if err := r.forgetPost(ctx, sourceID); err != nil {
+				r.report.Posts.Failed++
+				r.report.fail(KindPost, sourceID, item.Title, err)
+				r.skipComments(item)
+				return
+			}
+			ok = false
+		case postM.DeletedAt.Valid:
+			r.report.Posts.Skipped++
+			r.report.skip(KindPost, sourceID, item.Title, "imported post is in the trash")
+			r.skipComments(item)
+			return
+		}
+	}
+	if ok {
+		r.report.Posts.Existing++
+	} else {
+		var err error
+		if postID, err = r.importPost(ctx, sourceID, item); err != nil {
+			r.report.Posts.Failed++
+			r.report.fail(KindPost, sourceID, item.Title, err)
+			r.skipComments(item)
+			return
+		}
+		r.report.Posts.Created++
+	}
+
+	r.importComments(ctx, postID, sourceID, item)
+}
+
+func (r *importRun) importPost(ctx context.Context, sourceID string, item *wxr.Item) (string, error) {
+	if item.Creator == "" {
+		return "", fmt.Errorf("post has no author")
+	}
+	userM, err := r.user(ctx, item.Creator)
+	if err != nil {
+		return "", fmt.Errorf("author %q: %s", item.Creator, message(err))
+	}
+
+	doc := &frontmatter.Document{
+		Title:   postTitle(item),
+		Date:    item.Published(),
+		Lastmod: item.Modified(),
+		Tags:    r.postTags(sourceID, item),
+		// 待审核、私密和定时发布的文章导入为草稿，定时发布的文章导入后重新设置定时发布
+		Draft: item.Status != wxr.PostStatusPublish,
+		Body:  wxr.ToMarkdown(item.Content),
+	}
+	if doc.Slug, err = r.postSlug(ctx, item); err != nil {
+		return "", err
+	}
+	content, err := frontmatter.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := r.biz.PostV1().Import(ctx, &apiv1.ImportPostsRequest{
+		Username: userM.Username,
+		Filename: sourceID,
+		Content:  string(content),
+	})
+	if err != nil {
+		return "", err
+	}
+	if !resp.GetSuccess() {
+		return "", fmt.Errorf("%s", resp.GetError())
+	}
+
+	if item.Status == wxr.PostStatusFuture && !doc.Date.IsZero() {
+		authorCtx := contextx.WithUserID(ctx, userM.UserID)
+		rq := &apiv1.SchedulePostRequest{PostID: resp.GetPostID(), PublishAt: timestamppb.New(doc.Date)}
+		if _, err := r.biz.PostV1().Schedule(authorCtx, rq); err != nil {
+			r.report.warn(KindPost, sourceID, item.Title, "failed to schedule post: "+message(err))
+		}
+	}
+
+	if err := r.saveMapping(ctx, KindPost, sourceID, resp.GetPostID()); err != nil {
+		return "", err
+	}
+	return resp.GetPostID(), nil
+}
+
+// importComments 导入文章中已审核通过的评论. WordPress 支持多级回复，而博客只支持回复顶级评论，
+// 多级回复会被导入为其顶级评论的回复.
+func (r *importRun) importComments(ctx context.Context, postID string, postSourceID string, item *wxr.Item) {
+	comments := make(map[int64]*wxr.Comment, len(item.Comments))
+	for _, comment := range item.Comments {
+		comments[comment.ID] = comment
+	}
+	// 先导入父评论，回复导入时才能找到父评论的 ID
+	sorted := append([]*wxr.Comment(nil), item.Comments...)
+	sort.SliceStable(sorted, func(i, j int) bool { return depth(comments, sorted[i]) < depth(comments, sorted[j]) })
+
+	for _, comment := range sorted {
+		sourceID := postSourceID + "#comment-" + strconv.FormatInt(comment.ID, 10)
+		r.report.Comments.Total++
+
+		if _, ok := r.mappings.comments[sourceID]; ok {
+			r.report.Comments.Existing++
+			continue
+		}
+		if reason := r.skipComment(comment); reason != "" {
+			r.report.Comments.Skipped++
+			r.report.skip(KindComment, sourceID, item.Title, reason)
+			continue
+		}
+
+		if err := r.importComment(ctx, postID, postSourceID, sourceID, comments, comment); err != nil {
+			r.report.Comments.Failed++
+			r.report.fail(KindComment, sourceID, item.Title, err)
+			continue
+		}
+		r.report.Comments.Created++
+	}
+}
+
+// skipComments 跳过未导入的文章中的全部评论，使评论总数与 WXR 文件一致.
+func (r *importRun) skipComments(item *wxr.Item) {
+	r.report.Comments.Total += len(item.Comments)
+	r.report.Comments.Skipped += len(item.Comments)
+}
+
+// skipComment 返回评论不被导入的原因，评论需要导入时返回空字符串.
+func (r *importRun) skipComment(comment *wxr.Comment) string {
+	switch {
+	case !comment.IsApproved():
+		return "comment is not approved"
+	case comment.IsPingback():
+		return "pingbacks and trackbacks are not supported"
+	case r.commentAuthor(comment) == "" && r.opts.GuestUsername == "":
+		return "guest comments are not imported without a guest user"
+	case strings.TrimSpace(wxr.ToText(comment.Content)) == "":
+		return "comment is empty"
+	}
+	return ""
+}
+
+func (r *importRun) importComment(ctx context.Context, postID, postSourceID, sourceID string, comments map[int64]*wxr.Comment, comment *wxr.Comment) error {
+	content := wxr.ToText(comment.Content)
+	var userM *model.UserM
+	if login := r.commentAuthor(comment); login != "" {
+		var err error
+		if userM, err = r.user(ctx, login); err != nil {
+			return fmt.Errorf("author %q: %s", login, message(err))
+		}
+	} else {
+		// 访客评论以访客用户的身份导入，并注明原评论者
+		var err error
+		if userM, err = r.guestUser(ctx); err != nil {
+			return err
+		}
+		if author := strings.TrimSpace(comment.Author); author != "" {
+			content = author + ": " + content
+		}
+	}
+
+	rq := &apiv1.CreateCommentRequest{PostID: postID, Content: content}
+	// 回复挂到最近一个已导入的祖先评论所在的顶级评论下
+	for parent := comments[comment.Parent]; parent != nil; parent = comments[parent.Parent] {
+		parentSourceID := postSourceID + "#comment-" + strconv.FormatInt(parent.ID, 10)
+		if commentID, ok := r.mappings.comments[parentSourceID]; ok {
+			rq.ParentID = ptr.To(r.topLevel(ctx, commentID))
+			break
+		}
+	}
+
+	resp, err := r.biz.CommentV1().Create(contextx.WithUserID(ctx, userM.UserID), rq)
+	if err != nil {
+		return err
+	}
+
+	// 创建评论时使用当前时间，导入后恢复评论在 WordPress 中的创建时间
+	if created := comment.Created(); !created.IsZero() {
+		err := r.store.DB(ctx).Model(&model.CommentM{}).Where("commentID = ?", resp.GetCommentID()).
+			UpdateColumns(map[string]any{"createdAt": created, "updatedAt": created}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.saveMapping(ctx, KindComment, sourceID, resp.GetCommentID())
+}
+
+// commentAuthor 返回注册用户评论对应的作者登录名，访客评论返回空字符串.
+func (r *importRun) commentAuthor(comment *wxr.Comment) string {
+	if comment.UserID == 0 {
+		return ""
+	}
+	return r.authorID[comment.UserID]
+}
+
+// guestUser 返回访客评论导入到的用户，访客用户必须是已有用户.
+func (r *importRun) guestUser(ctx context.Context) (*model.UserM, error) {
+	if r.guest != nil {
+		return r.guest, nil
+	}
+	userM, err := r.findUser(ctx, where.F("username", r.opts.GuestUsername))
+	if err != nil {
+		return nil, err
+	}
+	if userM == nil {
+		return nil, fmt.Errorf("guest user %q not found", r.opts.GuestUsername)
+	}
+	r.guest = userM
+	return userM, nil
+}
+
+// topLevel 返回评论所在的顶级评论 ID.
+func (r *importRun) topLevel(ctx context.Context, commentID string) string {
+	var commentList []*model.CommentM
+	if err := r.store.DB(ctx).Where("commentID = ?", commentID).Limit(1).Find(&commentList).Error; err != nil || len(commentList) == 0 {
+		return commentID
+	}
+	if parentID := commentList[0].ParentID; parentID != "" {
+		return parentID
+	}
+	return commentID
+}
+
+// postTags 返回文章的标签，超出数量或长度限制的标签被丢弃，并记录在报告中.
+func (r *importRun) postTags(sourceID string, item *wxr.Item) []string {
+	var tags, dropped []string
+	for _, tag := range item.Tags() {
+		if len(tags) >= maxTags || utf8.RuneCountInString(tag) > maxTagLength {
+			dropped = append(dropped, tag)
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	if len(dropped) > 0 {
+		r.report.warn(KindPost, sourceID, item.Title, "dropped tags: "+strings.Join(dropped, ", "))
+	}
+	return tags
+}
+
+// postSlug 返回文章导入后使用的 slug. WordPress 中的 slug 不合法或已被其它文章使用时，由标题重新生成.
+func (r *importRun) postSlug(ctx context.Context, item *wxr.Item) (string, error) {
+	name, err := url.PathUnescape(item.PostName)
+	if err != nil || !slug.Valid(name) {
+		return "", nil
+	}
+	exists, err := r.store.Post().SlugExists(ctx, name, "")
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", nil
+	}
+	return name, nil
+}
+
+// findPost 查询文章，包括回收站中的文章，文章不存在时返回 nil.
+func (r *importRun) findPost(ctx context.Context, postID string) (*model.PostM, error) {
+	var postList []*model.PostM
+	if err := r.store.DB(ctx).Unscoped().Where("postID = ?", postID).Limit(1).Find(&postList).Error; err != nil {
+		return nil, err
+	}
+	if len(postList) == 0 {
+		return nil, nil
+	}
+	return postList[0], nil
+}
+
+// forgetPost 删除文章及其评论的映射. 文章被永久删除时评论也会被删除，评论需要随文章一起重新导入.
+func (r *importRun) forgetPost(ctx context.Context, postSourceID string) error {
+	var commentSourceIDs []string
+	for sourceID := range r.mappings.comments {
+		if strings.HasPrefix(sourceID, postSourceID+"#comment-") {
+			commentSourceIDs = append(commentSourceIDs, sourceID)
+		}
+	}
+	if err := r.forgetMappings(ctx, KindComment, commentSourceIDs...); err != nil {
+		return err
+	}
+	return r.forgetMappings(ctx, KindPost, postSourceID)
+}
+
+// forgetMappings 删除导入的资源已不存在的映射，以便重新导入.
+func (r *importRun) forgetMappings(ctx context.Context, kind string, sourceIDs ...string) error {
+	if len(sourceIDs) == 0 {
+		return nil
+	}
+	if err := r.store.ImportMapping().Delete(ctx, where.F("kind", kind, "sourceID", sourceIDs)); err != nil {
+		return err
+	}
+
+	for _, sourceID := range sourceIDs {
+		switch kind {
+		case KindUser:
+			delete(r.mappings.users, sourceID)
+		case KindPost:
+			delete(r.mappings.posts, sourceID)
+		case KindComment:
+			delete(r.mappings.comments, sourceID)
+		}
+	}
+	return nil
+}
+
+// findUser 查询用户，用户不存在时返回 nil.
+func (r *importRun) findUser(ctx context.Context, opts *where.Options) (*model.UserM, error) {
+	_, userList, err := r.store.User().List(ctx, opts.L(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(userList) == 0 {
+		return nil, nil
+	}
+	return userList[0], nil
+}
+
+func (r *importRun) saveMapping(ctx context.Context, kind, sourceID, targetID string) error {
+	mappingM := &model.ImportMappingM{Kind: kind, SourceID: sourceID, TargetID: targetID, CreatedAt: time.Now()}
+	if err := r.store.ImportMapping().Create(ctx, mappingM); err != nil {
+		return err
+	}
+
+	switch kind {
+	case KindUser:
+		r.mappings.users[sourceID] = targetID
+	case KindPost:
+		r.mappings.posts[sourceID] = targetID
+	case KindComment:
+		r.mappings.comments[sourceID] = targetID
+	}
+	return nil
+}
+
+// authorSourceID 返回作者的来源标识. 作者的登录名只在站点内唯一，因此使用站点地址作为前缀.
+func (r *importRun) authorSourceID(login string) string {
+	return r.export.SiteURL + "#author-" + login
+}
+
+// postSourceID 返回文章的来源标识. WordPress 为每篇文章生成全局唯一的 GUID，旧版本导出的文件缺少 GUID 时使用文章 ID.
+func (r *importRun) postSourceID(item *wxr.Item) string {
+	if item.GUID != "" {
+		return item.GUID
+	}
+	return r.export.SiteURL + "/?p=" + strconv.FormatInt(item.PostID, 10)
+}
+
+// depth 返回评论的回复层级，顶级评论为 0.
+func depth(comments map[int64]*wxr.Comment, comment *wxr.Comment) int {
+	n := 0
+	for parent := comments[comment.Parent]; parent != nil && n <= len(comments); parent = comments[parent.Parent] {
+		n++
+	}
+	return n
+}
+
+// postTitle 返回文章标题. WordPress 允许标题为空，此时使用文章 ID 作为标题.
+func postTitle(item *wxr.Item) string {
+	title := strings.TrimSpace(item.Title)
+	if title == "" {
+		title = "Untitled #" + strconv.FormatInt(item.PostID, 10)
+	}
+	return truncate(title, maxTitleLength)
+}
+
+// usernameFor 将 WordPress 登录名转换为合法的用户名：不允许的字符替换为下划线，长度不足时补齐.
+func usernameFor(login string) string {
+	username := strings.Trim(invalidUsernameRegex.ReplaceAllString(login, "_"), "_")
+	username = truncate(username, maxUsernameLength)
+	for len(username) < 3 {
+		username += "_wp"
+	}
+	return truncate(username, maxUsernameLength)
+}
+
+// placeholderPhone 返回由来源标识生成的占位手机号，不同作者的占位值不同，且不是合法的手机号.
+func placeholderPhone(sourceID string) string {
+	sum := sha1.Sum([]byte(sourceID))
+	return "wp" + hex.EncodeToString(sum[:])[:14]
+}
+
+// randomPassword 返回一个满足密码规则的随机密码.
+func randomPassword() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "Wp1" + hex.EncodeToString(b), nil
+}
+
+// truncate 将字符串截断为最多 n 个字符.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// message 返回错误的描述，业务错误只返回错误消息.
+func message(err error) string {
+	return errorsx.FromError(err).Message
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wpimport_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/authz"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/jwcen/miniblog/internal/apiserver/biz"
+	"github.com/jwcen/miniblog/internal/apiserver/model"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/render"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/search"
+	"github.com/jwcen/miniblog/internal/apiserver/pkg/viewcount"
+	"github.com/jwcen/miniblog/internal/apiserver/store"
+	"github.com/jwcen/miniblog/internal/apiserver/worker"
+	"github.com/jwcen/miniblog/internal/apiserver/wpimport"
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+	"github.com/jwcen/miniblog/internal/pkg/wxr"
+	apiv1 "github.com/jwcen/miniblog/pkg/api/apiserver/v1"
+)
+
+const export = `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Example Blog</title>
+	<wp:wxr_version>1.2</wp:wxr_version>
+	<wp:base_blog_url>https://blog.example.com</wp:base_blog_url>
+	<wp:author><wp:author_id>1</wp:author_id><wp:author_login>alice</wp:author_login><wp:author_email>alice@example.com</wp:author_email><wp:author_display_name>Alice</wp:author_display_name></wp:author>
+	<wp:author><wp:author_id>2</wp:author_id><wp:author_login>bob.smith</wp:author_login><wp:author_display_name>Bob Smith</wp:author_display_name></wp:author>
+	<item>
+		<title>Hello world!</title>
+		<dc:creator>alice</dc:creator>
+		<guid isPermaLink="false">https://blog.example.com/?p=1</guid>
+		<content:encoded><![CDATA[Welcome to <strong>WordPress</strong>.
+
+This is your first post.]]></content:encoded>
+		<wp:post_id>1</wp:post_id>
+		<wp:post_date_gmt>2019-03-01 02:00:00</wp:post_date_gmt>
+		<wp:post_modified_gmt>2019-03-02 02:00:00</wp:post_modified_gmt>
+		<wp:post_name>hello-world</wp:post_name>
+		<wp:status>publish</wp:status>
+		<wp:post_type>post</wp:post_type>
+		<category domain="category" nicename="news"><![CDATA[News]]></category>
+		<category domain="post_tag" nicename="go"><![CDATA[Go]]></category>
+		<wp:comment>
+			<wp:comment_id>1</wp:comment_id>
+			<wp:comment_author>Visitor</wp:comment_author>
+			<wp:comment_date_gmt>2019-03-01 03:00:00</wp:comment_date_gmt>
+			<wp:comment_content>Nice post</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>0</wp:comment_parent>
+			<wp:comment_user_id>0</wp:comment_user_id>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>3</wp:comment_id>
+			<wp:comment_author>alice</wp:comment_author>
+			<wp:comment_date_gmt>2019-03-01 05:00:00</wp:comment_date_gmt>
+			<wp:comment_content>Thanks, Bob</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>2</wp:comment_parent>
+			<wp:comment_user_id>1</wp:comment_user_id>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>2</wp:comment_id>
+			<wp:comment_author>bob.smith</wp:comment_author>
+			<wp:comment_date_gmt>2019-03-01 04:00:00</wp:comment_date_gmt>
+			<wp:comment_content>Thanks!</wp:comment_content>
+			<wp:comment_approved>1</wp:comment_approved>
+			<wp:comment_parent>1</wp:comment_parent>
+			<wp:comment_user_id>2</wp:comment_user_id>
+		</wp:comment>
+		<wp:comment>
+			<wp:comment_id>4</wp:comment_id>
+			<wp:comment_author>Spammer</wp:comment_author>
+			<wp:comment_content>Buy now</wp:comment_content>
+			<wp:comment_approved>spam</wp:comment_approved>
+		</wp:comment>
+	</item>
+	<item>
+		<title>Work in progress</title>
+		<dc:creator>bob.smith</dc:creator>
+		<guid isPermaLink="false">https://blog.example.com/?p=5</guid>
+		<content:encoded><![CDATA[<!-- wp:paragraph --><p>Draft</p><!-- /wp:paragraph -->]]></content:encoded>
+		<wp:post_id>5</wp:post_id>
+		<wp:post_date>2019-04-01 09:30:00</wp:post_date>
+		<wp:post_date_gmt>0000-00-00 00:00:00</wp:post_date_gmt>
+		<wp:status>draft</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+	<item>
+		<title>Deleted</title>
+		<dc:creator>alice</dc:creator>
+		<guid isPermaLink="false">https://blog.example.com/?p=6</guid>
+		<content:encoded>gone</content:encoded>
+		<wp:status>trash</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+	<item>
+		<title>About</title>
+		<dc:creator>alice</dc:creator>
+		<guid isPermaLink="false">https://blog.example.com/?page_id=2</guid>
+		<content:encoded>About us</content:encoded>
+		<wp:status>publish</wp:status>
+		<wp:post_type>page</wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+var (
+	dbOnce     sync.Once
+	testDB     *gorm.DB
+	testAuthz  *authz.Authz
+	testModels = []any{
+		&model.UserM{},
+		&model.PostM{},
+		&model.PostTagM{},
+		&model.PostRevisionM{},
+		&model.PostSlugM{},
+		&model.CommentM{},
+		&model.ImportMappingM{},
+		// 以下为永久删除博文时清理的关联数据
+		&model.MediaM{},
+		&model.PostShareM{},
+		&model.PostCollaboratorM{},
+		&model.PostReactionM{},
+		&model.PostReactionCountM{},
+		&model.BookmarkM{},
+		&model.SeriesPostM{},
+		&model.PostViewM{},
+	}
+)
+
+// newTestImporter 返回基于 SQLite 内存数据库的导入器，数据库中只有管理员用户. 返回管理员的用户 ID.
+func newTestImporter(t *testing.T, opts wpimport.Options) (*wpimport.Importer, store.IStore, string) {
+	t.Helper()
+
+	dbOnce.Do(func() {
+		db, err := gorm.Open(sqlite.Open("file:wpimport?mode=memory&cache=shared"), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, db.AutoMigrate(testModels...))
+		testAuthz, err = authz.NewAuthz(db)
+		require.NoError(t, err)
+		testDB = db
+	})
+	for _, m := range testModels {
+		require.NoError(t, testDB.Unscoped().Where("1 = 1").Delete(m).Error)
+	}
+
+	searcher, err := search.NewBleveSearcher()
+	require.NoError(t, err)
+
+	// store.NewStore 返回全局唯一的实例，测试之间通过清空数据隔离
+	s := store.NewStore(testDB)
+	root := &model.UserM{Username: known.AdminUsername, Password: "miniblog1234", Phone: "18100000000"}
+	require.NoError(t, s.User().Create(context.Background(), root))
+
+	b := biz.NewBiz(s, testAuthz, searcher, render.NewRenderer(), nil, viewcount.NewCounter(s, clock.RealClock{}))
+	return wpimport.New(b, s, opts), s, root.UserID
+}
+
+func parse(t *testing.T) *wxr.Export {
+	t.Helper()
+
+	export, err := wxr.Parse(strings.NewReader(export))
+	require.NoError(t, err)
+	return export
+}
+
+func TestImport(t *testing.T) {
+	importer, s, rootID := newTestImporter(t, wpimport.Options{GuestUsername: known.AdminUsername})
+	ctx := contextx.WithUsername(context.Background(), known.AdminUsername)
+
+	report, err := importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	assert.Empty(t, report.Failures)
+	assert.Equal(t, wpimport.Counts{Total: 2, Created: 2}, report.Users)
+	assert.Equal(t, wpimport.Counts{Total: 3, Created: 2, Skipped: 1}, report.Posts)
+	assert.Equal(t, wpimport.Counts{Total: 4, Created: 3, Skipped: 1}, report.Comments)
+	require.Len(t, report.Unsupported, 1)
+	assert.Equal(t, "page", report.Unsupported[0].Kind)
+	require.Len(t, report.CreatedUsers, 2)
+	assert.Equal(t, "bob_smith", report.CreatedUsers[1].Username)
+
+	// 文章保留发布时间、修改时间、slug 和标签，草稿没有发布时间
+	_, userList, err := s.User().List(ctx, where.F("username", "alice"))
+	require.NoError(t, err)
+	require.Len(t, userList, 1)
+	assert.Equal(t, "Alice", userList[0].Nickname)
+
+	post, err := s.Post().Get(ctx, where.F("slug", "hello-world"))
+	require.NoError(t, err)
+	assert.Equal(t, userList[0].UserID, post.UserID)
+	assert.Equal(t, "Welcome to **WordPress**.\n\nThis is your first post.\n", post.Content)
+	assert.Equal(t, int32(apiv1.PostStatus_Published), post.Status)
+	assert.True(t, time.Date(2019, 3, 1, 2, 0, 0, 0, time.UTC).Equal(*post.PublishedAt))
+	assert.True(t, time.Date(2019, 3, 2, 2, 0, 0, 0, time.UTC).Equal(post.UpdatedAt))
+	_, tagList, err := s.PostTag().List(ctx, where.F("postID", post.PostID))
+	require.NoError(t, err)
+	assert.Len(t, tagList, 2)
+
+	draft, err := s.Post().Get(ctx, where.F("title", "Work in progress"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(apiv1.PostStatus_Draft), draft.Status)
+	assert.Nil(t, draft.PublishedAt)
+
+	// 访客评论以管理员身份导入，多级回复挂到顶级评论下，并保留评论时间
+	_, commentList, err := s.Comment().List(ctx, where.F("postID", post.PostID))
+	require.NoError(t, err)
+	require.Len(t, commentList, 3)
+	comments := make(map[string]*model.CommentM)
+	for _, commentM := range commentList {
+		comments[commentM.Content] = commentM
+	}
+	top := comments["Visitor: Nice post"]
+	require.NotNil(t, top)
+	assert.Equal(t, rootID, top.UserID)
+	assert.Empty(t, top.ParentID)
+	assert.True(t, time.Date(2019, 3, 1, 3, 0, 0, 0, time.UTC).Equal(top.CreatedAt))
+	assert.Equal(t, top.CommentID, comments["Thanks!"].ParentID)
+	assert.Equal(t, top.CommentID, comments["Thanks, Bob"].ParentID)
+	assert.Equal(t, userList[0].UserID, comments["Thanks, Bob"].UserID)
+
+	// 重复导入时跳过已经导入过的数据
+	report, err = importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	assert.Equal(t, wpimport.Counts{Total: 2, Existing: 2}, report.Users)
+	assert.Equal(t, wpimport.Counts{Total: 3, Existing: 2, Skipped: 1}, report.Posts)
+	assert.Equal(t, wpimport.Counts{Total: 4, Existing: 3, Skipped: 1}, report.Comments)
+	count, _, err := s.Post().List(ctx, where.NewWhere())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestImportAfterPurge(t *testing.T) {
+	importer, s, _ := newTestImporter(t, wpimport.Options{GuestUsername: known.AdminUsername})
+	ctx := contextx.WithUsername(context.Background(), known.AdminUsername)
+
+	_, err := importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	post, err := s.Post().Get(ctx, where.F("slug", "hello-world"))
+	require.NoError(t, err)
+
+	// 回收站中的文章不会被重新导入，也不会导入其评论
+	require.NoError(t, s.Post().Delete(ctx, where.F("postID", post.PostID)))
+	report, err := importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	assert.Empty(t, report.Failures)
+	assert.Equal(t, wpimport.Counts{Total: 3, Existing: 1, Skipped: 2}, report.Posts)
+	assert.Equal(t, wpimport.Counts{Total: 4, Skipped: 4}, report.Comments)
+
+	// 文章被永久删除后重新导入文章及其评论
+	purger := worker.NewPostPurger(s, nil, clocktesting.NewFakeClock(time.Now().Add(time.Hour)), time.Minute)
+	purged, err := purger.PurgeExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), purged)
+
+	report, err = importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	assert.Empty(t, report.Failures)
+	assert.Equal(t, wpimport.Counts{Total: 3, Created: 1, Existing: 1, Skipped: 1}, report.Posts)
+	assert.Equal(t, wpimport.Counts{Total: 4, Created: 3, Skipped: 1}, report.Comments)
+
+	reimported, err := s.Post().Get(ctx, where.F("slug", "hello-world"))
+	require.NoError(t, err)
+	assert.NotEqual(t, post.PostID, reimported.PostID)
+	count, _, err := s.Comment().List(ctx, where.F("postID", reimported.PostID))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// 之后重复导入时跳过重新导入的数据
+	report, err = importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	assert.Equal(t, wpimport.Counts{Total: 3, Existing: 2, Skipped: 1}, report.Posts)
+	assert.Equal(t, wpimport.Counts{Total: 4, Existing: 3, Skipped: 1}, report.Comments)
+}
+
+func TestImportAuthors(t *testing.T) {
+	importer, _, _ := newTestImporter(t, wpimport.Options{Authors: map[string]string{"alice": known.AdminUsername, "bob.smith": "nobody"}})
+	ctx := contextx.WithUsername(context.Background(), known.AdminUsername)
+
+	report, err := importer.Import(ctx, parse(t))
+	require.NoError(t, err)
+	assert.Equal(t, wpimport.Counts{Total: 2, Matched: 1, Failed: 1}, report.Users)
+	assert.Equal(t, wpimport.Counts{Total: 3, Created: 1, Skipped: 1, Failed: 1}, report.Posts)
+	// 没有访客用户时不导入访客评论，访客评论的回复挂到顶级评论下
+	assert.Equal(t, wpimport.Counts{Total: 4, Created: 1, Skipped: 2, Failed: 1}, report.Comments)
+	assert.Equal(t, 3, report.Failed())
+	assert.Empty(t, report.CreatedUsers)
+}
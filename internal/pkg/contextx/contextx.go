@@ -28,6 +28,10 @@ type (
 	accessTokenKey struct{}
 	// requestIDKey 定义请求 ID 的上下文键.
 	requestIDKey struct{}
+	// clientIPKey 定义客户端 IP 的上下文键.
+	clientIPKey struct{}
+	// ifMatchKey 定义 If-Match 请求头的上下文键.
+	ifMatchKey struct{}
 )
 
 // WithUserID 将用户 ID 存放到上下文中.
@@ -73,3 +77,25 @@ func RequestID(ctx context.Context) string {
 	requestID, _ := ctx.Value(requestIDKey{}).(string)
 	return requestID
 }
+
+// WithClientIP 将客户端 IP 存放到上下文中.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// ClientIP 从上下文中提取客户端 IP.
+func ClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPKey{}).(string)
+	return clientIP
+}
+
+// WithIfMatch 将 If-Match 请求头存放到上下文中.
+func WithIfMatch(ctx context.Context, ifMatch string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, ifMatch)
+}
+
+// IfMatch 从上下文中提取 If-Match 请求头.
+func IfMatch(ctx context.Context) string {
+	ifMatch, _ := ctx.Value(ifMatchKey{}).(string)
+	return ifMatch
+}
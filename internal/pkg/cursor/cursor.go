@@ -0,0 +1,82 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cursor 用于在基于游标的分页中编码和解析不透明的分页游标.
+// 游标记录上一页最后一条记录的排序键（自增 ID，或排序时间和自增 ID），下一页从排在该记录之后的记录开始查询，
+// 因此翻页过程中插入或删除记录不会导致结果重复或遗漏.
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalid 表示游标不是由 Encode 生成的合法游标.
+var ErrInvalid = errors.New("invalid cursor")
+
+// Encode 将记录 ID 编码为游标.
+func Encode(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// Decode 解析游标，返回游标中记录的 ID. 游标为空时返回 0，表示从第一页开始查询.
+func Decode(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, ErrInvalid
+	}
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalid
+	}
+	return id, nil
+}
+
+// EncodeAt 将记录的排序时间和 ID 编码为游标，用于按时间倒序排列的列表. 时间相同的记录按 ID 区分先后.
+func EncodeAt(at time.Time, id int64) string {
+	raw := strconv.FormatInt(at.UnixNano(), 10) + "." + strconv.FormatInt(id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeAt 解析 EncodeAt 生成的游标，返回游标中记录的时间和 ID. 游标为空时返回零值，表示从第一页开始查询.
+func DecodeAt(s string) (time.Time, int64, error) {
+	if s == "" {
+		return time.Time{}, 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalid
+	}
+	nanos, rawID, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return time.Time{}, 0, ErrInvalid
+	}
+	at, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalid
+	}
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id <= 0 {
+		return time.Time{}, 0, ErrInvalid
+	}
+	return time.Unix(0, at), id, nil
+}
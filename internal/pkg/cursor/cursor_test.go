@@ -0,0 +1,63 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cursor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/pkg/cursor"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	for _, id := range []int64{1, 42, 1 << 40} {
+		got, err := cursor.Decode(cursor.Encode(id))
+		require.NoError(t, err)
+		assert.Equal(t, id, got)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	id, err := cursor.Decode("")
+	require.NoError(t, err)
+	assert.Zero(t, id)
+
+	for _, s := range []string{"!!!", cursor.Encode(0), cursor.Encode(-1), "YWJj"} {
+		_, err := cursor.Decode(s)
+		assert.ErrorIs(t, err, cursor.ErrInvalid, s)
+	}
+}
+
+func TestEncodeDecodeAt(t *testing.T) {
+	at := time.Date(2024, 5, 1, 8, 30, 0, 123456789, time.UTC)
+	gotAt, gotID, err := cursor.DecodeAt(cursor.EncodeAt(at, 42))
+	require.NoError(t, err)
+	assert.True(t, at.Equal(gotAt))
+	assert.EqualValues(t, 42, gotID)
+
+	gotAt, gotID, err = cursor.DecodeAt("")
+	require.NoError(t, err)
+	assert.True(t, gotAt.IsZero())
+	assert.Zero(t, gotID)
+
+	// ID 游标和时间游标不能混用
+	for _, s := range []string{"!!!", cursor.Encode(42), cursor.EncodeAt(at, 0)} {
+		_, _, err := cursor.DecodeAt(s)
+		assert.ErrorIs(t, err, cursor.ErrInvalid, s)
+	}
+}
@@ -38,6 +38,8 @@ var (
 	ErrPermissionDenied = errorsx.ErrPermissionDenied
 	// ErrOperationFailed 表示操作失败.
 	ErrOperationFailed = errorsx.ErrOperationFailed
+	// ErrVersionConflict 表示资源已被其他请求修改，请求中的版本号已过期.
+	ErrVersionConflict = &errorsx.ErrorX{Code: http.StatusConflict, Reason: "Aborted.VersionConflict", Message: "The resource has been modified by another request. Please reload it and try again."}
 	// ErrPageNotFound 表示页面未找到.
 	ErrPageNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PageNotFound", Message: "Page not found."}
 	// ErrSignToken 表示签发 JWT Token 时出错.
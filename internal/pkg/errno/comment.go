@@ -0,0 +1,33 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+var (
+	// ErrCommentNotFound 表示未找到指定的评论.
+	ErrCommentNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.CommentNotFound", Message: "Comment not found."}
+
+	// ErrCommentReplyDepth 表示回复的目标不是顶级评论，评论只支持一层回复.
+	ErrCommentReplyDepth = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.CommentReplyDepth",
+		Message: "Replies can only be made to top-level comments.",
+	}
+)
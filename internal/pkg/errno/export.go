@@ -0,0 +1,40 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+var (
+	// ErrExportJobNotFound 表示未找到指定的导出任务.
+	ErrExportJobNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.ExportJobNotFound", Message: "Export job not found."}
+
+	// ErrExportJobNotReady 表示导出任务尚未完成，导出文件还不能下载.
+	ErrExportJobNotReady = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.ExportJobNotReady",
+		Message: "Export job has not succeeded yet.",
+	}
+
+	// ErrExportTooLarge 表示用户的博文过多，不能直接导出，需要创建后台导出任务.
+	ErrExportTooLarge = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.ExportTooLarge",
+		Message: "Too many posts to export directly, create an export job instead.",
+	}
+)
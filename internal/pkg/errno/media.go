@@ -0,0 +1,40 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+var (
+	// ErrMediaNotFound 表示未找到指定的媒体文件.
+	ErrMediaNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.MediaNotFound", Message: "Media not found."}
+
+	// ErrMediaTooLarge 表示上传的媒体文件超过了大小限制.
+	ErrMediaTooLarge = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.MediaTooLarge",
+		Message: "Media file is too large.",
+	}
+
+	// ErrMediaTypeNotAllowed 表示上传的媒体文件类型不在允许的范围内.
+	ErrMediaTypeNotAllowed = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.MediaTypeNotAllowed",
+		Message: "Media type is not allowed.",
+	}
+)
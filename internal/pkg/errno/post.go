@@ -20,5 +20,35 @@ import (
 	"github.com/onexstack/onexstack/pkg/errorsx"
 )
 
-// ErrPostNotFound 表示未找到指定的博客.
-var ErrPostNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostNotFound", Message: "Post not found."}
+var (
+	// ErrPostNotFound 表示未找到指定的博客.
+	ErrPostNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostNotFound", Message: "Post not found."}
+
+	// ErrPostPermissionDenied 表示当前用户可以查看博客，但没有权限执行请求的操作，例如只读协作者修改博客.
+	ErrPostPermissionDenied = &errorsx.ErrorX{
+		Code:    http.StatusForbidden,
+		Reason:  "PermissionDenied.PostPermissionDenied",
+		Message: "You do not have permission to perform this operation on the post.",
+	}
+
+	// ErrPostStatusTransition 表示博客当前状态不允许执行请求的状态变更.
+	ErrPostStatusTransition = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.PostStatusTransition",
+		Message: "The post status does not allow this operation.",
+	}
+
+	// ErrPostRevisionNotFound 表示未找到指定的博客历史版本.
+	ErrPostRevisionNotFound = &errorsx.ErrorX{
+		Code:    http.StatusNotFound,
+		Reason:  "NotFound.PostRevisionNotFound",
+		Message: "Post revision not found.",
+	}
+
+	// ErrPostSlugAlreadyExists 表示指定的 slug 已被其它博客使用.
+	ErrPostSlugAlreadyExists = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "AlreadyExist.PostSlugAlreadyExists",
+		Message: "Post slug already exists.",
+	}
+)
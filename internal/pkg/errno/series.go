@@ -0,0 +1,33 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+var (
+	// ErrSeriesNotFound 表示未找到指定的系列.
+	ErrSeriesNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.SeriesNotFound", Message: "Series not found."}
+
+	// ErrPostInAnotherSeries 表示博文已属于其他系列，一篇博文最多属于一个系列.
+	ErrPostInAnotherSeries = &errorsx.ErrorX{
+		Code:    http.StatusBadRequest,
+		Reason:  "InvalidArgument.PostInAnotherSeries",
+		Message: "Post already belongs to another series.",
+	}
+)
@@ -0,0 +1,76 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package etag 实现资源版本号与 HTTP ETag 之间的转换，用于检测并发修改.
+package etag
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+)
+
+// Any 是 If-Match 请求头中匹配资源任意版本的值.
+const Any = "*"
+
+// ErrInvalid 表示 ETag 格式无效.
+var ErrInvalid = errors.New("invalid etag")
+
+// Format 将资源版本号格式化为强 ETag，例如 "3".
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// Parse 解析 If-Match 请求头，返回其中的资源版本号. 请求头为 * 时返回 0，表示匹配资源的任意版本.
+// 只支持单个由 Format 生成的强 ETag，为了方便调用，也接受不带引号的版本号.
+func Parse(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == Any {
+		return 0, nil
+	}
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, ErrInvalid
+	}
+	return version, nil
+}
+
+// Expected 返回请求期望的资源版本号. 请求消息中的版本号优先于上下文中的 If-Match 请求头，
+// 返回 0 表示 If-Match 为 *，不需要检查版本号. 两者都没有指定时返回 errno.ErrInvalidArgument.
+func Expected(ctx context.Context, version *int64) (int64, error) {
+	if version != nil {
+		if *version <= 0 {
+			return 0, errno.ErrInvalidArgument.WithMessage("version must be greater than 0")
+		}
+		return *version, nil
+	}
+
+	ifMatch := contextx.IfMatch(ctx)
+	if ifMatch == "" {
+		return 0, errno.ErrInvalidArgument.WithMessage("version or If-Match header is required")
+	}
+	expected, err := Parse(ifMatch)
+	if err != nil {
+		return 0, errno.ErrInvalidArgument.WithMessage("invalid If-Match header")
+	}
+	return expected, nil
+}
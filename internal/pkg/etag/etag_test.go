@@ -0,0 +1,100 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/etag"
+)
+
+func TestFormatParse(t *testing.T) {
+	for _, version := range []int64{1, 42, 1 << 40} {
+		got, err := etag.Parse(etag.Format(version))
+		require.NoError(t, err)
+		assert.Equal(t, version, got)
+	}
+	assert.Equal(t, `"3"`, etag.Format(3))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: `"7"`, want: 7},
+		{value: " \"7\" ", want: 7},
+		{value: "7", want: 7},
+		{value: "*", want: 0},
+		{value: `W/"7"`, wantErr: true},
+		{value: `"7", "8"`, wantErr: true},
+		{value: `"0"`, wantErr: true},
+		{value: `"-1"`, wantErr: true},
+		{value: `"abc"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := etag.Parse(tt.value)
+		if tt.wantErr {
+			assert.ErrorIs(t, err, etag.ErrInvalid, tt.value)
+			continue
+		}
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+}
+
+func TestExpected(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		version *int64
+		want    int64
+		wantErr bool
+	}{
+		{name: "version", version: ptr.To(int64(3)), want: 3},
+		{name: "version takes precedence over If-Match", ifMatch: `"5"`, version: ptr.To(int64(3)), want: 3},
+		{name: "If-Match", ifMatch: `"5"`, want: 5},
+		{name: "If-Match matches any version", ifMatch: "*", want: 0},
+		{name: "missing", wantErr: true},
+		{name: "invalid version", version: ptr.To(int64(0)), wantErr: true},
+		{name: "invalid If-Match", ifMatch: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ifMatch != "" {
+				ctx = contextx.WithIfMatch(ctx, tt.ifMatch)
+			}
+
+			got, err := etag.Expected(ctx, tt.version)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errno.ErrInvalidArgument)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
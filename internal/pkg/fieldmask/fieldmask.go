@@ -0,0 +1,74 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fieldmask 实现基于 google.protobuf.FieldMask 的部分更新.
+package fieldmask
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+)
+
+// Mask 是 FieldMask 中字段路径的集合，路径统一为 proto 文件中定义的 lowerCamelCase 字段名.
+type Mask map[string]struct{}
+
+// New 返回 FieldMask 中的字段路径. grpc-gateway 解析 JSON 请求时会将路径转换为 snake_case 格式，
+// 例如 contentFormat 会变为 content_format，因此需要还原为 proto 文件中定义的字段名.
+func New(fm *fieldmaskpb.FieldMask) Mask {
+	m := make(Mask, len(fm.GetPaths()))
+	for _, path := range fm.GetPaths() {
+		m[normalize(path)] = struct{}{}
+	}
+	return m
+}
+
+// Validate 校验 FieldMask 中的字段路径都是 allowed 中允许更新的字段.
+func Validate(fm *fieldmaskpb.FieldMask, allowed ...string) error {
+	for _, path := range fm.GetPaths() {
+		if !slices.Contains(allowed, normalize(path)) {
+			return errno.ErrInvalidArgument.WithMessage("invalid update mask path %q, allowed paths are %s", path, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+// Has 判断 FieldMask 中是否列出了 path.
+func (m Mask) Has(path string) bool {
+	_, ok := m[path]
+	return ok
+}
+
+// Covers 判断是否需要更新 path 对应的字段，set 表示请求中是否设置了该字段.
+// 未指定 FieldMask 时按字段是否被设置判断；指定 FieldMask 时只更新其中列出的字段，列出但未设置的字段会被清空.
+func (m Mask) Covers(path string, set bool) bool {
+	if len(m) == 0 {
+		return set
+	}
+	return m.Has(path)
+}
+
+// normalize 将 snake_case 格式的字段路径转换为 lowerCamelCase 格式.
+func normalize(path string) string {
+	parts := strings.Split(path, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
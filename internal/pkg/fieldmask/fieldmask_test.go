@@ -0,0 +1,81 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldmask_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/jwcen/miniblog/internal/pkg/errno"
+	"github.com/jwcen/miniblog/internal/pkg/fieldmask"
+)
+
+func TestNew(t *testing.T) {
+	m := fieldmask.New(&fieldmaskpb.FieldMask{Paths: []string{"title", "content_format", "sharedWith"}})
+	assert.Len(t, m, 3)
+	for _, path := range []string{"title", "contentFormat", "sharedWith"} {
+		assert.True(t, m.Has(path), path)
+	}
+	assert.False(t, m.Has("content_format"))
+
+	assert.Empty(t, fieldmask.New(nil))
+}
+
+func TestValidate(t *testing.T) {
+	allowed := []string{"title", "contentFormat"}
+	tests := []struct {
+		paths   []string
+		wantErr bool
+	}{
+		{paths: nil},
+		{paths: []string{"title"}},
+		{paths: []string{"contentFormat", "content_format"}},
+		{paths: []string{"postID"}, wantErr: true},
+		{paths: []string{"title", "tags"}, wantErr: true},
+		{paths: []string{""}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := fieldmask.Validate(&fieldmaskpb.FieldMask{Paths: tt.paths}, allowed...)
+		if tt.wantErr {
+			assert.ErrorIs(t, err, errno.ErrInvalidArgument, tt.paths)
+			continue
+		}
+		assert.NoError(t, err, tt.paths)
+	}
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		set   bool
+		want  bool
+	}{
+		{name: "no mask and set", set: true, want: true},
+		{name: "no mask and unset", set: false, want: false},
+		{name: "listed and set", paths: []string{"title"}, set: true, want: true},
+		{name: "listed and unset", paths: []string{"title"}, set: false, want: true},
+		{name: "not listed and set", paths: []string{"content"}, set: true, want: false},
+		{name: "not listed and unset", paths: []string{"content"}, set: false, want: false},
+	}
+
+	for _, tt := range tests {
+		m := fieldmask.New(&fieldmaskpb.FieldMask{Paths: tt.paths})
+		assert.Equal(t, tt.want, m.Covers("title", tt.set), tt.name)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package frontmatter 解析 Hugo、Jekyll 等静态博客生成器使用的、带 YAML front matter 的 Markdown 文件.
+package frontmatter
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrMissing 表示文件不是以 YAML front matter 开头.
+var ErrMissing = errors.New("missing YAML front matter")
+
+// dateLayouts 定义 front matter 中支持的日期格式. 未指定时区的日期按 UTC 解析.
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Document 是解析后的 Markdown 文件.
+type Document struct {
+	// Title 是文章标题.
+	Title string
+	// Date 是文章的发布时间，未指定时为零值.
+	Date time.Time
+	// Lastmod 是文章的最后修改时间，未指定时为零值.
+	Lastmod time.Time
+	// Tags 是文章标签.
+	Tags []string
+	// Draft 表示文章是否为草稿.
+	Draft bool
+	// Slug 是文章在 URL 中使用的可读标识，未指定时为空.
+	Slug string
+	// Body 是去掉 front matter 之后的 Markdown 正文.
+	Body string
+}
+
+// matter 对应 front matter 中支持的字段.
+type matter struct {
+	Title   string     `yaml:"title"`
+	Date    string     `yaml:"date,omitempty"`
+	Lastmod string     `yaml:"lastmod,omitempty"`
+	Tags    stringList `yaml:"tags,omitempty"`
+	Draft   bool       `yaml:"draft,omitempty"`
+	// Published 是 Jekyll 中表示文章是否发布的字段，值为 false 时视为草稿
+	Published *bool  `yaml:"published,omitempty"`
+	Slug      string `yaml:"slug,omitempty"`
+}
+
+// stringList 兼容列表形式和字符串形式的标签. 字符串形式的标签使用逗号或空白分隔.
+type stringList []string
+
+// UnmarshalYAML 实现 yaml.Unmarshaler 接口.
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		sep := func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }
+		if strings.Contains(value.Value, ",") {
+			sep = func(r rune) bool { return r == ',' }
+		}
+		for _, item := range strings.FieldsFunc(value.Value, sep) {
+			if item = strings.TrimSpace(item); item != "" {
+				*l = append(*l, item)
+			}
+		}
+		return nil
+	}
+
+	var items []string
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+// Parse 解析带 YAML front matter 的 Markdown 文件. front matter 必须位于文件开头，并由 "---" 包围.
+func Parse(content string) (*Document, error) {
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return nil, ErrMissing
+	}
+
+	rest := content[len("---\n"):]
+	var header, body string
+	switch {
+	case strings.HasPrefix(rest, "---\n") || rest == "---":
+		body = strings.TrimPrefix(rest, "---")
+	default:
+		end := strings.Index(rest, "\n---\n")
+		if end < 0 {
+			if !strings.HasSuffix(rest, "\n---") {
+				return nil, errors.New("unterminated YAML front matter")
+			}
+			end = len(rest) - len("\n---")
+		}
+		header, body = rest[:end], rest[min(end+len("\n---\n"), len(rest)):]
+	}
+
+	var m matter
+	if err := yaml.Unmarshal([]byte(header), &m); err != nil {
+		return nil, fmt.Errorf("invalid YAML front matter: %w", err)
+	}
+
+	doc := &Document{
+		Title: strings.TrimSpace(m.Title),
+		Tags:  m.Tags,
+		Draft: m.Draft || (m.Published != nil && !*m.Published),
+		Slug:  strings.TrimSpace(m.Slug),
+		Body:  strings.TrimLeft(body, "\n"),
+	}
+
+	var err error
+	if doc.Date, err = parseDate(m.Date); err != nil {
+		return nil, fmt.Errorf("invalid date: %w", err)
+	}
+	if doc.Lastmod, err = parseDate(m.Lastmod); err != nil {
+		return nil, fmt.Errorf("invalid lastmod: %w", err)
+	}
+	return doc, nil
+}
+
+// Marshal 将文章序列化为带 YAML front matter 的 Markdown 文件，生成的内容可以由 Parse 还原.
+// 时间使用 RFC 3339 格式，零值时间、空标签和空 slug 不会写入 front matter.
+func Marshal(doc *Document) ([]byte, error) {
+	m := matter{
+		Title: doc.Title,
+		Tags:  doc.Tags,
+		Draft: doc.Draft,
+		Slug:  doc.Slug,
+	}
+	if !doc.Date.IsZero() {
+		m.Date = doc.Date.Format(time.RFC3339)
+	}
+	if !doc.Lastmod.IsZero() {
+		m.Lastmod = doc.Lastmod.Format(time.RFC3339)
+	}
+
+	header, err := yaml.Marshal(&m)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	b.Write(header)
+	b.WriteString("---\n\n")
+	b.WriteString(doc.Body)
+	return []byte(b.String()), nil
+}
+
+// FilenameDate 从 Jekyll 风格的文件名（例如 2019-03-01-hello-world.md）中解析文章日期.
+// 文件名中不包含日期时返回零值.
+func FilenameDate(filename string) time.Time {
+	base := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	if len(base) < len("2006-01-02-") || base[len("2006-01-02")] != '-' {
+		return time.Time{}
+	}
+	date, err := time.Parse("2006-01-02", base[:len("2006-01-02")])
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+// parseDate 按 dateLayouts 中的格式依次尝试解析日期，值为空时返回零值.
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported date format %q", value)
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontmatter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/pkg/frontmatter"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *frontmatter.Document
+	}{
+		{
+			name: "hugo",
+			content: "---\ntitle: \"Hello, World\"\ndate: 2019-03-01T10:00:00+08:00\nlastmod: 2019-03-02\n" +
+				"tags: [go, blog]\ndraft: true\nslug: hello-world\n---\n\n# Hello\n",
+			want: &frontmatter.Document{
+				Title:   "Hello, World",
+				Date:    time.Date(2019, 3, 1, 2, 0, 0, 0, time.UTC),
+				Lastmod: time.Date(2019, 3, 2, 0, 0, 0, 0, time.UTC),
+				Tags:    []string{"go", "blog"},
+				Draft:   true,
+				Slug:    "hello-world",
+				Body:    "# Hello\n",
+			},
+		},
+		{
+			name:    "jekyll",
+			content: "---\r\nlayout: post\r\ntitle: Jekyll\r\ndate: 2019-03-01 10:00:00 +0800\r\ntags: go blog\r\npublished: false\r\n---\r\nbody\r\n",
+			want: &frontmatter.Document{
+				Title: "Jekyll",
+				Date:  time.Date(2019, 3, 1, 2, 0, 0, 0, time.UTC),
+				Tags:  []string{"go", "blog"},
+				Draft: true,
+				Body:  "body\n",
+			},
+		},
+		{
+			name:    "comma separated tags",
+			content: "---\ntitle: t\ntags: go, web dev\n---\nbody",
+			want:    &frontmatter.Document{Title: "t", Tags: []string{"go", "web dev"}, Body: "body"},
+		},
+		{
+			name:    "no body",
+			content: "---\ntitle: t\n---",
+			want:    &frontmatter.Document{Title: "t"},
+		},
+		{
+			name:    "empty front matter",
+			content: "---\n---\nbody",
+			want:    &frontmatter.Document{Body: "body"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := frontmatter.Parse(tt.content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Title, got.Title)
+			assert.True(t, tt.want.Date.Equal(got.Date), "date: %v", got.Date)
+			assert.True(t, tt.want.Lastmod.Equal(got.Lastmod), "lastmod: %v", got.Lastmod)
+			assert.Equal(t, tt.want.Tags, got.Tags)
+			assert.Equal(t, tt.want.Draft, got.Draft)
+			assert.Equal(t, tt.want.Slug, got.Slug)
+			assert.Equal(t, tt.want.Body, got.Body)
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := frontmatter.Parse("# no front matter")
+	assert.ErrorIs(t, err, frontmatter.ErrMissing)
+
+	for _, content := range []string{
+		"---\ntitle: t\nbody",
+		"---\ntitle: [\n---\nbody",
+		"---\ndate: yesterday\n---\nbody",
+	} {
+		_, err := frontmatter.Parse(content)
+		assert.Error(t, err, content)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	doc := &frontmatter.Document{
+		Title:   "Hello: World",
+		Date:    time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC),
+		Lastmod: time.Date(2019, 3, 2, 8, 30, 0, 0, time.UTC),
+		Tags:    []string{"go", "web dev"},
+		Draft:   true,
+		Slug:    "hello-world",
+		Body:    "# Hello\n\n---\n",
+	}
+
+	data, err := frontmatter.Marshal(doc)
+	require.NoError(t, err)
+
+	got, err := frontmatter.Parse(string(data))
+	require.NoError(t, err)
+	assert.Equal(t, doc.Title, got.Title)
+	assert.True(t, doc.Date.Equal(got.Date), "date: %v", got.Date)
+	assert.True(t, doc.Lastmod.Equal(got.Lastmod), "lastmod: %v", got.Lastmod)
+	assert.Equal(t, doc.Tags, got.Tags)
+	assert.Equal(t, doc.Draft, got.Draft)
+	assert.Equal(t, doc.Slug, got.Slug)
+	assert.Equal(t, doc.Body, got.Body)
+
+	// 零值字段不写入 front matter
+	data, err = frontmatter.Marshal(&frontmatter.Document{Title: "t", Body: "body"})
+	require.NoError(t, err)
+	assert.Equal(t, "---\ntitle: t\n---\n\nbody", string(data))
+}
+
+func TestFilenameDate(t *testing.T) {
+	assert.Equal(t, time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), frontmatter.FilenameDate("_posts/2019-03-01-hello.md"))
+	assert.True(t, frontmatter.FilenameDate("hello.md").IsZero())
+	assert.True(t, frontmatter.FilenameDate("2019-13-01-hello.md").IsZero())
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hll_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/pkg/hll"
+)
+
+func TestEstimate(t *testing.T) {
+	assert.Zero(t, hll.New().Estimate())
+
+	for _, n := range []int{10, 1000, 100000} {
+		s := hll.New()
+		for i := 0; i < n; i++ {
+			s.Insert("visitor-" + strconv.Itoa(i))
+			// 重复的元素不影响估算值
+			s.Insert("visitor-" + strconv.Itoa(i))
+		}
+		assert.InEpsilon(t, n, s.Estimate(), 0.1, "n=%d", n)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a, b := hll.New(), hll.New()
+	for i := 0; i < 3000; i++ {
+		a.Insert(strconv.Itoa(i))
+	}
+	for i := 2000; i < 5000; i++ {
+		b.Insert(strconv.Itoa(i))
+	}
+
+	a.Merge(b)
+	assert.InEpsilon(t, 5000, a.Estimate(), 0.1)
+}
+
+func TestBytes(t *testing.T) {
+	s := hll.New()
+	for i := 0; i < 500; i++ {
+		s.Insert(strconv.Itoa(i))
+	}
+
+	got, err := hll.FromBytes(s.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, s.Estimate(), got.Estimate())
+
+	empty, err := hll.FromBytes(nil)
+	require.NoError(t, err)
+	assert.Zero(t, empty.Estimate())
+
+	_, err = hll.FromBytes([]byte("abc"))
+	assert.ErrorIs(t, err, hll.ErrInvalid)
+}
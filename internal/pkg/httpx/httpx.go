@@ -0,0 +1,43 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"net/http"
+	"strings"
+)
+
+// BaseURL 根据请求推断站点的根地址. 请求来自本机或可信代理时，优先使用反向代理设置的
+// X-Forwarded-Proto 和 X-Forwarded-Host 头，否则使用请求的 Host 和连接是否启用 TLS，
+// 避免客户端伪造站点地址后，订阅源和站点地图中的链接被共享缓存保存并返回给其他用户.
+func BaseURL(r *http.Request, proxies TrustedProxies) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	host := r.Host
+	if !proxies.Trusts(r.RemoteAddr) {
+		return scheme + "://" + host
+	}
+
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+
+	return scheme + "://" + host
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseURL(t *testing.T) {
+	proxies, err := ParseTrustedProxies("10.0.0.0/8")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		tls        bool
+		proto      string
+		host       string
+		want       string
+	}{
+		{name: "direct request", remoteAddr: "203.0.113.7:5000", want: "http://miniblog.com"},
+		{name: "direct TLS request", remoteAddr: "203.0.113.7:5000", tls: true, want: "https://miniblog.com"},
+		{name: "forged headers from client", remoteAddr: "203.0.113.7:5000", proto: "https", host: "evil.com", want: "http://miniblog.com"},
+		{name: "headers from trusted proxy", remoteAddr: "10.0.0.2:5000", proto: "https", host: "blog.miniblog.com, evil.com", want: "https://blog.miniblog.com"},
+		{name: "headers from loopback", remoteAddr: "127.0.0.1:5000", proto: "https", want: "https://miniblog.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/feeds/rss.xml", nil)
+			r.Host = "miniblog.com"
+			r.RemoteAddr = tt.remoteAddr
+			if tt.tls {
+				r.TLS = &tls.ConnectionState{}
+			}
+			if tt.proto != "" {
+				r.Header.Set("X-Forwarded-Proto", tt.proto)
+			}
+			if tt.host != "" {
+				r.Header.Set("X-Forwarded-Host", tt.host)
+			}
+
+			assert.Equal(t, tt.want, BaseURL(r, proxies))
+		})
+	}
+}
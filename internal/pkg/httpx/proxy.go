@@ -0,0 +1,96 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpx
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// TrustedProxies 表示可信的反向代理地址. 本机地址始终可信，因为 grpc-gateway 与 gRPC 服务运行在同一进程中.
+// 只有来自可信代理的请求才使用 X-Forwarded-* 请求头，避免客户端伪造客户端 IP 和站点地址.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies 解析可信代理列表，每一项为 IP 地址或 CIDR.
+func ParseTrustedProxies(proxies ...string) (TrustedProxies, error) {
+	ret := make(TrustedProxies, 0, len(proxies))
+	for _, proxy := range proxies {
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			addr = addr.Unmap()
+			ret = append(ret, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: must be an IP address or CIDR", proxy)
+		}
+		ret = append(ret, prefix.Masked())
+	}
+	return ret, nil
+}
+
+// Trusts 判断 ip 是否为本机地址或可信代理. ip 可以带有端口.
+func (p TrustedProxies) Trusts(ip string) bool {
+	addr, err := netip.ParseAddr(hostOnly(ip))
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	if addr.IsLoopback() {
+		return true
+	}
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 根据连接的对端地址和 X-Forwarded-For 请求头确定客户端 IP.
+// 代理会将上一跳的地址追加到 X-Forwarded-For 末尾，而客户端可以任意设置最左侧的地址，
+// 因此从右向左跳过可信代理，返回第一个不可信的地址. 对端不是可信代理时直接返回对端地址.
+func (p TrustedProxies) ClientIP(remoteAddr string, forwardedFor ...string) string {
+	clientIP := hostOnly(remoteAddr)
+	if !p.Trusts(clientIP) {
+		return clientIP
+	}
+
+	var hops []string
+	for _, value := range forwardedFor {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		clientIP = hops[i]
+		if !p.Trusts(clientIP) {
+			break
+		}
+	}
+	return clientIP
+}
+
+// hostOnly 去掉地址中的端口.
+func hostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -31,6 +31,26 @@ const (
 
 	// XUsername 用来定义上下文的键，代表请求用户名.
 	XUsername = "x-username"
+
+	// XHTTPCode 用来定义 gRPC 响应头的键，grpc-gateway 会使用该值作为 HTTP 响应状态码.
+	XHTTPCode = "x-http-code"
+
+	// XHTTPLocation 用来定义 gRPC 响应头的键，grpc-gateway 会使用该值作为 HTTP 响应的 Location 头.
+	XHTTPLocation = "x-http-location"
+
+	// XHTTPContentDisposition 用来定义 gRPC 响应头的键，grpc-gateway 会使用该值作为 HTTP 响应的 Content-Disposition 头.
+	XHTTPContentDisposition = "x-http-content-disposition"
+
+	// XHTTPETag 用来定义 gRPC 响应头的键，grpc-gateway 会使用该值作为 HTTP 响应的 ETag 头.
+	XHTTPETag = "x-http-etag"
+
+	// XForwardedFor 用来定义请求头的键，代表经过代理转发的请求的客户端 IP 链.
+	// grpc-gateway 会将 HTTP 客户端的地址追加到该请求头中.
+	XForwardedFor = "x-forwarded-for"
+
+	// XIfMatch 用来定义请求头的键，代表客户端期望的资源版本（ETag）.
+	// grpc-gateway 会将 HTTP 请求的 If-Match 头以 grpcgateway-if-match 的键转发给 gRPC 服务.
+	XIfMatch = "if-match"
 )
 
 // 定义其他常量.
@@ -0,0 +1,23 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package known
+
+const (
+	// Reaction recorded when a user likes a post.
+	ReactionLike = "like"
+)
+
+// Reactions lists the emoji reactions that can be added to a post.
+var Reactions = []string{"heart", "laugh", "hooray", "confused", "rocket", "eyes"}
@@ -20,3 +20,10 @@ const (
 	// Role for administrators.
 	RoleAdmin = "role::admin"
 )
+
+const (
+	// Object for comment moderation policies.
+	CommentObject = "/v1/comments"
+	// Action that allows deleting any comment. Regular users are denied by policy.
+	ActionModerate = "MODERATE"
+)
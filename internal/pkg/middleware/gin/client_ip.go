@@ -0,0 +1,34 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+)
+
+// ClientIPMiddleware 将客户端 IP 保存到 context.Context 中，以便后续程序使用.
+// 只有来自本机或可信代理的请求才使用 X-Forwarded-For，规则与 gRPC 的 ClientIPInterceptor 相同.
+func ClientIPMiddleware(proxies httpx.TrustedProxies) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientIP := proxies.ClientIP(c.Request.RemoteAddr, c.Request.Header.Values("X-Forwarded-For")...)
+		ctx := contextx.WithClientIP(c.Request.Context(), clientIP)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
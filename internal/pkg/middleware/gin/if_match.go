@@ -0,0 +1,34 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+)
+
+// IfMatchMiddleware 将 If-Match 请求头保存到 context.Context 中，用于检测并发修改.
+func IfMatchMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if ifMatch := c.GetHeader(known.XIfMatch); ifMatch != "" {
+			ctx := contextx.WithIfMatch(c.Request.Context(), ifMatch)
+			c.Request = c.Request.WithContext(ctx)
+		}
+
+		c.Next()
+	}
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"github.com/jwcen/miniblog/internal/apiserver/model"
 	"github.com/jwcen/miniblog/internal/pkg/contextx"
 	"github.com/jwcen/miniblog/internal/pkg/errno"
@@ -20,31 +21,56 @@ type UserRetriever interface {
 // AuthnInterceptor 是一个 gRPC 拦截器，用于进行认证.
 func AuthnInterceptor(retriever UserRetriever) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		// 解析 JWT Token
-		userID, err := token.ParseRequest(ctx)
+		ctx, err := authenticate(ctx, retriever)
 		if err != nil {
-			log.Errorw("Failed to parse request", "err", err)
-			return nil, errno.ErrTokenInvalid.WithMessage(err.Error())
+			return nil, err
 		}
 
-		log.Debugw("Token parsing successful", "userID", userID)
+		// 继续处理请求
+		return handler(ctx, req)
+	}
+}
 
-		user, err := retriever.GetUser(ctx, userID)
+// AuthnStreamInterceptor 是一个 gRPC 流式拦截器，用于对流式请求进行认证.
+func AuthnStreamInterceptor(retriever UserRetriever) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), retriever)
 		if err != nil {
-			return nil, errno.ErrUnauthenticated.WithMessage(err.Error())
+			return err
 		}
 
-		// 将用户信息存入上下文
-		//nolint: staticcheck
-		ctx = context.WithValue(ctx, known.XUsername, user.Username)
-		//nolint: staticcheck
-		ctx = context.WithValue(ctx, known.XUserID, userID)
+		// 使用包含用户信息的上下文替换流的上下文
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
 
-		// 供 log 和 contextx 使用
-		ctx = contextx.WithUserID(ctx, user.UserID)
-		ctx = contextx.WithUsername(ctx, user.Username)
+// authenticate 解析请求中的 JWT Token，并将用户信息存入上下文.
+func authenticate(ctx context.Context, retriever UserRetriever) (context.Context, error) {
+	// 解析 JWT Token
+	userID, err := token.ParseRequest(ctx)
+	if err != nil {
+		log.Errorw("Failed to parse request", "err", err)
+		return nil, errno.ErrTokenInvalid.WithMessage(err.Error())
+	}
 
-		// 继续处理请求
-		return handler(ctx, req)
+	log.Debugw("Token parsing successful", "userID", userID)
+
+	user, err := retriever.GetUser(ctx, userID)
+	if err != nil {
+		return nil, errno.ErrUnauthenticated.WithMessage(err.Error())
 	}
+
+	// 将用户信息存入上下文
+	//nolint: staticcheck
+	ctx = context.WithValue(ctx, known.XUsername, user.Username)
+	//nolint: staticcheck
+	ctx = context.WithValue(ctx, known.XUserID, userID)
+
+	// 供 log 和 contextx 使用
+	ctx = contextx.WithUserID(ctx, user.UserID)
+	ctx = contextx.WithUsername(ctx, user.Username)
+
+	return ctx, nil
 }
@@ -18,25 +18,44 @@ type Authorizer interface {
 func AuthzInterceptor(authorizer Authorizer) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp any, err error) {
-
-		subject := contextx.UserID(ctx) // 获取用户ID
-		object := info.FullMethod       // 获取请求资源
-		action := "CALL"                // 默认操作
-
-		// 记录授权上下文信息
-		log.Debugw("Build authorize context", "subject", subject, "object", object, "action", action)
-
-		allowed, err := authorizer.Authorize(subject, object, action)
-		if err != nil || !allowed {
-			return nil, errno.ErrPermissionDenied.WithMessage(
-				"access denied: subject=%s, object=%s, action=%s, reason=%v",
-				subject,
-				object,
-				action,
-				err,
-			)
+		if err := authorize(ctx, authorizer, info.FullMethod); err != nil {
+			return nil, err
 		}
 
 		return handler(ctx, req)
 	}
 }
+
+// AuthzStreamInterceptor 是一个 gRPC 流式拦截器，用于对流式请求进行授权.
+func AuthzStreamInterceptor(authorizer Authorizer) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), authorizer, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// authorize 校验当前用户是否有权限调用指定的 gRPC 方法.
+func authorize(ctx context.Context, authorizer Authorizer, fullMethod string) error {
+	subject := contextx.UserID(ctx) // 获取用户ID
+	object := fullMethod            // 获取请求资源
+	action := "CALL"                // 默认操作
+
+	// 记录授权上下文信息
+	log.Debugw("Build authorize context", "subject", subject, "object", object, "action", action)
+
+	allowed, err := authorizer.Authorize(subject, object, action)
+	if err != nil || !allowed {
+		return errno.ErrPermissionDenied.WithMessage(
+			"access denied: subject=%s, object=%s, action=%s, reason=%v",
+			subject,
+			object,
+			action,
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+)
+
+// ClientIPInterceptor 是一个 gRPC 拦截器，用于将客户端 IP 保存到上下文中.
+// 只有连接的对端是本机（即同一进程中的 grpc-gateway）或可信代理时才使用 x-forwarded-for，
+// 并从右向左跳过可信代理，使用第一个不可信的地址，避免客户端伪造客户端 IP.
+func ClientIPInterceptor(proxies httpx.TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var remoteAddr string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			remoteAddr = p.Addr.String()
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		return handler(contextx.WithClientIP(ctx, proxies.ClientIP(remoteAddr, md[known.XForwardedFor]...)), req)
+	}
+}
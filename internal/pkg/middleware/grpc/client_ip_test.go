@@ -0,0 +1,87 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/httpx"
+)
+
+func TestClientIPInterceptor(t *testing.T) {
+	proxies, err := httpx.ParseTrustedProxies("10.0.0.0/8")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		// viaGateway 表示请求经过同一进程中的 grpc-gateway 转发，gRPC 连接的对端为本机地址
+		viaGateway bool
+		want       string
+	}{
+		{name: "gateway without header", remoteAddr: "203.0.113.7:5000", viaGateway: true, want: "203.0.113.7"},
+		{name: "gateway with forged header", remoteAddr: "203.0.113.7:5000", forwardedFor: "198.51.100.1", viaGateway: true, want: "203.0.113.7"},
+		{name: "gateway behind trusted proxy", remoteAddr: "10.0.0.2:5000", forwardedFor: "198.51.100.1, 203.0.113.7", viaGateway: true, want: "203.0.113.7"},
+		{name: "gateway behind trusted proxies", remoteAddr: "10.0.0.2:5000", forwardedFor: "203.0.113.7, 10.0.0.3", viaGateway: true, want: "203.0.113.7"},
+		{name: "direct client with forged header", remoteAddr: "203.0.113.7:5000", forwardedFor: "198.51.100.1", want: "203.0.113.7"},
+		{name: "direct trusted proxy", remoteAddr: "10.0.0.2:5000", forwardedFor: "203.0.113.7", want: "203.0.113.7"},
+	}
+
+	interceptor := ClientIPInterceptor(proxies)
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return contextx.ClientIP(ctx), nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			peerAddr := tt.remoteAddr
+			if tt.viaGateway {
+				// 使用 grpc-gateway 生成转发给 gRPC 服务的元数据
+				r := httptest.NewRequest("GET", "/v1/posts", nil)
+				r.RemoteAddr = tt.remoteAddr
+				if tt.forwardedFor != "" {
+					r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+				}
+				ctx, err := runtime.AnnotateContext(context.Background(), runtime.NewServeMux(), r, "/miniblog.v1.MiniBlog/ListPost")
+				require.NoError(t, err)
+				md, _ = metadata.FromOutgoingContext(ctx)
+				peerAddr = "127.0.0.1:6666"
+			} else if tt.forwardedFor != "" {
+				md.Set("x-forwarded-for", tt.forwardedFor)
+			}
+
+			addr, err := net.ResolveTCPAddr("tcp", peerAddr)
+			require.NoError(t, err)
+			ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), md), &peer.Peer{Addr: addr})
+
+			got, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/jwcen/miniblog/internal/pkg/contextx"
+	"github.com/jwcen/miniblog/internal/pkg/known"
+)
+
+// IfMatchInterceptor 是一个 gRPC 拦截器，用于将客户端期望的资源版本保存到上下文中.
+// 直接调用的请求使用 if-match 元数据，通过 grpc-gateway 转发的请求使用 HTTP 请求的 If-Match 头.
+func IfMatchInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, key := range []string{known.XIfMatch, runtime.MetadataPrefix + known.XIfMatch} {
+			if values := md[key]; len(values) > 0 {
+				ctx = contextx.WithIfMatch(ctx, values[0])
+				break
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -28,6 +28,14 @@ const (
 	UserID ResourceID = "user"
 	// PostID 定义博文资源标识符.
 	PostID ResourceID = "post"
+	// CommentID 定义评论资源标识符.
+	CommentID ResourceID = "comment"
+	// MediaID 定义媒体文件资源标识符.
+	MediaID ResourceID = "media"
+	// SeriesID 定义系列资源标识符.
+	SeriesID ResourceID = "series"
+	// ExportJobID 定义博文导出任务资源标识符.
+	ExportJobID ResourceID = "export"
 )
 
 // String 将资源标识符转换为字符串.
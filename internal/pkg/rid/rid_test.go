@@ -37,6 +37,10 @@ func TestResourceID_String(t *testing.T) {
 	// 测试 PostID 转换为字符串
 	postID := rid.PostID
 	assert.Equal(t, "post", postID.String(), "PostID.String() should return 'post'")
+
+	// 测试 CommentID 转换为字符串
+	commentID := rid.CommentID
+	assert.Equal(t, "comment", commentID.String(), "CommentID.String() should return 'comment'")
 }
 
 func TestResourceID_New(t *testing.T) {
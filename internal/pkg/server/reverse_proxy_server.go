@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"math"
 	"net/http"
 	"time"
 
@@ -30,11 +31,13 @@ type GRPCGatewayServer struct {
 }
 
 // NewGRPCGatewayServer 创建一个新的 GRPC 网关服务器实例.
+// muxOptions 用于定制网关的行为，例如根据 gRPC 响应头修改 HTTP 响应.
 func NewGRPCGatewayServer(
 	httpOptions *genericoptions.HTTPOptions,
 	grpcOptions *genericoptions.GRPCOptions,
 	tlsOptions *genericoptions.TLSOptions,
 	registerHandler func(mux *runtime.ServeMux, conn *grpc.ClientConn) error,
+	muxOptions ...runtime.ServeMuxOption,
 ) (*GRPCGatewayServer, error) {
 	var tlsConfig *tls.Config
 	if tlsOptions != nil && tlsOptions.UseTLS {
@@ -47,6 +50,8 @@ func NewGRPCGatewayServer(
 			Backoff:           backoff.DefaultConfig,
 			MinConnectTimeout: 10 * time.Second, // 最小连接超时时间
 		}),
+		// 网关只连接本服务的 gRPC 服务器，不限制响应大小，以便下载超过默认 4MB 限制的媒体文件
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
 	}
 	if tlsConfig != nil {
 		dialOptions = append(dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
@@ -60,13 +65,19 @@ func NewGRPCGatewayServer(
 		return nil, err
 	}
 
-	gwmux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			// 设置序列化 protobuf 数据时，枚举类型的字段以数字格式输出.
-			// 否则，默认会以字符串格式输出，跟枚举类型定义不一致，带来理解成本.
-			UseEnumNumbers: true,
-		},
-	}))
+	muxOptions = append([]runtime.ServeMuxOption{
+		// HTTPBodyMarshaler 将 google.api.HttpBody 类型的响应按原始内容输出，例如下载媒体文件，其他响应仍以 JSON 格式输出.
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					// 设置序列化 protobuf 数据时，枚举类型的字段以数字格式输出.
+					// 否则，默认会以字符串格式输出，跟枚举类型定义不一致，带来理解成本.
+					UseEnumNumbers: true,
+				},
+			},
+		}),
+	}, muxOptions...)
+	gwmux := runtime.NewServeMux(muxOptions...)
 	if err := registerHandler(gwmux, conn); err != nil {
 		log.Errorw("Failed to register handler", "err", err)
 		return nil, err
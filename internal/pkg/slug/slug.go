@@ -0,0 +1,87 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slug 用于根据标题生成适合出现在 URL 中的可读标识.
+package slug
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mozillazg/go-pinyin"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength 定义 slug 的最大长度.
+const MaxLength = 128
+
+// pattern 定义合法 slug 的格式：由小写字母和数字组成的单词，单词之间使用单个连字符分隔.
+var pattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Make 根据标题生成 slug.
+// 拉丁字母会去除变音符号并转为小写，汉字会转写为不带声调的拼音，其它无法转写的字符视为单词分隔符.
+// 标题中没有任何可转写的字符时返回空字符串，由调用方决定回退策略.
+func Make(title string) string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+
+	args := pinyin.NewArgs()
+	// 先做 NFD 分解，将 "é" 这类字符拆分为基本字母和变音符号
+	for _, r := range norm.NFD.String(title) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// 忽略变音符号
+		case r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			word.WriteRune(unicode.ToLower(r))
+		case unicode.Is(unicode.Han, r):
+			flush()
+			if py := pinyin.SinglePinyin(r, args); len(py) > 0 {
+				words = append(words, py[0])
+			}
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return truncate(strings.Join(words, "-"), MaxLength)
+}
+
+// Valid 判断 s 是否为合法的 slug.
+func Valid(s string) bool {
+	return len(s) <= MaxLength && pattern.MatchString(s)
+}
+
+// WithSuffix 为 slug 追加数字后缀，用于解决 slug 冲突，追加后的长度仍不超过 MaxLength.
+func WithSuffix(s string, n int) string {
+	suffix := "-" + strconv.Itoa(n)
+	return truncate(s, MaxLength-len(suffix)) + suffix
+}
+
+// truncate 将 s 截断到不超过 n 个字节，并去除末尾的连字符. slug 只包含 ASCII 字符，按字节截断是安全的.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		s = s[:n]
+	}
+	return strings.TrimRight(s, "-")
+}
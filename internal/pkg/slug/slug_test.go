@@ -0,0 +1,70 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slug_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jwcen/miniblog/internal/pkg/slug"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Hello, World!", want: "hello-world"},
+		{title: "  Go 1.24 Release Notes  ", want: "go-1-24-release-notes"},
+		{title: "Café Crème", want: "cafe-creme"},
+		{title: "Go 语言入门", want: "go-yu-yan-ru-men"},
+		{title: "こんにちは", want: ""},
+		{title: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, slug.Make(tt.title))
+		})
+	}
+}
+
+func TestMake_Truncate(t *testing.T) {
+	// 截断后的 slug 不能以连字符结尾
+	got := slug.Make(strings.Repeat("abc ", 100))
+	assert.LessOrEqual(t, len(got), slug.MaxLength)
+	assert.True(t, slug.Valid(got), "truncated slug should be valid")
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, slug.Valid("hello-world"))
+	assert.True(t, slug.Valid("go124"))
+	assert.False(t, slug.Valid(""))
+	assert.False(t, slug.Valid("Hello"))
+	assert.False(t, slug.Valid("hello--world"))
+	assert.False(t, slug.Valid("-hello"))
+	assert.False(t, slug.Valid("hello_world"))
+	assert.False(t, slug.Valid(strings.Repeat("a", slug.MaxLength+1)))
+}
+
+func TestWithSuffix(t *testing.T) {
+	assert.Equal(t, "hello-world-2", slug.WithSuffix("hello-world", 2))
+
+	got := slug.WithSuffix(strings.Repeat("a", slug.MaxLength), 12)
+	assert.Len(t, got, slug.MaxLength)
+	assert.True(t, strings.HasSuffix(got, "-12"))
+}
@@ -0,0 +1,470 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wxr
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	// shortcodeRegex 匹配 WordPress 内置的媒体类短代码标签，标签本身被去掉，标签之间的内容被保留.
+	shortcodeRegex = regexp.MustCompile(`\[/?(?:caption|wp_caption|embed|audio|video|gallery|playlist)(?:\s[^\]]*)?\]`)
+	// blankLineRegex 匹配经典编辑器中用于分隔段落的空行.
+	blankLineRegex = regexp.MustCompile(`\n[ \t]*\n`)
+	// preRegex 匹配 pre 元素，自动分段时 pre 元素中的空行需要原样保留.
+	preRegex = regexp.MustCompile(`(?is)<pre[\s>].*?</pre>`)
+	// blockStartRegex 匹配以块级元素开头的文本，这些文本在自动分段时不再包裹 p 元素.
+	blockStartRegex = regexp.MustCompile(`(?i)^<(?:p|h[1-6]|ul|ol|li|dl|pre|blockquote|div|table|figure|hr|section|address|form|iframe|!--)[\s/>]`)
+	// orderedMarkerRegex 匹配行首会被 Markdown 识别为有序列表的文本.
+	orderedMarkerRegex = regexp.MustCompile(`^(\d+)([.)])`)
+	// markdownEscaper 转义行内文本中的 Markdown 特殊字符.
+	markdownEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`)
+)
+
+// ToMarkdown 将 WordPress 文章的 HTML 内容转换为 Markdown.
+// 经典编辑器保存的内容使用空行分隔段落，转换前先按 WordPress 的 wpautop 规则补全段落；
+// 区块编辑器的区块注释和媒体类短代码会被去掉，表格等 Markdown 无法表示的元素保留为 HTML.
+func ToMarkdown(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = shortcodeRegex.ReplaceAllString(content, "")
+	if !strings.Contains(content, "<!-- wp:") {
+		content = autop(content)
+	}
+
+	root, err := html.Parse(strings.NewReader("<body>" + content + "</body>"))
+	if err != nil {
+		// html.Parse 只会在读取失败时返回错误，这里不会发生
+		return content
+	}
+
+	body := findBody(root)
+	if body == nil {
+		return ""
+	}
+	md := strings.Join(convertBlocks(body), "\n\n")
+	if md == "" {
+		return ""
+	}
+	return md + "\n"
+}
+
+// autop 是 WordPress wpautop 函数的简化实现：用空行分隔的文本包裹为段落，段落中的单个换行转换为 br 元素.
+func autop(content string) string {
+	// 先将 pre 元素替换为占位符，避免代码中的空行被分段
+	var pres []string
+	content = preRegex.ReplaceAllStringFunc(content, func(pre string) string {
+		pres = append(pres, pre)
+		return "\x00" + strconv.Itoa(len(pres)-1) + "\x00"
+	})
+
+	chunks := blankLineRegex.Split(strings.TrimSpace(content), -1)
+	for i, chunk := range chunks {
+		chunk = strings.TrimSpace(chunk)
+		if chunk == "" || blockStartRegex.MatchString(chunk) || strings.HasPrefix(chunk, "\x00") {
+			chunks[i] = chunk
+			continue
+		}
+		chunks[i] = "<p>" + strings.ReplaceAll(chunk, "\n", "<br>\n") + "</p>"
+	}
+	content = strings.Join(chunks, "\n\n")
+
+	for i, pre := range pres {
+		content = strings.Replace(content, "\x00"+strconv.Itoa(i)+"\x00", pre, 1)
+	}
+	return content
+}
+
+// findBody 返回 HTML 文档中的 body 元素.
+func findBody(n *html.Node) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == atom.Body {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if body := findBody(c); body != nil {
+			return body
+		}
+	}
+	return nil
+}
+
+// convertBlocks 将元素的子节点转换为 Markdown 块. 相邻的行内节点合并为一个段落.
+func convertBlocks(n *html.Node) []string {
+	var (
+		blocks []string
+		inline strings.Builder
+	)
+	flush := func() {
+		if text := paragraph(inline.String()); text != "" {
+			blocks = append(blocks, text)
+		}
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if !isBlock(c) {
+			inline.WriteString(convertInline(c))
+			continue
+		}
+		flush()
+		if block := convertBlock(c); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	flush()
+	return blocks
+}
+
+// convertBlock 将块级元素转换为 Markdown.
+func convertBlock(n *html.Node) string {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := strings.Join(strings.Fields(convertChildren(n)), " ")
+		if text == "" {
+			return ""
+		}
+		level := int(n.Data[1] - '0')
+		return strings.Repeat("#", level) + " " + text
+	case atom.Pre:
+		return codeBlock(n)
+	case atom.Blockquote:
+		return prefixLines(strings.Join(convertBlocks(n), "\n\n"), "> ", "> ")
+	case atom.Ul, atom.Ol:
+		return list(n)
+	case atom.Hr:
+		return "---"
+	case atom.Table:
+		var b strings.Builder
+		if err := html.Render(&b, n); err != nil {
+			return ""
+		}
+		return b.String()
+	case atom.Script, atom.Style, atom.Noscript, atom.Iframe, atom.Object:
+		if src := attr(n, "src"); src != "" && n.DataAtom == atom.Iframe {
+			return "<" + src + ">"
+		}
+		return ""
+	default:
+		return strings.Join(convertBlocks(n), "\n\n")
+	}
+}
+
+// convertInline 将行内节点转换为 Markdown.
+func convertInline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return markdownEscaper.Replace(collapseSpace(n.Data))
+	case html.ElementNode:
+	default:
+		// 注释（包括区块编辑器的区块注释）等节点不输出
+		return ""
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		return "\\\n"
+	case atom.Strong, atom.B:
+		return wrap(convertChildren(n), "**")
+	case atom.Em, atom.I:
+		return wrap(convertChildren(n), "*")
+	case atom.Del, atom.S, atom.Strike:
+		return wrap(convertChildren(n), "~~")
+	case atom.Code, atom.Kbd, atom.Tt:
+		return inlineCode(textContent(n))
+	case atom.A:
+		text := strings.TrimSpace(convertChildren(n))
+		href := attr(n, "href")
+		switch {
+		case href == "":
+			return text
+		case text == "":
+			return "<" + href + ">"
+		}
+		if title := attr(n, "title"); title != "" {
+			return "[" + text + "](" + escapeURL(href) + ` "` + strings.ReplaceAll(title, `"`, `\"`) + `")`
+		}
+		return "[" + text + "](" + escapeURL(href) + ")"
+	case atom.Img:
+		src := attr(n, "src")
+		if src == "" {
+			return ""
+		}
+		return "![" + markdownEscaper.Replace(attr(n, "alt")) + "](" + escapeURL(src) + ")"
+	case atom.Script, atom.Style:
+		return ""
+	default:
+		return convertChildren(n)
+	}
+}
+
+// convertChildren 将元素的子节点按行内节点转换为 Markdown.
+func convertChildren(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if isBlock(c) {
+			// 行内上下文中的块级元素按空格分隔输出
+			b.WriteString(" " + strings.Join(convertBlocks(c), " ") + " ")
+			continue
+		}
+		b.WriteString(convertInline(c))
+	}
+	return b.String()
+}
+
+// codeBlock 将 pre 元素转换为围栏代码块，代码语言取自 pre 或 code 元素的 language-* 类名.
+func codeBlock(n *html.Node) string {
+	lang := language(n)
+	if code := n.FirstChild; code != nil && code.NextSibling == nil && code.DataAtom == atom.Code && lang == "" {
+		lang = language(code)
+	}
+
+	code := strings.TrimSuffix(strings.TrimPrefix(textContent(n), "\n"), "\n")
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	return fence + lang + "\n" + code + "\n" + fence
+}
+
+// list 将 ul 和 ol 元素转换为 Markdown 列表，列表项中的后续行按列表标记的宽度缩进.
+func list(n *html.Node) string {
+	var items []string
+	start := 1
+	if value, err := strconv.Atoi(attr(n, "start")); err == nil && n.DataAtom == atom.Ol {
+		start = value
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+			continue
+		}
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = strconv.Itoa(start+len(items)) + ". "
+		}
+		content := strings.Join(convertBlocks(c), "\n")
+		items = append(items, prefixLines(content, marker, strings.Repeat(" ", len(marker))))
+	}
+	return strings.Join(items, "\n")
+}
+
+// paragraph 整理段落文本：去掉首尾空白和行首空格，并转义行首会被识别为 Markdown 块标记的字符.
+func paragraph(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " ")
+		if i == len(lines)-1 {
+			line = strings.TrimSuffix(strings.TrimRight(line, " "), "\\")
+		}
+		lines[i] = escapeLineStart(line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// escapeLineStart 转义行首的标题、引用、列表和分隔线标记.
+func escapeLineStart(line string) string {
+	if line == "" {
+		return line
+	}
+	switch line[0] {
+	case '#', '>', '-', '+', '=', '|':
+		return `\` + line
+	}
+	if m := orderedMarkerRegex.FindStringSubmatch(line); m != nil {
+		return m[1] + `\` + line[len(m[1]):]
+	}
+	return line
+}
+
+// wrap 使用强调标记包裹文本，文本首尾的空白移到标记外面.
+func wrap(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+	return text[:start] + marker + trimmed + marker + text[start+len(trimmed):]
+}
+
+// inlineCode 使用比代码中最长的连续反引号多一个的反引号包裹行内代码.
+func inlineCode(code string) string {
+	code = collapseSpace(code)
+	if code == "" {
+		return ""
+	}
+	longest, run := 0, 0
+	for _, r := range code {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		return fence + " " + code + " " + fence
+	}
+	return fence + code + fence
+}
+
+// prefixLines 为第一行添加 first 前缀，为其余行添加 rest 前缀. 空行只保留去掉尾部空格的前缀.
+func prefixLines(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			prefix = strings.TrimRight(prefix, " ")
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isBlock 返回节点是否为块级元素.
+func isBlock(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	switch n.DataAtom {
+	case atom.P, atom.Div, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Pre, atom.Blockquote,
+		atom.Ul, atom.Ol, atom.Li, atom.Dl, atom.Dt, atom.Dd, atom.Hr, atom.Table, atom.Figure, atom.Figcaption,
+		atom.Section, atom.Article, atom.Header, atom.Footer, atom.Aside, atom.Nav, atom.Main, atom.Address,
+		atom.Iframe, atom.Script, atom.Style, atom.Noscript, atom.Object:
+		return true
+	}
+	return false
+}
+
+// language 返回元素 class 属性中 language-* 或 lang-* 类名表示的代码语言.
+func language(n *html.Node) string {
+	for _, class := range strings.Fields(attr(n, "class")) {
+		for _, prefix := range []string{"language-", "lang-"} {
+			if lang, ok := strings.CutPrefix(class, prefix); ok {
+				return lang
+			}
+		}
+	}
+	return ""
+}
+
+// attr 返回元素的属性值.
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+// textContent 返回节点中所有文本节点的内容.
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.DataAtom == atom.Br {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString(textContent(c))
+	}
+	return b.String()
+}
+
+// collapseSpace 将连续的空白字符合并为一个空格.
+func collapseSpace(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range text {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\f' {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// escapeURL 转义链接地址中会提前结束 Markdown 链接的字符.
+func escapeURL(url string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(url)
+}
+
+// ToText 将 WordPress 评论的 HTML 内容转换为纯文本，段落之间使用空行分隔.
+func ToText(content string) string {
+	content = autop(strings.ReplaceAll(content, "\r\n", "\n"))
+	root, err := html.Parse(strings.NewReader("<body>" + content + "</body>"))
+	if err != nil {
+		return content
+	}
+
+	body := findBody(root)
+	if body == nil {
+		return ""
+	}
+	return strings.Join(textBlocks(body), "\n\n")
+}
+
+// textBlocks 将元素的子节点转换为纯文本段落.
+func textBlocks(n *html.Node) []string {
+	var (
+		blocks []string
+		inline strings.Builder
+	)
+	flush := func() {
+		lines := strings.Split(strings.TrimSpace(inline.String()), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimSpace(line)
+		}
+		if text := strings.TrimSpace(strings.Join(lines, "\n")); text != "" {
+			blocks = append(blocks, text)
+		}
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.ElementNode && (c.DataAtom == atom.Script || c.DataAtom == atom.Style):
+		case isBlock(c):
+			flush()
+			blocks = append(blocks, textBlocks(c)...)
+		case c.Type == html.TextNode:
+			inline.WriteString(collapseSpace(c.Data))
+		case c.Type == html.ElementNode && c.DataAtom == atom.Br:
+			inline.WriteString("\n")
+		case c.Type == html.ElementNode:
+			inline.WriteString(strings.Join(textBlocks(c), " "))
+		}
+	}
+	flush()
+	return blocks
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wxr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jwcen/miniblog/internal/pkg/wxr"
+)
+
+func TestToMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "empty",
+			content: "",
+			want:    "",
+		},
+		{
+			name:    "classic editor paragraphs",
+			content: "First line\nsecond line\n\nSecond <strong>bold</strong> and <em>italic</em>.",
+			want:    "First line\\\nsecond line\n\nSecond **bold** and *italic*.\n",
+		},
+		{
+			name: "block editor",
+			content: "<!-- wp:heading -->\n<h2>Title</h2>\n<!-- /wp:heading -->\n\n" +
+				"<!-- wp:paragraph -->\n<p>Hello <a href=\"https://example.com/a b\" title=\"Example\">world</a></p>\n<!-- /wp:paragraph -->",
+			want: "## Title\n\nHello [world](https://example.com/a%20b \"Example\")\n",
+		},
+		{
+			name:    "code",
+			content: "<p>Use <code>go test</code></p>\n<pre class=\"language-go\"><code>func main() {\n\n\tfmt.Println(\"*\")\n}</code></pre>",
+			want:    "Use `go test`\n\n```go\nfunc main() {\n\n\tfmt.Println(\"*\")\n}\n```\n",
+		},
+		{
+			name:    "lists",
+			content: "<ul><li>one</li><li>two<ol start=\"3\"><li>three</li></ol></li></ul>",
+			want:    "- one\n- two\n  3. three\n",
+		},
+		{
+			name:    "blockquote",
+			content: "<blockquote><p>quoted</p><p>text</p></blockquote>",
+			want:    "> quoted\n>\n> text\n",
+		},
+		{
+			name:    "caption shortcode and image",
+			content: "[caption id=\"attachment_1\" align=\"alignnone\"]<img src=\"/a.png\" alt=\"A [b]\" /> Photo[/caption]",
+			want:    "![A \\[b\\]](/a.png) Photo\n",
+		},
+		{
+			name:    "markdown special characters",
+			content: "# not a heading\n\n1. not a list with *stars* and snake_case",
+			want:    "\\# not a heading\n\n1\\. not a list with \\*stars\\* and snake\\_case\n",
+		},
+		{
+			name:    "table is kept as HTML",
+			content: "<table><tr><td>a</td></tr></table>",
+			want:    "<table><tbody><tr><td>a</td></tr></tbody></table>\n",
+		},
+		{
+			name:    "scripts are dropped",
+			content: "<p>a</p><script>alert(1)</script><hr/><p>b</p>",
+			want:    "a\n\n---\n\nb\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, wxr.ToMarkdown(tt.content))
+		})
+	}
+}
+
+func TestToText(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "plain", content: "Hi, this is a comment.", want: "Hi, this is a comment."},
+		{name: "line breaks", content: "first\nsecond\n\nthird", want: "first\nsecond\n\nthird"},
+		{name: "markup", content: "<p>see <a href=\"https://example.com\">this</a> &amp; <b>that</b></p><script>x</script>", want: "see this & that"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, wxr.ToText(tt.content))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wxr 解析 WordPress 导出的 WXR（WordPress eXtended RSS）文件，并将文章的 HTML 内容转换为 Markdown.
+package wxr
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ErrNotWXR 表示文件不是 WordPress 导出的 WXR 文件.
+var ErrNotWXR = errors.New("not a WordPress eXtended RSS file")
+
+// dateLayout 是 WXR 文件中的日期格式. WordPress 使用全零日期表示未设置.
+const dateLayout = "2006-01-02 15:04:05"
+
+// WordPress 中的文章类型.
+const PostTypePost = "post"
+
+// WordPress 中的文章状态.
+const (
+	PostStatusPublish = "publish"
+	PostStatusFuture  = "future"
+	PostStatusDraft   = "draft"
+	PostStatusPending = "pending"
+	PostStatusPrivate = "private"
+	PostStatusTrash   = "trash"
+)
+
+// WordPress 中文章分类和文章标签的 domain 属性.
+const (
+	CategoryDomainCategory = "category"
+	CategoryDomainTag      = "post_tag"
+)
+
+// Export 是解析后的 WXR 文件.
+// wp 命名空间的元素按本地名称匹配，以兼容 WXR 1.0 到 1.2 的各个版本.
+type Export struct {
+	// Version 是 WXR 文件的版本号.
+	Version string `xml:"wxr_version"`
+	// Title 是站点名称.
+	Title string `xml:"title"`
+	// SiteURL 是站点地址，用于生成导入映射的来源标识.
+	SiteURL string `xml:"base_blog_url"`
+	// Authors 是站点的作者列表.
+	Authors []*Author `xml:"author"`
+	// Items 是站点的文章、页面和附件等内容.
+	Items []*Item `xml:"item"`
+}
+
+// Author 是 WordPress 中的作者.
+type Author struct {
+	ID          int64  `xml:"author_id"`
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+}
+
+// Item 是 WordPress 中的一项内容，只有 PostType 为 post 的内容是文章.
+type Item struct {
+	Title           string      `xml:"title"`
+	Link            string      `xml:"link"`
+	GUID            string      `xml:"guid"`
+	Creator         string      `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Content         string      `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PostID          int64       `xml:"post_id"`
+	PostDate        string      `xml:"post_date"`
+	PostDateGMT     string      `xml:"post_date_gmt"`
+	PostModified    string      `xml:"post_modified"`
+	PostModifiedGMT string      `xml:"post_modified_gmt"`
+	PostName        string      `xml:"post_name"`
+	Status          string      `xml:"status"`
+	PostType        string      `xml:"post_type"`
+	Categories      []*Category `xml:"category"`
+	Comments        []*Comment  `xml:"comment"`
+}
+
+// Category 是文章的分类或标签，Domain 为 category 或 post_tag.
+type Category struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+// Comment 是文章的评论.
+type Comment struct {
+	ID          int64  `xml:"comment_id"`
+	Author      string `xml:"comment_author"`
+	AuthorEmail string `xml:"comment_author_email"`
+	AuthorURL   string `xml:"comment_author_url"`
+	Date        string `xml:"comment_date"`
+	DateGMT     string `xml:"comment_date_gmt"`
+	Content     string `xml:"comment_content"`
+	Approved    string `xml:"comment_approved"`
+	Type        string `xml:"comment_type"`
+	Parent      int64  `xml:"comment_parent"`
+	UserID      int64  `xml:"comment_user_id"`
+}
+
+// Parse 解析 WXR 文件.
+func Parse(r io.Reader) (*Export, error) {
+	var doc struct {
+		Channel Export `xml:"channel"`
+	}
+
+	decoder := xml.NewDecoder(r)
+	// WordPress 导出的文件总是 UTF-8 编码，其它声明的编码按原样读取
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid WXR file: %w", err)
+	}
+	if doc.Channel.Version == "" {
+		return nil, ErrNotWXR
+	}
+
+	export := &doc.Channel
+	export.SiteURL = strings.TrimSpace(export.SiteURL)
+	for _, item := range export.Items {
+		item.GUID = strings.TrimSpace(item.GUID)
+		item.PostName = strings.TrimSpace(item.PostName)
+	}
+	return export, nil
+}
+
+// IsPost 返回内容是否为文章. 页面、附件和导航菜单等其它类型的内容不会被导入.
+func (i *Item) IsPost() bool {
+	return i.PostType == PostTypePost
+}
+
+// Published 返回文章的发布时间（UTC），未设置时返回零值.
+func (i *Item) Published() time.Time {
+	return parseDate(i.PostDateGMT, i.PostDate)
+}
+
+// Modified 返回文章的最后修改时间（UTC），未设置时返回零值.
+func (i *Item) Modified() time.Time {
+	return parseDate(i.PostModifiedGMT, i.PostModified)
+}
+
+// Tags 返回文章的分类和标签名称，重复的名称只保留一个. WordPress 的默认分类"未分类"不会被返回.
+func (i *Item) Tags() []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, category := range i.Categories {
+		if category.Domain != CategoryDomainTag && category.Domain != CategoryDomainCategory {
+			continue
+		}
+		if category.Domain == CategoryDomainCategory && category.Nicename == "uncategorized" {
+			continue
+		}
+		name := strings.TrimSpace(category.Name)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
+
+// IsApproved 返回评论是否已经审核通过. 待审核、垃圾和已删除的评论不会被导入.
+func (c *Comment) IsApproved() bool {
+	return c.Approved == "1"
+}
+
+// IsPingback 返回评论是否为 pingback 或 trackback.
+func (c *Comment) IsPingback() bool {
+	return c.Type == "pingback" || c.Type == "trackback"
+}
+
+// Created 返回评论的创建时间（UTC），未设置时返回零值.
+func (c *Comment) Created() time.Time {
+	return parseDate(c.DateGMT, c.Date)
+}
+
+// parseDate 优先解析 UTC 时间，UTC 时间未设置时将站点本地时间按 UTC 解析.
+func parseDate(gmt, local string) time.Time {
+	for _, value := range []string{gmt, local} {
+		value = strings.TrimSpace(value)
+		if value == "" || strings.HasPrefix(value, "0000-00-00") {
+			continue
+		}
+		if t, err := time.Parse(dateLayout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
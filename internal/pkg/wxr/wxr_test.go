@@ -0,0 +1,82 @@
+// Copyright 2024 jayvee <jvvcen@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wxr_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jwcen/miniblog/internal/pkg/wxr"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open("testdata/export.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	export, err := wxr.Parse(f)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.2", export.Version)
+	assert.Equal(t, "Example Blog", export.Title)
+	assert.Equal(t, "https://blog.example.com", export.SiteURL)
+	require.Len(t, export.Authors, 2)
+	assert.Equal(t, wxr.Author{ID: 2, Login: "bob.smith", Email: "bob@example.com", DisplayName: "Bob Smith"}, *export.Authors[1])
+	require.Len(t, export.Items, 3)
+
+	post := export.Items[0]
+	assert.True(t, post.IsPost())
+	assert.Equal(t, "Hello world!", post.Title)
+	assert.Equal(t, "https://blog.example.com/?p=1", post.GUID)
+	assert.Equal(t, "alice", post.Creator)
+	assert.Equal(t, "hello-world", post.PostName)
+	assert.Equal(t, wxr.PostStatusPublish, post.Status)
+	assert.Equal(t, "Welcome to WordPress.\n\nThis is your first post.", post.Content)
+	assert.Equal(t, time.Date(2019, 3, 1, 2, 0, 0, 0, time.UTC), post.Published())
+	assert.Equal(t, time.Date(2019, 3, 2, 2, 0, 0, 0, time.UTC), post.Modified())
+	assert.Equal(t, []string{"News", "Go"}, post.Tags())
+
+	require.Len(t, post.Comments, 3)
+	assert.True(t, post.Comments[0].IsApproved())
+	assert.Equal(t, time.Date(2019, 3, 1, 3, 0, 0, 0, time.UTC), post.Comments[0].Created())
+	assert.Equal(t, int64(1), post.Comments[1].Parent)
+	assert.Equal(t, int64(2), post.Comments[1].UserID)
+	assert.False(t, post.Comments[2].IsApproved())
+
+	draft := export.Items[1]
+	assert.Equal(t, wxr.PostStatusDraft, draft.Status)
+	// 草稿没有 UTC 发布时间，按站点本地时间解析
+	assert.Equal(t, time.Date(2019, 4, 1, 9, 30, 0, 0, time.UTC), draft.Published())
+	assert.True(t, draft.Modified().IsZero())
+	assert.Empty(t, draft.Tags())
+
+	assert.False(t, export.Items[2].IsPost())
+}
+
+func TestParseNotWXR(t *testing.T) {
+	_, err := wxr.Parse(strings.NewReader(`<?xml version="1.0"?><rss version="2.0"><channel><title>feed</title></channel></rss>`))
+	assert.ErrorIs(t, err, wxr.ErrNotWXR)
+
+	_, err = wxr.Parse(strings.NewReader(`<html><body>hello</body></html>`))
+	assert.ErrorIs(t, err, wxr.ErrNotWXR)
+
+	_, err = wxr.Parse(strings.NewReader(`<rss><channel>`))
+	assert.Error(t, err)
+}
@@ -25,6 +25,7 @@ package v1
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -49,126 +50,672 @@ var file_apiserver_v1_apiserver_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
-	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa0, 0x0e, 0x0a, 0x08,
-	0x4d, 0x69, 0x6e, 0x69, 0x42, 0x6c, 0x6f, 0x67, 0x12, 0x76, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x7a, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3e, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0xb2, 0xbb,
-	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x81, 0xa5, 0xe5, 0xba,
-	0xb7, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0x2a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a,
-	0x12, 0x65, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
-	0x92, 0x41, 0x23, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
-	0x86, 0x12, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x2a,
-	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22,
-	0x06, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x2a,
-	0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
-	0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x2a, 0x0c, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
-	0x3a, 0x01, 0x2a, 0x1a, 0x0e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92,
-	0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
-	0x12, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a, 0x0e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x7c, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7,
-	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09,
-	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0xe7, 0x94,
-	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6,
-	0x96, 0xb0, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x0a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6,
-	0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
-	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1,
-	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-	0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae,
-	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6,
-	0x9c, 0x89, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
-	0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab,
-	0xa0, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
-	0x73, 0x12, 0x85, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x48, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7,
-	0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0,
-	0x2a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
-	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
-	0xa4, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x2a, 0x09, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x2b,
-	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12,
-	0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe4, 0xbf, 0xa1, 0xe6,
-	0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x92, 0x41,
-	0x2c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
-	0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6, 0x96, 0x87,
-	0xe7, 0xab, 0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x8b,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61,
+	0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x61, 0x70, 0x69, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf4, 0x4e, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x69,
+	0x42, 0x6c, 0x6f, 0x67, 0x12, 0x76, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x92, 0x41,
+	0x2b, 0x0a, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0xb2, 0xbb, 0xe7, 0x90, 0x86, 0x12,
+	0x12, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x81, 0xa5, 0xe5, 0xba, 0xb7, 0xe6, 0xa3, 0x80,
+	0xe6, 0x9f, 0xa5, 0x2a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0a, 0x12, 0x08, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x12, 0x65, 0x0a, 0x05,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x92, 0x41, 0x23, 0x0a,
+	0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x2a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22, 0x06, 0x2f, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x2a, 0x0a, 0x0c, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xb7, 0xe6,
+	0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x2a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x1a,
+	0x0e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41, 0x2c, 0x0a, 0x0c,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe4, 0xbf,
+	0xae, 0xe6, 0x94, 0xb9, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x5a,
+	0x17, 0x3a, 0x01, 0x2a, 0x32, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x82, 0x01, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x92, 0x41, 0x28, 0x0a,
+	0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5,
+	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x7d, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12,
+	0x77, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87,
+	0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x08, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5,
+	0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba,
+	0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae,
+	0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6,
+	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x5a, 0x17, 0x3a, 0x01, 0x2a, 0x32,
+	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x7d, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae,
+	0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6,
+	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x2a, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e,
+	0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
+	0x2a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a,
+	0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5,
+	0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x91, 0x01, 0x0a,
+	0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92,
+	0x41, 0x29, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x0c, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x12, 0x9b, 0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x92, 0xa4, 0xe5,
+	0x9b, 0x9e, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0d, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a,
+	0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x7d, 0x2f, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x91,
+	0x01, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x51, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x0c, 0xe5, 0xbd, 0x92, 0xe6, 0xa1, 0xa3, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0,
+	0x2a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x12, 0xa2, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
+	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe8, 0xae, 0xbe, 0xe7, 0xbd,
+	0xae, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe5, 0xae, 0x9a, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0x91,
+	0xe5, 0xb8, 0x83, 0x2a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x76, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f,
+	0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6,
+	0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0xb7, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x65, 0x92, 0x41, 0x3e, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1b, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x96, 0x87,
+	0xe7, 0xab, 0xa0, 0xe7, 0x9a, 0x84, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x3c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
+	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1b, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+	0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe7, 0x9a, 0x84, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41, 0x44, 0x0a, 0x0c,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x21, 0xe6, 0xaf,
+	0x94, 0xe8, 0xbe, 0x83, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0xa4,
+	0xe4, 0xb8, 0xaa, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a,
+	0x11, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0xdf, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x01, 0x92, 0x41, 0x49,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x24,
+	0xe5, 0xb0, 0x86, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe5, 0x9b, 0x9e, 0xe6, 0xbb, 0x9a, 0xe5,
+	0x88, 0xb0, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a,
+	0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x92, 0x41, 0x2b, 0x0a,
+	0x0c, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5,
+	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x2a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0xa6, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe8, 0xaf,
+	0x84, 0xe8, 0xae, 0xba, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6,
+	0x96, 0xb0, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x2a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a,
+	0x1a, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5d, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xaf, 0x84, 0xe8, 0xae,
+	0xba, 0x2a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x7d, 0x12,
+	0x98, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x58, 0x92, 0x41, 0x32, 0x0a, 0x0c, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x15, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe5, 0x8d, 0x9a, 0xe6, 0x96,
+	0x87, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x2a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5b, 0x92, 0x41, 0x37, 0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xb7, 0xb2, 0xe5,
+	0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0d,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0xa6,
+	0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x92, 0x41, 0x3f,
+	0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x12, 0x1e,
+	0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe5, 0xb7, 0xb2, 0xe5,
+	0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0xd5, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x92, 0x41, 0x4b, 0x0a, 0x0c, 0xe5, 0x85, 0xac,
+	0xe5, 0xbc, 0x80, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x12, 0x24, 0xe5, 0x88, 0x97, 0xe5, 0x87,
+	0xba, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0xb7, 0xb2,
+	0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x8a, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4a, 0x92, 0x41, 0x2f, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x85, 0xa8, 0xe6, 0x96, 0x87, 0xe6, 0xa3, 0x80, 0xe7, 0xb4,
+	0xa2, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0xad, 0x01, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x40, 0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x12, 0x21, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0x20, 0x73,
+	0x6c, 0x75, 0x67, 0x20, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0xb7, 0xb2, 0xe5, 0x8f, 0x91,
+	0xe5, 0xb8, 0x83, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x2f, 0x73, 0x6c, 0x75, 0x67, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x12, 0x95, 0x01, 0x0a,
+	0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x92,
+	0x41, 0x2f, 0x0a, 0x0c, 0xe5, 0xaa, 0x92, 0xe4, 0xbd, 0x93, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x12, 0xe4, 0xb8, 0x8a, 0xe4, 0xbc, 0xa0, 0xe5, 0xaa, 0x92, 0xe4, 0xbd, 0x93, 0xe6, 0x96,
+	0x87, 0xe4, 0xbb, 0xb6, 0x2a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x12, 0x81, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x4a, 0x92, 0x41,
+	0x2c, 0x0a, 0x0c, 0xe5, 0xaa, 0x92, 0xe4, 0xbd, 0x93, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
+	0x12, 0xe4, 0xb8, 0x8b, 0xe8, 0xbd, 0xbd, 0xe5, 0xaa, 0x92, 0xe4, 0xbd, 0x93, 0xe6, 0x96, 0x87,
+	0xe4, 0xbb, 0xb6, 0x2a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2f, 0x7b,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x44, 0x7d, 0x12, 0x8d, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41, 0x2f, 0x0a, 0x0c,
+	0xe5, 0xaa, 0x92, 0xe4, 0xbd, 0x93, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88,
+	0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0xaa, 0x92, 0xe4, 0xbd, 0x93, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6,
+	0x2a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2f, 0x7b,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x44, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x6c, 0x92, 0x41,
+	0x47, 0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x12,
+	0x27, 0xe4, 0xb8, 0x8b, 0xe8, 0xbd, 0xbd, 0xe5, 0xb7, 0xb2, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83,
+	0xe5, 0x8d, 0x9a, 0xe6, 0x96, 0x87, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0xaa, 0x92, 0xe4,
+	0xbd, 0x93, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x2f, 0x7b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x44, 0x7d, 0x12, 0x7f, 0x0a, 0x08, 0x4c, 0x69,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x48, 0x92, 0x41, 0x26, 0x0a, 0x0c, 0xe4, 0xba, 0x92, 0xe5, 0x8a, 0xa8, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe5, 0x8d, 0x9a, 0xe5,
+	0xae, 0xa2, 0x2a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x1a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0a,
+	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x92, 0x41, 0x2e, 0x0a, 0x0c,
+	0xe4, 0xba, 0x92, 0xe5, 0x8a, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x8f,
+	0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2,
+	0x2a, 0x0a, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x92, 0x41, 0x33, 0x0a, 0x0c, 0xe4,
+	0xba, 0x92, 0xe5, 0x8a, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0xb7, 0xbb,
+	0xe5, 0x8a, 0xa0, 0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x9b, 0x9e, 0xe5, 0xba, 0x94, 0x2a,
+	0x0f, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x1a, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x12,
+	0xbd, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe4, 0xba, 0x92, 0xe5,
+	0x8a, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4,
+	0xe8, 0xa1, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x9b, 0x9e, 0xe5, 0xba, 0x94, 0x2a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x7d, 0x12,
+	0xab, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65,
+	0x72, 0x73, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x92, 0x41, 0x3e, 0x0a, 0x0c,
+	0xe4, 0xba, 0x92, 0xe5, 0x8a, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1e, 0xe8, 0x8e,
+	0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x8a, 0x01,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f,
+	0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a,
+	0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe9, 0x98, 0x85, 0xe8, 0xaf, 0xbb, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0x12, 0x0c, 0xe6, 0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x2a,
+	0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x1a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x19, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x32, 0x0a, 0x0c, 0xe9, 0x98, 0x85, 0xe8, 0xaf,
+	0xbb, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x12, 0x12, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe6,
+	0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0x2a, 0x0e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x49, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe9, 0x98, 0x85, 0xe8, 0xaf, 0xbb, 0xe5, 0x88, 0x97,
+	0xe8, 0xa1, 0xa8, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0x98, 0x85, 0xe8, 0xaf,
+	0xbb, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0a,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x92, 0x41, 0x28, 0x0a, 0x0c,
+	0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x85,
+	0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x1a, 0x16, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x30, 0x0a, 0x0c, 0xe5,
+	0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x8f, 0x96,
+	0xe6, 0xb6, 0x88, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a,
+	0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x58, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xb2, 0x89,
+	0xe4, 0xb8, 0x9d, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x9e, 0x01, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x58, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x85,
+	0xb3, 0xe6, 0xb3, 0xa8, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x2a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x89, 0x01,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49,
+	0x92, 0x41, 0x32, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe9, 0xa6, 0x96, 0xe9, 0xa1, 0xb5, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe7, 0xba, 0xbf, 0x2a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x92, 0x41, 0x3d, 0x0a, 0x0c,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1b, 0xe5, 0x88,
+	0x97, 0xe5, 0x87, 0xba, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99, 0xe4, 0xb8, 0xad,
+	0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x60, 0x92, 0x41, 0x35, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe4, 0xbb, 0x8e, 0xe5, 0x9b, 0x9e, 0xe6, 0x94,
+	0xb6, 0xe7, 0xab, 0x99, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0,
+	0x2a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x92, 0x41, 0x2a, 0x0a, 0x0c,
+	0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01,
+	0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x90, 0x01,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x17,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4d, 0x92, 0x41, 0x2a, 0x0a, 0x0c, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0xb3, 0xbb, 0xe5,
+	0x88, 0x97, 0x2a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x44, 0x7d,
+	0x12, 0xac, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41, 0x3d, 0x0a, 0x0c, 0xe7, 0xb3,
+	0xbb, 0xe5, 0x88, 0x97, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1e, 0xe8, 0xb0, 0x83, 0xe6,
+	0x95, 0xb4, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe4, 0xb8, 0xad, 0xe5, 0x8d, 0x9a, 0xe6, 0x96,
+	0x87, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x2a, 0x0d, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a,
+	0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x44, 0x7d, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x87, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41, 0x2d, 0x0a,
+	0x0c, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8,
+	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x44, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x92,
+	0x41, 0x36, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x8d, 0x9a, 0xe6, 0x96, 0x87, 0xe6, 0xb5,
+	0x8f, 0xe8, 0xa7, 0x88, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0x2a, 0x0c, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x92, 0x41, 0x39, 0x0a, 0x0c,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1c, 0xe6, 0x89,
+	0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0xaf, 0xbc, 0xe5, 0x85, 0xa5, 0x20, 0x4d, 0x61, 0x72, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x20, 0xe5, 0x8d, 0x9a, 0xe6, 0x96, 0x87, 0x2a, 0x0b, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a,
+	0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x28, 0x01, 0x30, 0x01, 0x12, 0x9e, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x52, 0x92, 0x41, 0x39, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe5, 0x8d,
+	0x9a, 0xe6, 0x96, 0x87, 0xe5, 0xaf, 0xbc, 0xe5, 0x87, 0xba, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1,
+	0x2a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x92, 0x41, 0x36,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0x8d, 0x9a, 0xe6, 0x96, 0x87, 0xe5, 0xaf, 0xbc, 0xe5,
+	0x87, 0xba, 0xe4, 0xbb, 0xbb, 0xe5, 0x8a, 0xa1, 0x2a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x49, 0x44,
+	0x7d, 0x12, 0x3f, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0xa0, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92,
+	0x41, 0x39, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x1e, 0xe5, 0xb0, 0x86, 0xe5, 0x8d, 0x9a, 0xe6, 0x96, 0x87, 0xe5, 0x88, 0x86, 0xe4, 0xba,
+	0xab, 0xe7, 0xbb, 0x99, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0x2a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0xae, 0x01, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x41, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
+	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x24, 0xe5, 0x8f, 0x96, 0xe6, 0xb6,
+	0x88, 0xe5, 0xb0, 0x86, 0xe5, 0x8d, 0x9a, 0xe6, 0x96, 0x87, 0xe5, 0x88, 0x86, 0xe4, 0xba, 0xab,
+	0xe7, 0xbb, 0x99, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a,
+	0x0b, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x2a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0xd2, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92, 0x41, 0x4e, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae,
+	0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x2d, 0xe9, 0x82, 0x80, 0xe8, 0xaf, 0xb7, 0xe5,
+	0x8d, 0x9a, 0xe6, 0x96, 0x87, 0xe5, 0x8d, 0x8f, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe6, 0x88,
+	0x96, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0x8d, 0x8f, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85,
+	0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x2a, 0x0f, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a,
+	0x1a, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0xc2, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x6d, 0x92, 0x41, 0x39, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x15, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe5, 0x8d, 0x9a, 0xe6,
+	0x96, 0x87, 0xe5, 0x8d, 0x8f, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x2a, 0x12, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62,
+	0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d,
+	0x12, 0xbb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x3e, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x1b, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x8d,
+	0x9a, 0xe6, 0x96, 0x87, 0xe5, 0x8d, 0x8f, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0xe5, 0x88, 0x97,
+	0xe8, 0xa1, 0xa8, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d,
+	0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x8b,
 	0x02, 0x92, 0x41, 0xd4, 0x01, 0x12, 0xaa, 0x01, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
 	0x6f, 0x67, 0x20, 0x41, 0x50, 0x49, 0x22, 0x4f, 0x0a, 0x18, 0xe5, 0xb0, 0x8f, 0xe8, 0x80, 0x8c,
 	0xe7, 0xbe, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe9, 0xa1, 0xb9, 0xe7,
@@ -190,69 +737,271 @@ var file_apiserver_v1_apiserver_proto_rawDesc = []byte{
 }
 
 var file_apiserver_v1_apiserver_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),          // 0: google.protobuf.Empty
-	(*LoginRequest)(nil),           // 1: v1.LoginRequest
-	(*RefreshTokenRequest)(nil),    // 2: v1.RefreshTokenRequest
-	(*ChangePasswordRequest)(nil),  // 3: v1.ChangePasswordRequest
-	(*CreateUserRequest)(nil),      // 4: v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),      // 5: v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),      // 6: v1.DeleteUserRequest
-	(*GetUserRequest)(nil),         // 7: v1.GetUserRequest
-	(*ListUserRequest)(nil),        // 8: v1.ListUserRequest
-	(*CreatePostRequest)(nil),      // 9: v1.CreatePostRequest
-	(*UpdatePostRequest)(nil),      // 10: v1.UpdatePostRequest
-	(*DeletePostRequest)(nil),      // 11: v1.DeletePostRequest
-	(*GetPostRequest)(nil),         // 12: v1.GetPostRequest
-	(*ListPostRequest)(nil),        // 13: v1.ListPostRequest
-	(*HealthzResponse)(nil),        // 14: v1.HealthzResponse
-	(*LoginResponse)(nil),          // 15: v1.LoginResponse
-	(*RefreshTokenResponse)(nil),   // 16: v1.RefreshTokenResponse
-	(*ChangePasswordResponse)(nil), // 17: v1.ChangePasswordResponse
-	(*CreateUserResponse)(nil),     // 18: v1.CreateUserResponse
-	(*UpdateUserResponse)(nil),     // 19: v1.UpdateUserResponse
-	(*DeleteUserResponse)(nil),     // 20: v1.DeleteUserResponse
-	(*GetUserResponse)(nil),        // 21: v1.GetUserResponse
-	(*ListUserResponse)(nil),       // 22: v1.ListUserResponse
-	(*CreatePostResponse)(nil),     // 23: v1.CreatePostResponse
-	(*UpdatePostResponse)(nil),     // 24: v1.UpdatePostResponse
-	(*DeletePostResponse)(nil),     // 25: v1.DeletePostResponse
-	(*GetPostResponse)(nil),        // 26: v1.GetPostResponse
-	(*ListPostResponse)(nil),       // 27: v1.ListPostResponse
+	(*emptypb.Empty)(nil),                 // 0: google.protobuf.Empty
+	(*LoginRequest)(nil),                  // 1: v1.LoginRequest
+	(*RefreshTokenRequest)(nil),           // 2: v1.RefreshTokenRequest
+	(*ChangePasswordRequest)(nil),         // 3: v1.ChangePasswordRequest
+	(*CreateUserRequest)(nil),             // 4: v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),             // 5: v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),             // 6: v1.DeleteUserRequest
+	(*GetUserRequest)(nil),                // 7: v1.GetUserRequest
+	(*ListUserRequest)(nil),               // 8: v1.ListUserRequest
+	(*CreatePostRequest)(nil),             // 9: v1.CreatePostRequest
+	(*UpdatePostRequest)(nil),             // 10: v1.UpdatePostRequest
+	(*DeletePostRequest)(nil),             // 11: v1.DeletePostRequest
+	(*GetPostRequest)(nil),                // 12: v1.GetPostRequest
+	(*ListPostRequest)(nil),               // 13: v1.ListPostRequest
+	(*PublishPostRequest)(nil),            // 14: v1.PublishPostRequest
+	(*UnpublishPostRequest)(nil),          // 15: v1.UnpublishPostRequest
+	(*ArchivePostRequest)(nil),            // 16: v1.ArchivePostRequest
+	(*SchedulePostRequest)(nil),           // 17: v1.SchedulePostRequest
+	(*ListTagsRequest)(nil),               // 18: v1.ListTagsRequest
+	(*ListPostRevisionsRequest)(nil),      // 19: v1.ListPostRevisionsRequest
+	(*GetPostRevisionRequest)(nil),        // 20: v1.GetPostRevisionRequest
+	(*DiffPostRevisionsRequest)(nil),      // 21: v1.DiffPostRevisionsRequest
+	(*RestorePostRevisionRequest)(nil),    // 22: v1.RestorePostRevisionRequest
+	(*CreateCommentRequest)(nil),          // 23: v1.CreateCommentRequest
+	(*UpdateCommentRequest)(nil),          // 24: v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),          // 25: v1.DeleteCommentRequest
+	(*ListCommentRequest)(nil),            // 26: v1.ListCommentRequest
+	(*GetPublicPostRequest)(nil),          // 27: v1.GetPublicPostRequest
+	(*ListPublicPostsRequest)(nil),        // 28: v1.ListPublicPostsRequest
+	(*ListAuthorPublicPostsRequest)(nil),  // 29: v1.ListAuthorPublicPostsRequest
+	(*SearchPostsRequest)(nil),            // 30: v1.SearchPostsRequest
+	(*GetPostBySlugRequest)(nil),          // 31: v1.GetPostBySlugRequest
+	(*UploadMediaRequest)(nil),            // 32: v1.UploadMediaRequest
+	(*GetMediaRequest)(nil),               // 33: v1.GetMediaRequest
+	(*DeleteMediaRequest)(nil),            // 34: v1.DeleteMediaRequest
+	(*GetPublicMediaRequest)(nil),         // 35: v1.GetPublicMediaRequest
+	(*LikePostRequest)(nil),               // 36: v1.LikePostRequest
+	(*UnlikePostRequest)(nil),             // 37: v1.UnlikePostRequest
+	(*AddPostReactionRequest)(nil),        // 38: v1.AddPostReactionRequest
+	(*RemovePostReactionRequest)(nil),     // 39: v1.RemovePostReactionRequest
+	(*ListPostLikersRequest)(nil),         // 40: v1.ListPostLikersRequest
+	(*AddBookmarkRequest)(nil),            // 41: v1.AddBookmarkRequest
+	(*RemoveBookmarkRequest)(nil),         // 42: v1.RemoveBookmarkRequest
+	(*ListBookmarksRequest)(nil),          // 43: v1.ListBookmarksRequest
+	(*FollowUserRequest)(nil),             // 44: v1.FollowUserRequest
+	(*UnfollowUserRequest)(nil),           // 45: v1.UnfollowUserRequest
+	(*ListFollowersRequest)(nil),          // 46: v1.ListFollowersRequest
+	(*ListFollowingRequest)(nil),          // 47: v1.ListFollowingRequest
+	(*GetTimelineRequest)(nil),            // 48: v1.GetTimelineRequest
+	(*ListTrashedPostsRequest)(nil),       // 49: v1.ListTrashedPostsRequest
+	(*RestorePostRequest)(nil),            // 50: v1.RestorePostRequest
+	(*CreateSeriesRequest)(nil),           // 51: v1.CreateSeriesRequest
+	(*UpdateSeriesRequest)(nil),           // 52: v1.UpdateSeriesRequest
+	(*ReorderSeriesRequest)(nil),          // 53: v1.ReorderSeriesRequest
+	(*GetSeriesRequest)(nil),              // 54: v1.GetSeriesRequest
+	(*GetPostStatsRequest)(nil),           // 55: v1.GetPostStatsRequest
+	(*ImportPostsRequest)(nil),            // 56: v1.ImportPostsRequest
+	(*CreateExportJobRequest)(nil),        // 57: v1.CreateExportJobRequest
+	(*GetExportJobRequest)(nil),           // 58: v1.GetExportJobRequest
+	(*ExportPostsRequest)(nil),            // 59: v1.ExportPostsRequest
+	(*SharePostRequest)(nil),              // 60: v1.SharePostRequest
+	(*UnsharePostRequest)(nil),            // 61: v1.UnsharePostRequest
+	(*AddCollaboratorRequest)(nil),        // 62: v1.AddCollaboratorRequest
+	(*RemoveCollaboratorRequest)(nil),     // 63: v1.RemoveCollaboratorRequest
+	(*ListCollaboratorsRequest)(nil),      // 64: v1.ListCollaboratorsRequest
+	(*HealthzResponse)(nil),               // 65: v1.HealthzResponse
+	(*LoginResponse)(nil),                 // 66: v1.LoginResponse
+	(*RefreshTokenResponse)(nil),          // 67: v1.RefreshTokenResponse
+	(*ChangePasswordResponse)(nil),        // 68: v1.ChangePasswordResponse
+	(*CreateUserResponse)(nil),            // 69: v1.CreateUserResponse
+	(*UpdateUserResponse)(nil),            // 70: v1.UpdateUserResponse
+	(*DeleteUserResponse)(nil),            // 71: v1.DeleteUserResponse
+	(*GetUserResponse)(nil),               // 72: v1.GetUserResponse
+	(*ListUserResponse)(nil),              // 73: v1.ListUserResponse
+	(*CreatePostResponse)(nil),            // 74: v1.CreatePostResponse
+	(*UpdatePostResponse)(nil),            // 75: v1.UpdatePostResponse
+	(*DeletePostResponse)(nil),            // 76: v1.DeletePostResponse
+	(*GetPostResponse)(nil),               // 77: v1.GetPostResponse
+	(*ListPostResponse)(nil),              // 78: v1.ListPostResponse
+	(*PublishPostResponse)(nil),           // 79: v1.PublishPostResponse
+	(*UnpublishPostResponse)(nil),         // 80: v1.UnpublishPostResponse
+	(*ArchivePostResponse)(nil),           // 81: v1.ArchivePostResponse
+	(*SchedulePostResponse)(nil),          // 82: v1.SchedulePostResponse
+	(*ListTagsResponse)(nil),              // 83: v1.ListTagsResponse
+	(*ListPostRevisionsResponse)(nil),     // 84: v1.ListPostRevisionsResponse
+	(*GetPostRevisionResponse)(nil),       // 85: v1.GetPostRevisionResponse
+	(*DiffPostRevisionsResponse)(nil),     // 86: v1.DiffPostRevisionsResponse
+	(*RestorePostRevisionResponse)(nil),   // 87: v1.RestorePostRevisionResponse
+	(*CreateCommentResponse)(nil),         // 88: v1.CreateCommentResponse
+	(*UpdateCommentResponse)(nil),         // 89: v1.UpdateCommentResponse
+	(*DeleteCommentResponse)(nil),         // 90: v1.DeleteCommentResponse
+	(*ListCommentResponse)(nil),           // 91: v1.ListCommentResponse
+	(*GetPublicPostResponse)(nil),         // 92: v1.GetPublicPostResponse
+	(*ListPublicPostsResponse)(nil),       // 93: v1.ListPublicPostsResponse
+	(*ListAuthorPublicPostsResponse)(nil), // 94: v1.ListAuthorPublicPostsResponse
+	(*SearchPostsResponse)(nil),           // 95: v1.SearchPostsResponse
+	(*GetPostBySlugResponse)(nil),         // 96: v1.GetPostBySlugResponse
+	(*UploadMediaResponse)(nil),           // 97: v1.UploadMediaResponse
+	(*httpbody.HttpBody)(nil),             // 98: google.api.HttpBody
+	(*DeleteMediaResponse)(nil),           // 99: v1.DeleteMediaResponse
+	(*LikePostResponse)(nil),              // 100: v1.LikePostResponse
+	(*UnlikePostResponse)(nil),            // 101: v1.UnlikePostResponse
+	(*AddPostReactionResponse)(nil),       // 102: v1.AddPostReactionResponse
+	(*RemovePostReactionResponse)(nil),    // 103: v1.RemovePostReactionResponse
+	(*ListPostLikersResponse)(nil),        // 104: v1.ListPostLikersResponse
+	(*AddBookmarkResponse)(nil),           // 105: v1.AddBookmarkResponse
+	(*RemoveBookmarkResponse)(nil),        // 106: v1.RemoveBookmarkResponse
+	(*ListBookmarksResponse)(nil),         // 107: v1.ListBookmarksResponse
+	(*FollowUserResponse)(nil),            // 108: v1.FollowUserResponse
+	(*UnfollowUserResponse)(nil),          // 109: v1.UnfollowUserResponse
+	(*ListFollowersResponse)(nil),         // 110: v1.ListFollowersResponse
+	(*ListFollowingResponse)(nil),         // 111: v1.ListFollowingResponse
+	(*GetTimelineResponse)(nil),           // 112: v1.GetTimelineResponse
+	(*ListTrashedPostsResponse)(nil),      // 113: v1.ListTrashedPostsResponse
+	(*RestorePostResponse)(nil),           // 114: v1.RestorePostResponse
+	(*CreateSeriesResponse)(nil),          // 115: v1.CreateSeriesResponse
+	(*UpdateSeriesResponse)(nil),          // 116: v1.UpdateSeriesResponse
+	(*ReorderSeriesResponse)(nil),         // 117: v1.ReorderSeriesResponse
+	(*GetSeriesResponse)(nil),             // 118: v1.GetSeriesResponse
+	(*GetPostStatsResponse)(nil),          // 119: v1.GetPostStatsResponse
+	(*ImportPostsResponse)(nil),           // 120: v1.ImportPostsResponse
+	(*CreateExportJobResponse)(nil),       // 121: v1.CreateExportJobResponse
+	(*GetExportJobResponse)(nil),          // 122: v1.GetExportJobResponse
+	(*SharePostResponse)(nil),             // 123: v1.SharePostResponse
+	(*UnsharePostResponse)(nil),           // 124: v1.UnsharePostResponse
+	(*AddCollaboratorResponse)(nil),       // 125: v1.AddCollaboratorResponse
+	(*RemoveCollaboratorResponse)(nil),    // 126: v1.RemoveCollaboratorResponse
+	(*ListCollaboratorsResponse)(nil),     // 127: v1.ListCollaboratorsResponse
 }
 var file_apiserver_v1_apiserver_proto_depIdxs = []int32{
-	0,  // 0: v1.MiniBlog.Healthz:input_type -> google.protobuf.Empty
-	1,  // 1: v1.MiniBlog.Login:input_type -> v1.LoginRequest
-	2,  // 2: v1.MiniBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
-	3,  // 3: v1.MiniBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
-	4,  // 4: v1.MiniBlog.CreateUser:input_type -> v1.CreateUserRequest
-	5,  // 5: v1.MiniBlog.UpdateUser:input_type -> v1.UpdateUserRequest
-	6,  // 6: v1.MiniBlog.DeleteUser:input_type -> v1.DeleteUserRequest
-	7,  // 7: v1.MiniBlog.GetUser:input_type -> v1.GetUserRequest
-	8,  // 8: v1.MiniBlog.ListUser:input_type -> v1.ListUserRequest
-	9,  // 9: v1.MiniBlog.CreatePost:input_type -> v1.CreatePostRequest
-	10, // 10: v1.MiniBlog.UpdatePost:input_type -> v1.UpdatePostRequest
-	11, // 11: v1.MiniBlog.DeletePost:input_type -> v1.DeletePostRequest
-	12, // 12: v1.MiniBlog.GetPost:input_type -> v1.GetPostRequest
-	13, // 13: v1.MiniBlog.ListPost:input_type -> v1.ListPostRequest
-	14, // 14: v1.MiniBlog.Healthz:output_type -> v1.HealthzResponse
-	15, // 15: v1.MiniBlog.Login:output_type -> v1.LoginResponse
-	16, // 16: v1.MiniBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
-	17, // 17: v1.MiniBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
-	18, // 18: v1.MiniBlog.CreateUser:output_type -> v1.CreateUserResponse
-	19, // 19: v1.MiniBlog.UpdateUser:output_type -> v1.UpdateUserResponse
-	20, // 20: v1.MiniBlog.DeleteUser:output_type -> v1.DeleteUserResponse
-	21, // 21: v1.MiniBlog.GetUser:output_type -> v1.GetUserResponse
-	22, // 22: v1.MiniBlog.ListUser:output_type -> v1.ListUserResponse
-	23, // 23: v1.MiniBlog.CreatePost:output_type -> v1.CreatePostResponse
-	24, // 24: v1.MiniBlog.UpdatePost:output_type -> v1.UpdatePostResponse
-	25, // 25: v1.MiniBlog.DeletePost:output_type -> v1.DeletePostResponse
-	26, // 26: v1.MiniBlog.GetPost:output_type -> v1.GetPostResponse
-	27, // 27: v1.MiniBlog.ListPost:output_type -> v1.ListPostResponse
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: v1.MiniBlog.Healthz:input_type -> google.protobuf.Empty
+	1,   // 1: v1.MiniBlog.Login:input_type -> v1.LoginRequest
+	2,   // 2: v1.MiniBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
+	3,   // 3: v1.MiniBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
+	4,   // 4: v1.MiniBlog.CreateUser:input_type -> v1.CreateUserRequest
+	5,   // 5: v1.MiniBlog.UpdateUser:input_type -> v1.UpdateUserRequest
+	6,   // 6: v1.MiniBlog.DeleteUser:input_type -> v1.DeleteUserRequest
+	7,   // 7: v1.MiniBlog.GetUser:input_type -> v1.GetUserRequest
+	8,   // 8: v1.MiniBlog.ListUser:input_type -> v1.ListUserRequest
+	9,   // 9: v1.MiniBlog.CreatePost:input_type -> v1.CreatePostRequest
+	10,  // 10: v1.MiniBlog.UpdatePost:input_type -> v1.UpdatePostRequest
+	11,  // 11: v1.MiniBlog.DeletePost:input_type -> v1.DeletePostRequest
+	12,  // 12: v1.MiniBlog.GetPost:input_type -> v1.GetPostRequest
+	13,  // 13: v1.MiniBlog.ListPost:input_type -> v1.ListPostRequest
+	14,  // 14: v1.MiniBlog.PublishPost:input_type -> v1.PublishPostRequest
+	15,  // 15: v1.MiniBlog.UnpublishPost:input_type -> v1.UnpublishPostRequest
+	16,  // 16: v1.MiniBlog.ArchivePost:input_type -> v1.ArchivePostRequest
+	17,  // 17: v1.MiniBlog.SchedulePost:input_type -> v1.SchedulePostRequest
+	18,  // 18: v1.MiniBlog.ListTags:input_type -> v1.ListTagsRequest
+	19,  // 19: v1.MiniBlog.ListPostRevisions:input_type -> v1.ListPostRevisionsRequest
+	20,  // 20: v1.MiniBlog.GetPostRevision:input_type -> v1.GetPostRevisionRequest
+	21,  // 21: v1.MiniBlog.DiffPostRevisions:input_type -> v1.DiffPostRevisionsRequest
+	22,  // 22: v1.MiniBlog.RestorePostRevision:input_type -> v1.RestorePostRevisionRequest
+	23,  // 23: v1.MiniBlog.CreateComment:input_type -> v1.CreateCommentRequest
+	24,  // 24: v1.MiniBlog.UpdateComment:input_type -> v1.UpdateCommentRequest
+	25,  // 25: v1.MiniBlog.DeleteComment:input_type -> v1.DeleteCommentRequest
+	26,  // 26: v1.MiniBlog.ListComment:input_type -> v1.ListCommentRequest
+	27,  // 27: v1.MiniBlog.GetPublicPost:input_type -> v1.GetPublicPostRequest
+	28,  // 28: v1.MiniBlog.ListPublicPosts:input_type -> v1.ListPublicPostsRequest
+	29,  // 29: v1.MiniBlog.ListAuthorPublicPosts:input_type -> v1.ListAuthorPublicPostsRequest
+	30,  // 30: v1.MiniBlog.SearchPosts:input_type -> v1.SearchPostsRequest
+	31,  // 31: v1.MiniBlog.GetPostBySlug:input_type -> v1.GetPostBySlugRequest
+	32,  // 32: v1.MiniBlog.UploadMedia:input_type -> v1.UploadMediaRequest
+	33,  // 33: v1.MiniBlog.GetMedia:input_type -> v1.GetMediaRequest
+	34,  // 34: v1.MiniBlog.DeleteMedia:input_type -> v1.DeleteMediaRequest
+	35,  // 35: v1.MiniBlog.GetPublicMedia:input_type -> v1.GetPublicMediaRequest
+	36,  // 36: v1.MiniBlog.LikePost:input_type -> v1.LikePostRequest
+	37,  // 37: v1.MiniBlog.UnlikePost:input_type -> v1.UnlikePostRequest
+	38,  // 38: v1.MiniBlog.AddPostReaction:input_type -> v1.AddPostReactionRequest
+	39,  // 39: v1.MiniBlog.RemovePostReaction:input_type -> v1.RemovePostReactionRequest
+	40,  // 40: v1.MiniBlog.ListPostLikers:input_type -> v1.ListPostLikersRequest
+	41,  // 41: v1.MiniBlog.AddBookmark:input_type -> v1.AddBookmarkRequest
+	42,  // 42: v1.MiniBlog.RemoveBookmark:input_type -> v1.RemoveBookmarkRequest
+	43,  // 43: v1.MiniBlog.ListBookmarks:input_type -> v1.ListBookmarksRequest
+	44,  // 44: v1.MiniBlog.FollowUser:input_type -> v1.FollowUserRequest
+	45,  // 45: v1.MiniBlog.UnfollowUser:input_type -> v1.UnfollowUserRequest
+	46,  // 46: v1.MiniBlog.ListFollowers:input_type -> v1.ListFollowersRequest
+	47,  // 47: v1.MiniBlog.ListFollowing:input_type -> v1.ListFollowingRequest
+	48,  // 48: v1.MiniBlog.GetTimeline:input_type -> v1.GetTimelineRequest
+	49,  // 49: v1.MiniBlog.ListTrashedPosts:input_type -> v1.ListTrashedPostsRequest
+	50,  // 50: v1.MiniBlog.RestorePost:input_type -> v1.RestorePostRequest
+	51,  // 51: v1.MiniBlog.CreateSeries:input_type -> v1.CreateSeriesRequest
+	52,  // 52: v1.MiniBlog.UpdateSeries:input_type -> v1.UpdateSeriesRequest
+	53,  // 53: v1.MiniBlog.ReorderSeries:input_type -> v1.ReorderSeriesRequest
+	54,  // 54: v1.MiniBlog.GetSeries:input_type -> v1.GetSeriesRequest
+	55,  // 55: v1.MiniBlog.GetPostStats:input_type -> v1.GetPostStatsRequest
+	56,  // 56: v1.MiniBlog.ImportPosts:input_type -> v1.ImportPostsRequest
+	57,  // 57: v1.MiniBlog.CreateExportJob:input_type -> v1.CreateExportJobRequest
+	58,  // 58: v1.MiniBlog.GetExportJob:input_type -> v1.GetExportJobRequest
+	59,  // 59: v1.MiniBlog.ExportPosts:input_type -> v1.ExportPostsRequest
+	60,  // 60: v1.MiniBlog.SharePost:input_type -> v1.SharePostRequest
+	61,  // 61: v1.MiniBlog.UnsharePost:input_type -> v1.UnsharePostRequest
+	62,  // 62: v1.MiniBlog.AddCollaborator:input_type -> v1.AddCollaboratorRequest
+	63,  // 63: v1.MiniBlog.RemoveCollaborator:input_type -> v1.RemoveCollaboratorRequest
+	64,  // 64: v1.MiniBlog.ListCollaborators:input_type -> v1.ListCollaboratorsRequest
+	65,  // 65: v1.MiniBlog.Healthz:output_type -> v1.HealthzResponse
+	66,  // 66: v1.MiniBlog.Login:output_type -> v1.LoginResponse
+	67,  // 67: v1.MiniBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
+	68,  // 68: v1.MiniBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
+	69,  // 69: v1.MiniBlog.CreateUser:output_type -> v1.CreateUserResponse
+	70,  // 70: v1.MiniBlog.UpdateUser:output_type -> v1.UpdateUserResponse
+	71,  // 71: v1.MiniBlog.DeleteUser:output_type -> v1.DeleteUserResponse
+	72,  // 72: v1.MiniBlog.GetUser:output_type -> v1.GetUserResponse
+	73,  // 73: v1.MiniBlog.ListUser:output_type -> v1.ListUserResponse
+	74,  // 74: v1.MiniBlog.CreatePost:output_type -> v1.CreatePostResponse
+	75,  // 75: v1.MiniBlog.UpdatePost:output_type -> v1.UpdatePostResponse
+	76,  // 76: v1.MiniBlog.DeletePost:output_type -> v1.DeletePostResponse
+	77,  // 77: v1.MiniBlog.GetPost:output_type -> v1.GetPostResponse
+	78,  // 78: v1.MiniBlog.ListPost:output_type -> v1.ListPostResponse
+	79,  // 79: v1.MiniBlog.PublishPost:output_type -> v1.PublishPostResponse
+	80,  // 80: v1.MiniBlog.UnpublishPost:output_type -> v1.UnpublishPostResponse
+	81,  // 81: v1.MiniBlog.ArchivePost:output_type -> v1.ArchivePostResponse
+	82,  // 82: v1.MiniBlog.SchedulePost:output_type -> v1.SchedulePostResponse
+	83,  // 83: v1.MiniBlog.ListTags:output_type -> v1.ListTagsResponse
+	84,  // 84: v1.MiniBlog.ListPostRevisions:output_type -> v1.ListPostRevisionsResponse
+	85,  // 85: v1.MiniBlog.GetPostRevision:output_type -> v1.GetPostRevisionResponse
+	86,  // 86: v1.MiniBlog.DiffPostRevisions:output_type -> v1.DiffPostRevisionsResponse
+	87,  // 87: v1.MiniBlog.RestorePostRevision:output_type -> v1.RestorePostRevisionResponse
+	88,  // 88: v1.MiniBlog.CreateComment:output_type -> v1.CreateCommentResponse
+	89,  // 89: v1.MiniBlog.UpdateComment:output_type -> v1.UpdateCommentResponse
+	90,  // 90: v1.MiniBlog.DeleteComment:output_type -> v1.DeleteCommentResponse
+	91,  // 91: v1.MiniBlog.ListComment:output_type -> v1.ListCommentResponse
+	92,  // 92: v1.MiniBlog.GetPublicPost:output_type -> v1.GetPublicPostResponse
+	93,  // 93: v1.MiniBlog.ListPublicPosts:output_type -> v1.ListPublicPostsResponse
+	94,  // 94: v1.MiniBlog.ListAuthorPublicPosts:output_type -> v1.ListAuthorPublicPostsResponse
+	95,  // 95: v1.MiniBlog.SearchPosts:output_type -> v1.SearchPostsResponse
+	96,  // 96: v1.MiniBlog.GetPostBySlug:output_type -> v1.GetPostBySlugResponse
+	97,  // 97: v1.MiniBlog.UploadMedia:output_type -> v1.UploadMediaResponse
+	98,  // 98: v1.MiniBlog.GetMedia:output_type -> google.api.HttpBody
+	99,  // 99: v1.MiniBlog.DeleteMedia:output_type -> v1.DeleteMediaResponse
+	98,  // 100: v1.MiniBlog.GetPublicMedia:output_type -> google.api.HttpBody
+	100, // 101: v1.MiniBlog.LikePost:output_type -> v1.LikePostResponse
+	101, // 102: v1.MiniBlog.UnlikePost:output_type -> v1.UnlikePostResponse
+	102, // 103: v1.MiniBlog.AddPostReaction:output_type -> v1.AddPostReactionResponse
+	103, // 104: v1.MiniBlog.RemovePostReaction:output_type -> v1.RemovePostReactionResponse
+	104, // 105: v1.MiniBlog.ListPostLikers:output_type -> v1.ListPostLikersResponse
+	105, // 106: v1.MiniBlog.AddBookmark:output_type -> v1.AddBookmarkResponse
+	106, // 107: v1.MiniBlog.RemoveBookmark:output_type -> v1.RemoveBookmarkResponse
+	107, // 108: v1.MiniBlog.ListBookmarks:output_type -> v1.ListBookmarksResponse
+	108, // 109: v1.MiniBlog.FollowUser:output_type -> v1.FollowUserResponse
+	109, // 110: v1.MiniBlog.UnfollowUser:output_type -> v1.UnfollowUserResponse
+	110, // 111: v1.MiniBlog.ListFollowers:output_type -> v1.ListFollowersResponse
+	111, // 112: v1.MiniBlog.ListFollowing:output_type -> v1.ListFollowingResponse
+	112, // 113: v1.MiniBlog.GetTimeline:output_type -> v1.GetTimelineResponse
+	113, // 114: v1.MiniBlog.ListTrashedPosts:output_type -> v1.ListTrashedPostsResponse
+	114, // 115: v1.MiniBlog.RestorePost:output_type -> v1.RestorePostResponse
+	115, // 116: v1.MiniBlog.CreateSeries:output_type -> v1.CreateSeriesResponse
+	116, // 117: v1.MiniBlog.UpdateSeries:output_type -> v1.UpdateSeriesResponse
+	117, // 118: v1.MiniBlog.ReorderSeries:output_type -> v1.ReorderSeriesResponse
+	118, // 119: v1.MiniBlog.GetSeries:output_type -> v1.GetSeriesResponse
+	119, // 120: v1.MiniBlog.GetPostStats:output_type -> v1.GetPostStatsResponse
+	120, // 121: v1.MiniBlog.ImportPosts:output_type -> v1.ImportPostsResponse
+	121, // 122: v1.MiniBlog.CreateExportJob:output_type -> v1.CreateExportJobResponse
+	122, // 123: v1.MiniBlog.GetExportJob:output_type -> v1.GetExportJobResponse
+	98,  // 124: v1.MiniBlog.ExportPosts:output_type -> google.api.HttpBody
+	123, // 125: v1.MiniBlog.SharePost:output_type -> v1.SharePostResponse
+	124, // 126: v1.MiniBlog.UnsharePost:output_type -> v1.UnsharePostResponse
+	125, // 127: v1.MiniBlog.AddCollaborator:output_type -> v1.AddCollaboratorResponse
+	126, // 128: v1.MiniBlog.RemoveCollaborator:output_type -> v1.RemoveCollaboratorResponse
+	127, // 129: v1.MiniBlog.ListCollaborators:output_type -> v1.ListCollaboratorsResponse
+	65,  // [65:130] is the sub-list for method output_type
+	0,   // [0:65] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_apiserver_proto_init() }
@@ -263,6 +1012,20 @@ func file_apiserver_v1_apiserver_proto_init() {
 	file_apiserver_v1_healthz_proto_init()
 	file_apiserver_v1_post_proto_init()
 	file_apiserver_v1_user_proto_init()
+	file_apiserver_v1_post_revision_proto_init()
+	file_apiserver_v1_comment_proto_init()
+	file_apiserver_v1_public_post_proto_init()
+	file_apiserver_v1_post_search_proto_init()
+	file_apiserver_v1_media_proto_init()
+	file_apiserver_v1_post_reaction_proto_init()
+	file_apiserver_v1_bookmark_proto_init()
+	file_apiserver_v1_follow_proto_init()
+	file_apiserver_v1_series_proto_init()
+	file_apiserver_v1_post_stats_proto_init()
+	file_apiserver_v1_post_import_proto_init()
+	file_apiserver_v1_post_export_proto_init()
+	file_apiserver_v1_post_share_proto_init()
+	file_apiserver_v1_post_collaborator_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -24,6 +24,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -36,20 +37,71 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MiniBlog_Healthz_FullMethodName        = "/v1.MiniBlog/Healthz"
-	MiniBlog_Login_FullMethodName          = "/v1.MiniBlog/Login"
-	MiniBlog_RefreshToken_FullMethodName   = "/v1.MiniBlog/RefreshToken"
-	MiniBlog_ChangePassword_FullMethodName = "/v1.MiniBlog/ChangePassword"
-	MiniBlog_CreateUser_FullMethodName     = "/v1.MiniBlog/CreateUser"
-	MiniBlog_UpdateUser_FullMethodName     = "/v1.MiniBlog/UpdateUser"
-	MiniBlog_DeleteUser_FullMethodName     = "/v1.MiniBlog/DeleteUser"
-	MiniBlog_GetUser_FullMethodName        = "/v1.MiniBlog/GetUser"
-	MiniBlog_ListUser_FullMethodName       = "/v1.MiniBlog/ListUser"
-	MiniBlog_CreatePost_FullMethodName     = "/v1.MiniBlog/CreatePost"
-	MiniBlog_UpdatePost_FullMethodName     = "/v1.MiniBlog/UpdatePost"
-	MiniBlog_DeletePost_FullMethodName     = "/v1.MiniBlog/DeletePost"
-	MiniBlog_GetPost_FullMethodName        = "/v1.MiniBlog/GetPost"
-	MiniBlog_ListPost_FullMethodName       = "/v1.MiniBlog/ListPost"
+	MiniBlog_Healthz_FullMethodName               = "/v1.MiniBlog/Healthz"
+	MiniBlog_Login_FullMethodName                 = "/v1.MiniBlog/Login"
+	MiniBlog_RefreshToken_FullMethodName          = "/v1.MiniBlog/RefreshToken"
+	MiniBlog_ChangePassword_FullMethodName        = "/v1.MiniBlog/ChangePassword"
+	MiniBlog_CreateUser_FullMethodName            = "/v1.MiniBlog/CreateUser"
+	MiniBlog_UpdateUser_FullMethodName            = "/v1.MiniBlog/UpdateUser"
+	MiniBlog_DeleteUser_FullMethodName            = "/v1.MiniBlog/DeleteUser"
+	MiniBlog_GetUser_FullMethodName               = "/v1.MiniBlog/GetUser"
+	MiniBlog_ListUser_FullMethodName              = "/v1.MiniBlog/ListUser"
+	MiniBlog_CreatePost_FullMethodName            = "/v1.MiniBlog/CreatePost"
+	MiniBlog_UpdatePost_FullMethodName            = "/v1.MiniBlog/UpdatePost"
+	MiniBlog_DeletePost_FullMethodName            = "/v1.MiniBlog/DeletePost"
+	MiniBlog_GetPost_FullMethodName               = "/v1.MiniBlog/GetPost"
+	MiniBlog_ListPost_FullMethodName              = "/v1.MiniBlog/ListPost"
+	MiniBlog_PublishPost_FullMethodName           = "/v1.MiniBlog/PublishPost"
+	MiniBlog_UnpublishPost_FullMethodName         = "/v1.MiniBlog/UnpublishPost"
+	MiniBlog_ArchivePost_FullMethodName           = "/v1.MiniBlog/ArchivePost"
+	MiniBlog_SchedulePost_FullMethodName          = "/v1.MiniBlog/SchedulePost"
+	MiniBlog_ListTags_FullMethodName              = "/v1.MiniBlog/ListTags"
+	MiniBlog_ListPostRevisions_FullMethodName     = "/v1.MiniBlog/ListPostRevisions"
+	MiniBlog_GetPostRevision_FullMethodName       = "/v1.MiniBlog/GetPostRevision"
+	MiniBlog_DiffPostRevisions_FullMethodName     = "/v1.MiniBlog/DiffPostRevisions"
+	MiniBlog_RestorePostRevision_FullMethodName   = "/v1.MiniBlog/RestorePostRevision"
+	MiniBlog_CreateComment_FullMethodName         = "/v1.MiniBlog/CreateComment"
+	MiniBlog_UpdateComment_FullMethodName         = "/v1.MiniBlog/UpdateComment"
+	MiniBlog_DeleteComment_FullMethodName         = "/v1.MiniBlog/DeleteComment"
+	MiniBlog_ListComment_FullMethodName           = "/v1.MiniBlog/ListComment"
+	MiniBlog_GetPublicPost_FullMethodName         = "/v1.MiniBlog/GetPublicPost"
+	MiniBlog_ListPublicPosts_FullMethodName       = "/v1.MiniBlog/ListPublicPosts"
+	MiniBlog_ListAuthorPublicPosts_FullMethodName = "/v1.MiniBlog/ListAuthorPublicPosts"
+	MiniBlog_SearchPosts_FullMethodName           = "/v1.MiniBlog/SearchPosts"
+	MiniBlog_GetPostBySlug_FullMethodName         = "/v1.MiniBlog/GetPostBySlug"
+	MiniBlog_UploadMedia_FullMethodName           = "/v1.MiniBlog/UploadMedia"
+	MiniBlog_GetMedia_FullMethodName              = "/v1.MiniBlog/GetMedia"
+	MiniBlog_DeleteMedia_FullMethodName           = "/v1.MiniBlog/DeleteMedia"
+	MiniBlog_GetPublicMedia_FullMethodName        = "/v1.MiniBlog/GetPublicMedia"
+	MiniBlog_LikePost_FullMethodName              = "/v1.MiniBlog/LikePost"
+	MiniBlog_UnlikePost_FullMethodName            = "/v1.MiniBlog/UnlikePost"
+	MiniBlog_AddPostReaction_FullMethodName       = "/v1.MiniBlog/AddPostReaction"
+	MiniBlog_RemovePostReaction_FullMethodName    = "/v1.MiniBlog/RemovePostReaction"
+	MiniBlog_ListPostLikers_FullMethodName        = "/v1.MiniBlog/ListPostLikers"
+	MiniBlog_AddBookmark_FullMethodName           = "/v1.MiniBlog/AddBookmark"
+	MiniBlog_RemoveBookmark_FullMethodName        = "/v1.MiniBlog/RemoveBookmark"
+	MiniBlog_ListBookmarks_FullMethodName         = "/v1.MiniBlog/ListBookmarks"
+	MiniBlog_FollowUser_FullMethodName            = "/v1.MiniBlog/FollowUser"
+	MiniBlog_UnfollowUser_FullMethodName          = "/v1.MiniBlog/UnfollowUser"
+	MiniBlog_ListFollowers_FullMethodName         = "/v1.MiniBlog/ListFollowers"
+	MiniBlog_ListFollowing_FullMethodName         = "/v1.MiniBlog/ListFollowing"
+	MiniBlog_GetTimeline_FullMethodName           = "/v1.MiniBlog/GetTimeline"
+	MiniBlog_ListTrashedPosts_FullMethodName      = "/v1.MiniBlog/ListTrashedPosts"
+	MiniBlog_RestorePost_FullMethodName           = "/v1.MiniBlog/RestorePost"
+	MiniBlog_CreateSeries_FullMethodName          = "/v1.MiniBlog/CreateSeries"
+	MiniBlog_UpdateSeries_FullMethodName          = "/v1.MiniBlog/UpdateSeries"
+	MiniBlog_ReorderSeries_FullMethodName         = "/v1.MiniBlog/ReorderSeries"
+	MiniBlog_GetSeries_FullMethodName             = "/v1.MiniBlog/GetSeries"
+	MiniBlog_GetPostStats_FullMethodName          = "/v1.MiniBlog/GetPostStats"
+	MiniBlog_ImportPosts_FullMethodName           = "/v1.MiniBlog/ImportPosts"
+	MiniBlog_CreateExportJob_FullMethodName       = "/v1.MiniBlog/CreateExportJob"
+	MiniBlog_GetExportJob_FullMethodName          = "/v1.MiniBlog/GetExportJob"
+	MiniBlog_ExportPosts_FullMethodName           = "/v1.MiniBlog/ExportPosts"
+	MiniBlog_SharePost_FullMethodName             = "/v1.MiniBlog/SharePost"
+	MiniBlog_UnsharePost_FullMethodName           = "/v1.MiniBlog/UnsharePost"
+	MiniBlog_AddCollaborator_FullMethodName       = "/v1.MiniBlog/AddCollaborator"
+	MiniBlog_RemoveCollaborator_FullMethodName    = "/v1.MiniBlog/RemoveCollaborator"
+	MiniBlog_ListCollaborators_FullMethodName     = "/v1.MiniBlog/ListCollaborators"
 )
 
 // MiniBlogClient is the client API for MiniBlog service.
@@ -86,6 +138,109 @@ type MiniBlogClient interface {
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	// ListPost 列出所有文章
 	ListPost(ctx context.Context, in *ListPostRequest, opts ...grpc.CallOption) (*ListPostResponse, error)
+	// PublishPost 发布文章
+	PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error)
+	// UnpublishPost 撤回已发布的文章，使其重新成为草稿
+	UnpublishPost(ctx context.Context, in *UnpublishPostRequest, opts ...grpc.CallOption) (*UnpublishPostResponse, error)
+	// ArchivePost 归档文章
+	ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*ArchivePostResponse, error)
+	// SchedulePost 设置文章定时发布
+	SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error)
+	// ListTags 列出所有标签
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// ListPostRevisions 列出文章的历史版本
+	ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error)
+	// GetPostRevision 获取文章的历史版本
+	GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*GetPostRevisionResponse, error)
+	// DiffPostRevisions 比较文章的两个历史版本
+	DiffPostRevisions(ctx context.Context, in *DiffPostRevisionsRequest, opts ...grpc.CallOption) (*DiffPostRevisionsResponse, error)
+	// RestorePostRevision 将文章回滚到指定历史版本
+	RestorePostRevision(ctx context.Context, in *RestorePostRevisionRequest, opts ...grpc.CallOption) (*RestorePostRevisionResponse, error)
+	// CreateComment 创建评论
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	// UpdateComment 更新评论
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	// DeleteComment 删除评论
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	// ListComment 列出博文的评论
+	ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error)
+	// GetPublicPost 获取已发布的文章
+	GetPublicPost(ctx context.Context, in *GetPublicPostRequest, opts ...grpc.CallOption) (*GetPublicPostResponse, error)
+	// ListPublicPosts 列出所有已发布的文章
+	ListPublicPosts(ctx context.Context, in *ListPublicPostsRequest, opts ...grpc.CallOption) (*ListPublicPostsResponse, error)
+	// ListAuthorPublicPosts 列出指定作者已发布的文章
+	ListAuthorPublicPosts(ctx context.Context, in *ListAuthorPublicPostsRequest, opts ...grpc.CallOption) (*ListAuthorPublicPostsResponse, error)
+	// SearchPosts 全文检索文章
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error)
+	// GetPostBySlug 通过 slug 获取已发布文章
+	GetPostBySlug(ctx context.Context, in *GetPostBySlugRequest, opts ...grpc.CallOption) (*GetPostBySlugResponse, error)
+	// UploadMedia 上传媒体文件
+	UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*UploadMediaResponse, error)
+	// GetMedia 下载媒体文件
+	GetMedia(ctx context.Context, in *GetMediaRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// DeleteMedia 删除媒体文件
+	DeleteMedia(ctx context.Context, in *DeleteMediaRequest, opts ...grpc.CallOption) (*DeleteMediaResponse, error)
+	// GetPublicMedia 下载已发布博文中的媒体文件
+	GetPublicMedia(ctx context.Context, in *GetPublicMediaRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// LikePost 点赞博客
+	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
+	// UnlikePost 取消点赞博客
+	UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*UnlikePostResponse, error)
+	// AddPostReaction 添加表情回应
+	AddPostReaction(ctx context.Context, in *AddPostReactionRequest, opts ...grpc.CallOption) (*AddPostReactionResponse, error)
+	// RemovePostReaction 移除表情回应
+	RemovePostReaction(ctx context.Context, in *RemovePostReactionRequest, opts ...grpc.CallOption) (*RemovePostReactionResponse, error)
+	// ListPostLikers 获取博客点赞用户列表
+	ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error)
+	// AddBookmark 收藏博客
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error)
+	// RemoveBookmark 取消收藏博客
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	// ListBookmarks 获取阅读列表
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error)
+	// FollowUser 关注用户
+	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
+	// UnfollowUser 取消关注用户
+	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
+	// ListFollowers 获取粉丝列表
+	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error)
+	// ListFollowing 获取关注列表
+	ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error)
+	// GetTimeline 获取首页时间线
+	GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error)
+	// ListTrashedPosts 列出回收站中的文章
+	ListTrashedPosts(ctx context.Context, in *ListTrashedPostsRequest, opts ...grpc.CallOption) (*ListTrashedPostsResponse, error)
+	// RestorePost 从回收站恢复文章
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
+	// CreateSeries 创建系列
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error)
+	// UpdateSeries 更新系列
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*UpdateSeriesResponse, error)
+	// ReorderSeries 调整系列中博文的顺序
+	ReorderSeries(ctx context.Context, in *ReorderSeriesRequest, opts ...grpc.CallOption) (*ReorderSeriesResponse, error)
+	// GetSeries 获取系列信息
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error)
+	// GetPostStats 获取博文浏览统计
+	GetPostStats(ctx context.Context, in *GetPostStatsRequest, opts ...grpc.CallOption) (*GetPostStatsResponse, error)
+	// ImportPosts 批量导入 Markdown 博文
+	ImportPosts(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportPostsRequest, ImportPostsResponse], error)
+	// CreateExportJob 创建博文导出任务
+	CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*CreateExportJobResponse, error)
+	// GetExportJob 查询博文导出任务
+	GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error)
+	// ExportPosts 下载导出文件，导出文件按块以流的形式返回.
+	// 通过 grpc-gateway 访问时由 InstallExportHandlers 注册的 /v1/export 和 /v1/exports/{jobID}/download 接口直接返回文件内容
+	ExportPosts(ctx context.Context, in *ExportPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// SharePost 将博文分享给指定用户
+	SharePost(ctx context.Context, in *SharePostRequest, opts ...grpc.CallOption) (*SharePostResponse, error)
+	// UnsharePost 取消将博文分享给指定用户
+	UnsharePost(ctx context.Context, in *UnsharePostRequest, opts ...grpc.CallOption) (*UnsharePostResponse, error)
+	// AddCollaborator 邀请博文协作者或修改协作者角色
+	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error)
+	// RemoveCollaborator 移除博文协作者
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	// ListCollaborators 获取博文协作者列表
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
 }
 
 type miniBlogClient struct {
@@ -236,6 +391,528 @@ func (c *miniBlogClient) ListPost(ctx context.Context, in *ListPostRequest, opts
 	return out, nil
 }
 
+func (c *miniBlogClient) PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishPostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_PublishPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UnpublishPost(ctx context.Context, in *UnpublishPostRequest, opts ...grpc.CallOption) (*UnpublishPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishPostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnpublishPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*ArchivePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ArchivePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) SchedulePost(ctx context.Context, in *SchedulePostRequest, opts ...grpc.CallOption) (*SchedulePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_SchedulePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPostRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*GetPostRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostRevisionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) DiffPostRevisions(ctx context.Context, in *DiffPostRevisionsRequest, opts ...grpc.CallOption) (*DiffPostRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_DiffPostRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RestorePostRevision(ctx context.Context, in *RestorePostRevisionRequest, opts ...grpc.CallOption) (*RestorePostRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostRevisionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RestorePostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPublicPost(ctx context.Context, in *GetPublicPostRequest, opts ...grpc.CallOption) (*GetPublicPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicPostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPublicPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPublicPosts(ctx context.Context, in *ListPublicPostsRequest, opts ...grpc.CallOption) (*ListPublicPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPublicPostsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPublicPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListAuthorPublicPosts(ctx context.Context, in *ListAuthorPublicPostsRequest, opts ...grpc.CallOption) (*ListAuthorPublicPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthorPublicPostsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListAuthorPublicPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPostBySlug(ctx context.Context, in *GetPostBySlugRequest, opts ...grpc.CallOption) (*GetPostBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostBySlugResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPostBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*UploadMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadMediaResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UploadMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetMedia(ctx context.Context, in *GetMediaRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, MiniBlog_GetMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) DeleteMedia(ctx context.Context, in *DeleteMediaRequest, opts ...grpc.CallOption) (*DeleteMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMediaResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_DeleteMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPublicMedia(ctx context.Context, in *GetPublicMediaRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPublicMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LikePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_LikePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*UnlikePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlikePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnlikePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) AddPostReaction(ctx context.Context, in *AddPostReactionRequest, opts ...grpc.CallOption) (*AddPostReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPostReactionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_AddPostReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RemovePostReaction(ctx context.Context, in *RemovePostReactionRequest, opts ...grpc.CallOption) (*RemovePostReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePostReactionResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RemovePostReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostLikersResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListPostLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookmarkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_AddBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RemoveBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarksResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowUserResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_FollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowUserResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnfollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowersResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowingResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetTimeline(ctx context.Context, in *GetTimelineRequest, opts ...grpc.CallOption) (*GetTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimelineResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListTrashedPosts(ctx context.Context, in *ListTrashedPostsRequest, opts ...grpc.CallOption) (*ListTrashedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedPostsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListTrashedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*CreateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSeriesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*UpdateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSeriesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UpdateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ReorderSeries(ctx context.Context, in *ReorderSeriesRequest, opts ...grpc.CallOption) (*ReorderSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderSeriesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ReorderSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*GetSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeriesResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetPostStats(ctx context.Context, in *GetPostStatsRequest, opts ...grpc.CallOption) (*GetPostStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostStatsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetPostStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ImportPosts(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportPostsRequest, ImportPostsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MiniBlog_ServiceDesc.Streams[0], MiniBlog_ImportPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPostsRequest, ImportPostsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MiniBlog_ImportPostsClient = grpc.BidiStreamingClient[ImportPostsRequest, ImportPostsResponse]
+
+func (c *miniBlogClient) CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*CreateExportJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExportJobResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_CreateExportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportJobResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_GetExportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ExportPosts(ctx context.Context, in *ExportPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MiniBlog_ServiceDesc.Streams[1], MiniBlog_ExportPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPostsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MiniBlog_ExportPostsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *miniBlogClient) SharePost(ctx context.Context, in *SharePostRequest, opts ...grpc.CallOption) (*SharePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_SharePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) UnsharePost(ctx context.Context, in *UnsharePostRequest, opts ...grpc.CallOption) (*UnsharePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsharePostResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_UnsharePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCollaboratorResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_AddCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_RemoveCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miniBlogClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, MiniBlog_ListCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MiniBlogServer is the server API for MiniBlog service.
 // All implementations must embed UnimplementedMiniBlogServer
 // for forward compatibility.
@@ -270,327 +947,1483 @@ type MiniBlogServer interface {
 	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
 	// ListPost 列出所有文章
 	ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error)
+	// PublishPost 发布文章
+	PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error)
+	// UnpublishPost 撤回已发布的文章，使其重新成为草稿
+	UnpublishPost(context.Context, *UnpublishPostRequest) (*UnpublishPostResponse, error)
+	// ArchivePost 归档文章
+	ArchivePost(context.Context, *ArchivePostRequest) (*ArchivePostResponse, error)
+	// SchedulePost 设置文章定时发布
+	SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error)
+	// ListTags 列出所有标签
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// ListPostRevisions 列出文章的历史版本
+	ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error)
+	// GetPostRevision 获取文章的历史版本
+	GetPostRevision(context.Context, *GetPostRevisionRequest) (*GetPostRevisionResponse, error)
+	// DiffPostRevisions 比较文章的两个历史版本
+	DiffPostRevisions(context.Context, *DiffPostRevisionsRequest) (*DiffPostRevisionsResponse, error)
+	// RestorePostRevision 将文章回滚到指定历史版本
+	RestorePostRevision(context.Context, *RestorePostRevisionRequest) (*RestorePostRevisionResponse, error)
+	// CreateComment 创建评论
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	// UpdateComment 更新评论
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	// DeleteComment 删除评论
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// ListComment 列出博文的评论
+	ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error)
+	// GetPublicPost 获取已发布的文章
+	GetPublicPost(context.Context, *GetPublicPostRequest) (*GetPublicPostResponse, error)
+	// ListPublicPosts 列出所有已发布的文章
+	ListPublicPosts(context.Context, *ListPublicPostsRequest) (*ListPublicPostsResponse, error)
+	// ListAuthorPublicPosts 列出指定作者已发布的文章
+	ListAuthorPublicPosts(context.Context, *ListAuthorPublicPostsRequest) (*ListAuthorPublicPostsResponse, error)
+	// SearchPosts 全文检索文章
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	// GetPostBySlug 通过 slug 获取已发布文章
+	GetPostBySlug(context.Context, *GetPostBySlugRequest) (*GetPostBySlugResponse, error)
+	// UploadMedia 上传媒体文件
+	UploadMedia(context.Context, *UploadMediaRequest) (*UploadMediaResponse, error)
+	// GetMedia 下载媒体文件
+	GetMedia(context.Context, *GetMediaRequest) (*httpbody.HttpBody, error)
+	// DeleteMedia 删除媒体文件
+	DeleteMedia(context.Context, *DeleteMediaRequest) (*DeleteMediaResponse, error)
+	// GetPublicMedia 下载已发布博文中的媒体文件
+	GetPublicMedia(context.Context, *GetPublicMediaRequest) (*httpbody.HttpBody, error)
+	// LikePost 点赞博客
+	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
+	// UnlikePost 取消点赞博客
+	UnlikePost(context.Context, *UnlikePostRequest) (*UnlikePostResponse, error)
+	// AddPostReaction 添加表情回应
+	AddPostReaction(context.Context, *AddPostReactionRequest) (*AddPostReactionResponse, error)
+	// RemovePostReaction 移除表情回应
+	RemovePostReaction(context.Context, *RemovePostReactionRequest) (*RemovePostReactionResponse, error)
+	// ListPostLikers 获取博客点赞用户列表
+	ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error)
+	// AddBookmark 收藏博客
+	AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error)
+	// RemoveBookmark 取消收藏博客
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	// ListBookmarks 获取阅读列表
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error)
+	// FollowUser 关注用户
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	// UnfollowUser 取消关注用户
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
+	// ListFollowers 获取粉丝列表
+	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error)
+	// ListFollowing 获取关注列表
+	ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error)
+	// GetTimeline 获取首页时间线
+	GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error)
+	// ListTrashedPosts 列出回收站中的文章
+	ListTrashedPosts(context.Context, *ListTrashedPostsRequest) (*ListTrashedPostsResponse, error)
+	// RestorePost 从回收站恢复文章
+	RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
+	// CreateSeries 创建系列
+	CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error)
+	// UpdateSeries 更新系列
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*UpdateSeriesResponse, error)
+	// ReorderSeries 调整系列中博文的顺序
+	ReorderSeries(context.Context, *ReorderSeriesRequest) (*ReorderSeriesResponse, error)
+	// GetSeries 获取系列信息
+	GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error)
+	// GetPostStats 获取博文浏览统计
+	GetPostStats(context.Context, *GetPostStatsRequest) (*GetPostStatsResponse, error)
+	// ImportPosts 批量导入 Markdown 博文
+	ImportPosts(grpc.BidiStreamingServer[ImportPostsRequest, ImportPostsResponse]) error
+	// CreateExportJob 创建博文导出任务
+	CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobResponse, error)
+	// GetExportJob 查询博文导出任务
+	GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobResponse, error)
+	// ExportPosts 下载导出文件，导出文件按块以流的形式返回.
+	// 通过 grpc-gateway 访问时由 InstallExportHandlers 注册的 /v1/export 和 /v1/exports/{jobID}/download 接口直接返回文件内容
+	ExportPosts(*ExportPostsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// SharePost 将博文分享给指定用户
+	SharePost(context.Context, *SharePostRequest) (*SharePostResponse, error)
+	// UnsharePost 取消将博文分享给指定用户
+	UnsharePost(context.Context, *UnsharePostRequest) (*UnsharePostResponse, error)
+	// AddCollaborator 邀请博文协作者或修改协作者角色
+	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
+	// RemoveCollaborator 移除博文协作者
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	// ListCollaborators 获取博文协作者列表
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	mustEmbedUnimplementedMiniBlogServer()
+}
+
+// UnimplementedMiniBlogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMiniBlogServer struct{}
+
+func (UnimplementedMiniBlogServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+}
+func (UnimplementedMiniBlogServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedMiniBlogServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedMiniBlogServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedMiniBlogServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedMiniBlogServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedMiniBlogServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedMiniBlogServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+}
+func (UnimplementedMiniBlogServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedMiniBlogServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+}
+func (UnimplementedMiniBlogServer) PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (UnimplementedMiniBlogServer) UnpublishPost(context.Context, *UnpublishPostRequest) (*UnpublishPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishPost not implemented")
+}
+func (UnimplementedMiniBlogServer) ArchivePost(context.Context, *ArchivePostRequest) (*ArchivePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePost not implemented")
+}
+func (UnimplementedMiniBlogServer) SchedulePost(context.Context, *SchedulePostRequest) (*SchedulePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePost not implemented")
+}
+func (UnimplementedMiniBlogServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostRevisions not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPostRevision(context.Context, *GetPostRevisionRequest) (*GetPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevision not implemented")
+}
+func (UnimplementedMiniBlogServer) DiffPostRevisions(context.Context, *DiffPostRevisionsRequest) (*DiffPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPostRevisions not implemented")
+}
+func (UnimplementedMiniBlogServer) RestorePostRevision(context.Context, *RestorePostRevisionRequest) (*RestorePostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePostRevision not implemented")
+}
+func (UnimplementedMiniBlogServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedMiniBlogServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedMiniBlogServer) ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComment not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPublicPost(context.Context, *GetPublicPostRequest) (*GetPublicPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicPost not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPublicPosts(context.Context, *ListPublicPostsRequest) (*ListPublicPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) ListAuthorPublicPosts(context.Context, *ListAuthorPublicPostsRequest) (*ListAuthorPublicPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorPublicPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPostBySlug(context.Context, *GetPostBySlugRequest) (*GetPostBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostBySlug not implemented")
+}
+func (UnimplementedMiniBlogServer) UploadMedia(context.Context, *UploadMediaRequest) (*UploadMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedMiniBlogServer) GetMedia(context.Context, *GetMediaRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedia not implemented")
+}
+func (UnimplementedMiniBlogServer) DeleteMedia(context.Context, *DeleteMediaRequest) (*DeleteMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMedia not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPublicMedia(context.Context, *GetPublicMediaRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicMedia not implemented")
+}
+func (UnimplementedMiniBlogServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedMiniBlogServer) UnlikePost(context.Context, *UnlikePostRequest) (*UnlikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
+}
+func (UnimplementedMiniBlogServer) AddPostReaction(context.Context, *AddPostReactionRequest) (*AddPostReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPostReaction not implemented")
+}
+func (UnimplementedMiniBlogServer) RemovePostReaction(context.Context, *RemovePostReactionRequest) (*RemovePostReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePostReaction not implemented")
+}
+func (UnimplementedMiniBlogServer) ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostLikers not implemented")
+}
+func (UnimplementedMiniBlogServer) AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedMiniBlogServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedMiniBlogServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedMiniBlogServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedMiniBlogServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedMiniBlogServer) ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedMiniBlogServer) ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedMiniBlogServer) GetTimeline(context.Context, *GetTimelineRequest) (*GetTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeline not implemented")
+}
+func (UnimplementedMiniBlogServer) ListTrashedPosts(context.Context, *ListTrashedPostsRequest) (*ListTrashedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedMiniBlogServer) CreateSeries(context.Context, *CreateSeriesRequest) (*CreateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedMiniBlogServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*UpdateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedMiniBlogServer) ReorderSeries(context.Context, *ReorderSeriesRequest) (*ReorderSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSeries not implemented")
+}
+func (UnimplementedMiniBlogServer) GetSeries(context.Context, *GetSeriesRequest) (*GetSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedMiniBlogServer) GetPostStats(context.Context, *GetPostStatsRequest) (*GetPostStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostStats not implemented")
+}
+func (UnimplementedMiniBlogServer) ImportPosts(grpc.BidiStreamingServer[ImportPostsRequest, ImportPostsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExportJob not implemented")
+}
+func (UnimplementedMiniBlogServer) GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportJob not implemented")
+}
+func (UnimplementedMiniBlogServer) ExportPosts(*ExportPostsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPosts not implemented")
+}
+func (UnimplementedMiniBlogServer) SharePost(context.Context, *SharePostRequest) (*SharePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePost not implemented")
+}
+func (UnimplementedMiniBlogServer) UnsharePost(context.Context, *UnsharePostRequest) (*UnsharePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsharePost not implemented")
+}
+func (UnimplementedMiniBlogServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedMiniBlogServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedMiniBlogServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedMiniBlogServer) mustEmbedUnimplementedMiniBlogServer() {}
+func (UnimplementedMiniBlogServer) testEmbeddedByValue()                  {}
+
+// UnsafeMiniBlogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MiniBlogServer will
+// result in compilation errors.
+type UnsafeMiniBlogServer interface {
 	mustEmbedUnimplementedMiniBlogServer()
 }
 
-// UnimplementedMiniBlogServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMiniBlogServer struct{}
+func RegisterMiniBlogServer(s grpc.ServiceRegistrar, srv MiniBlogServer) {
+	// If the following call pancis, it indicates UnimplementedMiniBlogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MiniBlog_ServiceDesc, srv)
+}
+
+func _MiniBlog_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_Healthz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).Healthz(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListUser(ctx, req.(*ListUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPost(ctx, req.(*ListPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).PublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_PublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).PublishPost(ctx, req.(*PublishPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UnpublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UnpublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UnpublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UnpublishPost(ctx, req.(*UnpublishPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ArchivePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ArchivePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ArchivePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ArchivePost(ctx, req.(*ArchivePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_SchedulePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).SchedulePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_SchedulePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).SchedulePost(ctx, req.(*SchedulePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPostRevisions(ctx, req.(*ListPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_GetPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPostRevision(ctx, req.(*GetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DiffPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DiffPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DiffPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DiffPostRevisions(ctx, req.(*DiffPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RestorePostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RestorePostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RestorePostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RestorePostRevision(ctx, req.(*RestorePostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListComment(ctx, req.(*ListCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedMiniBlogServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+func _MiniBlog_GetPublicPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPublicPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPublicPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPublicPost(ctx, req.(*GetPublicPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func _MiniBlog_ListPublicPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPublicPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPublicPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPublicPosts(ctx, req.(*ListPublicPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func _MiniBlog_ListAuthorPublicPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorPublicPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListAuthorPublicPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListAuthorPublicPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListAuthorPublicPosts(ctx, req.(*ListAuthorPublicPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+
+func _MiniBlog_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).SearchPosts(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func _MiniBlog_GetPostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPostBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPostBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPostBySlug(ctx, req.(*GetPostBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _MiniBlog_UploadMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UploadMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UploadMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UploadMedia(ctx, req.(*UploadMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func _MiniBlog_GetMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetMedia(ctx, req.(*GetMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func _MiniBlog_DeleteMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).DeleteMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_DeleteMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).DeleteMedia(ctx, req.(*DeleteMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+
+func _MiniBlog_GetPublicMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetPublicMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetPublicMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetPublicMedia(ctx, req.(*GetPublicMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+
+func _MiniBlog_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_LikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).LikePost(ctx, req.(*LikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+
+func _MiniBlog_UnlikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UnlikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UnlikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UnlikePost(ctx, req.(*UnlikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+
+func _MiniBlog_AddPostReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPostReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).AddPostReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_AddPostReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).AddPostReaction(ctx, req.(*AddPostReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RemovePostReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePostReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RemovePostReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RemovePostReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RemovePostReaction(ctx, req.(*RemovePostReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListPostLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListPostLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListPostLikers(ctx, req.(*ListPostLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_AddBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_RemoveBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MiniBlog_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+
+func _MiniBlog_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+
+func _MiniBlog_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListFollowers(ctx, req.(*ListFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMiniBlogServer) mustEmbedUnimplementedMiniBlogServer() {}
-func (UnimplementedMiniBlogServer) testEmbeddedByValue()                  {}
 
-// UnsafeMiniBlogServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MiniBlogServer will
-// result in compilation errors.
-type UnsafeMiniBlogServer interface {
-	mustEmbedUnimplementedMiniBlogServer()
+func _MiniBlog_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiniBlogServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).ListFollowing(ctx, req.(*ListFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMiniBlogServer(s grpc.ServiceRegistrar, srv MiniBlogServer) {
-	// If the following call pancis, it indicates UnimplementedMiniBlogServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MiniBlog_GetTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MiniBlog_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MiniBlogServer).GetTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MiniBlog_GetTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiniBlogServer).GetTimeline(ctx, req.(*GetTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MiniBlog_ListTrashedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).Healthz(ctx, in)
+		return srv.(MiniBlogServer).ListTrashedPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_Healthz_FullMethodName,
+		FullMethod: MiniBlog_ListTrashedPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).Healthz(ctx, req.(*emptypb.Empty))
+		return srv.(MiniBlogServer).ListTrashedPosts(ctx, req.(*ListTrashedPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _MiniBlog_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).Login(ctx, in)
+		return srv.(MiniBlogServer).RestorePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_Login_FullMethodName,
+		FullMethod: MiniBlog_RestorePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).Login(ctx, req.(*LoginRequest))
+		return srv.(MiniBlogServer).RestorePost(ctx, req.(*RestorePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _MiniBlog_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).RefreshToken(ctx, in)
+		return srv.(MiniBlogServer).CreateSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_RefreshToken_FullMethodName,
+		FullMethod: MiniBlog_CreateSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(MiniBlogServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
+func _MiniBlog_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).ChangePassword(ctx, in)
+		return srv.(MiniBlogServer).UpdateSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_ChangePassword_FullMethodName,
+		FullMethod: MiniBlog_UpdateSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(MiniBlogServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _MiniBlog_ReorderSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).CreateUser(ctx, in)
+		return srv.(MiniBlogServer).ReorderSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_CreateUser_FullMethodName,
+		FullMethod: MiniBlog_ReorderSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(MiniBlogServer).ReorderSeries(ctx, req.(*ReorderSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _MiniBlog_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).UpdateUser(ctx, in)
+		return srv.(MiniBlogServer).GetSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_UpdateUser_FullMethodName,
+		FullMethod: MiniBlog_GetSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(MiniBlogServer).GetSeries(ctx, req.(*GetSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _MiniBlog_GetPostStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).DeleteUser(ctx, in)
+		return srv.(MiniBlogServer).GetPostStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_DeleteUser_FullMethodName,
+		FullMethod: MiniBlog_GetPostStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(MiniBlogServer).GetPostStats(ctx, req.(*GetPostStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _MiniBlog_ImportPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MiniBlogServer).ImportPosts(&grpc.GenericServerStream[ImportPostsRequest, ImportPostsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MiniBlog_ImportPostsServer = grpc.BidiStreamingServer[ImportPostsRequest, ImportPostsResponse]
+
+func _MiniBlog_CreateExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).GetUser(ctx, in)
+		return srv.(MiniBlogServer).CreateExportJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_GetUser_FullMethodName,
+		FullMethod: MiniBlog_CreateExportJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(MiniBlogServer).CreateExportJob(ctx, req.(*CreateExportJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserRequest)
+func _MiniBlog_GetExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).ListUser(ctx, in)
+		return srv.(MiniBlogServer).GetExportJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_ListUser_FullMethodName,
+		FullMethod: MiniBlog_GetExportJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).ListUser(ctx, req.(*ListUserRequest))
+		return srv.(MiniBlogServer).GetExportJob(ctx, req.(*GetExportJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _MiniBlog_ExportPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MiniBlogServer).ExportPosts(m, &grpc.GenericServerStream[ExportPostsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MiniBlog_ExportPostsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _MiniBlog_SharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).CreatePost(ctx, in)
+		return srv.(MiniBlogServer).SharePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_CreatePost_FullMethodName,
+		FullMethod: MiniBlog_SharePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(MiniBlogServer).SharePost(ctx, req.(*SharePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePostRequest)
+func _MiniBlog_UnsharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsharePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).UpdatePost(ctx, in)
+		return srv.(MiniBlogServer).UnsharePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_UpdatePost_FullMethodName,
+		FullMethod: MiniBlog_UnsharePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+		return srv.(MiniBlogServer).UnsharePost(ctx, req.(*UnsharePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostRequest)
+func _MiniBlog_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollaboratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).DeletePost(ctx, in)
+		return srv.(MiniBlogServer).AddCollaborator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_DeletePost_FullMethodName,
+		FullMethod: MiniBlog_AddCollaborator_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).DeletePost(ctx, req.(*DeletePostRequest))
+		return srv.(MiniBlogServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostRequest)
+func _MiniBlog_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).GetPost(ctx, in)
+		return srv.(MiniBlogServer).RemoveCollaborator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_GetPost_FullMethodName,
+		FullMethod: MiniBlog_RemoveCollaborator_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).GetPost(ctx, req.(*GetPostRequest))
+		return srv.(MiniBlogServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MiniBlog_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPostRequest)
+func _MiniBlog_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MiniBlogServer).ListPost(ctx, in)
+		return srv.(MiniBlogServer).ListCollaborators(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MiniBlog_ListPost_FullMethodName,
+		FullMethod: MiniBlog_ListCollaborators_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MiniBlogServer).ListPost(ctx, req.(*ListPostRequest))
+		return srv.(MiniBlogServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -658,7 +2491,215 @@ var MiniBlog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPost",
 			Handler:    _MiniBlog_ListPost_Handler,
 		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _MiniBlog_PublishPost_Handler,
+		},
+		{
+			MethodName: "UnpublishPost",
+			Handler:    _MiniBlog_UnpublishPost_Handler,
+		},
+		{
+			MethodName: "ArchivePost",
+			Handler:    _MiniBlog_ArchivePost_Handler,
+		},
+		{
+			MethodName: "SchedulePost",
+			Handler:    _MiniBlog_SchedulePost_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _MiniBlog_ListTags_Handler,
+		},
+		{
+			MethodName: "ListPostRevisions",
+			Handler:    _MiniBlog_ListPostRevisions_Handler,
+		},
+		{
+			MethodName: "GetPostRevision",
+			Handler:    _MiniBlog_GetPostRevision_Handler,
+		},
+		{
+			MethodName: "DiffPostRevisions",
+			Handler:    _MiniBlog_DiffPostRevisions_Handler,
+		},
+		{
+			MethodName: "RestorePostRevision",
+			Handler:    _MiniBlog_RestorePostRevision_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _MiniBlog_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _MiniBlog_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _MiniBlog_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComment",
+			Handler:    _MiniBlog_ListComment_Handler,
+		},
+		{
+			MethodName: "GetPublicPost",
+			Handler:    _MiniBlog_GetPublicPost_Handler,
+		},
+		{
+			MethodName: "ListPublicPosts",
+			Handler:    _MiniBlog_ListPublicPosts_Handler,
+		},
+		{
+			MethodName: "ListAuthorPublicPosts",
+			Handler:    _MiniBlog_ListAuthorPublicPosts_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _MiniBlog_SearchPosts_Handler,
+		},
+		{
+			MethodName: "GetPostBySlug",
+			Handler:    _MiniBlog_GetPostBySlug_Handler,
+		},
+		{
+			MethodName: "UploadMedia",
+			Handler:    _MiniBlog_UploadMedia_Handler,
+		},
+		{
+			MethodName: "GetMedia",
+			Handler:    _MiniBlog_GetMedia_Handler,
+		},
+		{
+			MethodName: "DeleteMedia",
+			Handler:    _MiniBlog_DeleteMedia_Handler,
+		},
+		{
+			MethodName: "GetPublicMedia",
+			Handler:    _MiniBlog_GetPublicMedia_Handler,
+		},
+		{
+			MethodName: "LikePost",
+			Handler:    _MiniBlog_LikePost_Handler,
+		},
+		{
+			MethodName: "UnlikePost",
+			Handler:    _MiniBlog_UnlikePost_Handler,
+		},
+		{
+			MethodName: "AddPostReaction",
+			Handler:    _MiniBlog_AddPostReaction_Handler,
+		},
+		{
+			MethodName: "RemovePostReaction",
+			Handler:    _MiniBlog_RemovePostReaction_Handler,
+		},
+		{
+			MethodName: "ListPostLikers",
+			Handler:    _MiniBlog_ListPostLikers_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _MiniBlog_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _MiniBlog_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _MiniBlog_ListBookmarks_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _MiniBlog_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _MiniBlog_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _MiniBlog_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _MiniBlog_ListFollowing_Handler,
+		},
+		{
+			MethodName: "GetTimeline",
+			Handler:    _MiniBlog_GetTimeline_Handler,
+		},
+		{
+			MethodName: "ListTrashedPosts",
+			Handler:    _MiniBlog_ListTrashedPosts_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _MiniBlog_RestorePost_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _MiniBlog_CreateSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _MiniBlog_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "ReorderSeries",
+			Handler:    _MiniBlog_ReorderSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _MiniBlog_GetSeries_Handler,
+		},
+		{
+			MethodName: "GetPostStats",
+			Handler:    _MiniBlog_GetPostStats_Handler,
+		},
+		{
+			MethodName: "CreateExportJob",
+			Handler:    _MiniBlog_CreateExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJob",
+			Handler:    _MiniBlog_GetExportJob_Handler,
+		},
+		{
+			MethodName: "SharePost",
+			Handler:    _MiniBlog_SharePost_Handler,
+		},
+		{
+			MethodName: "UnsharePost",
+			Handler:    _MiniBlog_UnsharePost_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _MiniBlog_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _MiniBlog_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _MiniBlog_ListCollaborators_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportPosts",
+			Handler:       _MiniBlog_ImportPosts_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportPosts",
+			Handler:       _MiniBlog_ExportPosts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "apiserver/v1/apiserver.proto",
 }
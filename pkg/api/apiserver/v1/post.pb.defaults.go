@@ -30,6 +30,9 @@ var (
 	_ *wrapperspb.BoolValue
 )
 
+func (x *TocEntry) Default() {
+}
+
 func (x *Post) Default() {
 }
 
@@ -62,3 +65,48 @@ func (x *ListPostRequest) Default() {
 
 func (x *ListPostResponse) Default() {
 }
+
+func (x *Tag) Default() {
+}
+
+func (x *ListTagsRequest) Default() {
+}
+
+func (x *ListTagsResponse) Default() {
+}
+
+func (x *PublishPostRequest) Default() {
+}
+
+func (x *PublishPostResponse) Default() {
+}
+
+func (x *UnpublishPostRequest) Default() {
+}
+
+func (x *UnpublishPostResponse) Default() {
+}
+
+func (x *ArchivePostRequest) Default() {
+}
+
+func (x *ArchivePostResponse) Default() {
+}
+
+func (x *SchedulePostRequest) Default() {
+}
+
+func (x *SchedulePostResponse) Default() {
+}
+
+func (x *ListTrashedPostsRequest) Default() {
+}
+
+func (x *ListTrashedPostsResponse) Default() {
+}
+
+func (x *RestorePostRequest) Default() {
+}
+
+func (x *RestorePostResponse) Default() {
+}
@@ -25,6 +25,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -37,6 +38,285 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PostStatus 表示博客文章的状态
+type PostStatus int32
+
+const (
+	// Draft 表示草稿，仅作者本人可见
+	PostStatus_Draft PostStatus = 0
+	// Published 表示已发布
+	PostStatus_Published PostStatus = 1
+	// Archived 表示已归档
+	PostStatus_Archived PostStatus = 2
+)
+
+// Enum value maps for PostStatus.
+var (
+	PostStatus_name = map[int32]string{
+		0: "Draft",
+		1: "Published",
+		2: "Archived",
+	}
+	PostStatus_value = map[string]int32{
+		"Draft":     0,
+		"Published": 1,
+		"Archived":  2,
+	}
+)
+
+func (x PostStatus) Enum() *PostStatus {
+	p := new(PostStatus)
+	*p = x
+	return p
+}
+
+func (x PostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_post_proto_enumTypes[0].Descriptor()
+}
+
+func (PostStatus) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_post_proto_enumTypes[0]
+}
+
+func (x PostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostStatus.Descriptor instead.
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{0}
+}
+
+// PostVisibility 表示博客的可见范围. 可见范围只决定其他用户能否读取博客，修改博客的权限由作者和协作者角色决定
+type PostVisibility int32
+
+const (
+	// Public 表示公开，发布后所有人可见，并会出现在公开列表、订阅源和站点地图中
+	PostVisibility_Public PostVisibility = 0
+	// Unlisted 表示不公开列出，发布后知道链接的人可见，但不会出现在任何列表中
+	PostVisibility_Unlisted PostVisibility = 1
+	// Private 表示私密，仅作者本人和协作者可见
+	PostVisibility_Private PostVisibility = 2
+	// Shared 表示仅分享给指定用户，作者、协作者和被分享的用户可见
+	PostVisibility_Shared PostVisibility = 3
+)
+
+// Enum value maps for PostVisibility.
+var (
+	PostVisibility_name = map[int32]string{
+		0: "Public",
+		1: "Unlisted",
+		2: "Private",
+		3: "Shared",
+	}
+	PostVisibility_value = map[string]int32{
+		"Public":   0,
+		"Unlisted": 1,
+		"Private":  2,
+		"Shared":   3,
+	}
+)
+
+func (x PostVisibility) Enum() *PostVisibility {
+	p := new(PostVisibility)
+	*p = x
+	return p
+}
+
+func (x PostVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_post_proto_enumTypes[1].Descriptor()
+}
+
+func (PostVisibility) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_post_proto_enumTypes[1]
+}
+
+func (x PostVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostVisibility.Descriptor instead.
+func (PostVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{1}
+}
+
+// CollaboratorRole 表示协作者在博客中的角色. 协作者不受博客可见范围和状态的限制，始终可以查看博客
+type CollaboratorRole int32
+
+const (
+	// Viewer 表示只读协作者，只能查看博客
+	CollaboratorRole_Viewer CollaboratorRole = 0
+	// Editor 表示编辑者，可以查看和修改博客的标题、内容和标签，但不能发布、删除博客或修改可见范围
+	CollaboratorRole_Editor CollaboratorRole = 1
+)
+
+// Enum value maps for CollaboratorRole.
+var (
+	CollaboratorRole_name = map[int32]string{
+		0: "Viewer",
+		1: "Editor",
+	}
+	CollaboratorRole_value = map[string]int32{
+		"Viewer": 0,
+		"Editor": 1,
+	}
+)
+
+func (x CollaboratorRole) Enum() *CollaboratorRole {
+	p := new(CollaboratorRole)
+	*p = x
+	return p
+}
+
+func (x CollaboratorRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollaboratorRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_post_proto_enumTypes[2].Descriptor()
+}
+
+func (CollaboratorRole) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_post_proto_enumTypes[2]
+}
+
+func (x CollaboratorRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollaboratorRole.Descriptor instead.
+func (CollaboratorRole) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{2}
+}
+
+// ContentFormat 表示博客内容的格式
+type ContentFormat int32
+
+const (
+	// Markdown 表示 Markdown 格式
+	ContentFormat_Markdown ContentFormat = 0
+	// Plain 表示纯文本格式
+	ContentFormat_Plain ContentFormat = 1
+	// HTML 表示 HTML 格式，渲染时会过滤掉不安全的标签和属性
+	ContentFormat_HTML ContentFormat = 2
+)
+
+// Enum value maps for ContentFormat.
+var (
+	ContentFormat_name = map[int32]string{
+		0: "Markdown",
+		1: "Plain",
+		2: "HTML",
+	}
+	ContentFormat_value = map[string]int32{
+		"Markdown": 0,
+		"Plain":    1,
+		"HTML":     2,
+	}
+)
+
+func (x ContentFormat) Enum() *ContentFormat {
+	p := new(ContentFormat)
+	*p = x
+	return p
+}
+
+func (x ContentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_post_proto_enumTypes[3].Descriptor()
+}
+
+func (ContentFormat) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_post_proto_enumTypes[3]
+}
+
+func (x ContentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentFormat.Descriptor instead.
+func (ContentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{3}
+}
+
+// TocEntry 表示博客目录中的一项，由 Markdown 内容中的标题生成
+type TocEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// level 表示标题级别，取值为 1 到 6
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// anchor 表示标题在 contentHtml 中的锚点 ID
+	Anchor string `protobuf:"bytes,2,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	// title 表示标题文本
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *TocEntry) Reset() {
+	*x = TocEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TocEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TocEntry) ProtoMessage() {}
+
+func (x *TocEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TocEntry.ProtoReflect.Descriptor instead.
+func (*TocEntry) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TocEntry) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *TocEntry) GetAnchor() string {
+	if x != nil {
+		return x.Anchor
+	}
+	return ""
+}
+
+func (x *TocEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
 // Post 表示博客文章
 type Post struct {
 	state         protoimpl.MessageState
@@ -55,25 +335,998 @@ type Post struct {
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示博客最后更新时间
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// tags 表示博客的标签列表
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// status 表示博客的状态
+	Status PostStatus `protobuf:"varint,8,opt,name=status,proto3,enum=v1.PostStatus" json:"status,omitempty"`
+	// publishedAt 表示博客最近一次发布的时间，未发布时为空
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	// publishAt 表示博客的定时发布时间，未设置定时发布时为空
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=publishAt,proto3" json:"publishAt,omitempty"`
+	// slug 表示博客 URL 中使用的可读标识，全局唯一
+	Slug string `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
+	// contentFormat 表示博客内容的格式
+	ContentFormat ContentFormat `protobuf:"varint,12,opt,name=contentFormat,proto3,enum=v1.ContentFormat" json:"contentFormat,omitempty"`
+	// contentHtml 表示渲染并过滤后的博客内容 HTML
+	ContentHtml string `protobuf:"bytes,13,opt,name=contentHtml,proto3" json:"contentHtml,omitempty"`
+	// toc 表示博客的目录，仅 Markdown 格式的内容会生成目录
+	Toc []*TocEntry `protobuf:"bytes,14,rep,name=toc,proto3" json:"toc,omitempty"`
+	// excerpt 表示博客的纯文本摘要
+	Excerpt string `protobuf:"bytes,15,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	// likeCount 表示博客的点赞数
+	LikeCount int64 `protobuf:"varint,16,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
+	// reactions 表示博客各表情的回应数，键为表情名称
+	Reactions map[string]int64 `protobuf:"bytes,17,rep,name=reactions,proto3" json:"reactions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// deletedAt 表示博客移入回收站的时间，未删除时为空
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// visibility 表示博客的可见范围
+	Visibility PostVisibility `protobuf:"varint,19,opt,name=visibility,proto3,enum=v1.PostVisibility" json:"visibility,omitempty"`
+	// sharedWith 表示博客被分享给的用户 ID 列表，仅作者本人查看时返回
+	SharedWith []string `protobuf:"bytes,20,rep,name=sharedWith,proto3" json:"sharedWith,omitempty"`
+	// role 表示当前用户在博客中的协作角色，当前用户是作者或不是协作者时为空
+	Role *CollaboratorRole `protobuf:"varint,21,opt,name=role,proto3,enum=v1.CollaboratorRole,oneof" json:"role,omitempty"`
+	// version 表示博客的版本号，每次修改博客时递增. 更新博客时需要带上该版本号，用于检测并发修改
+	Version int64 `protobuf:"varint,22,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Post) Reset() {
 	*x = Post{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[0]
+		mi := &file_apiserver_v1_post_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Post) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *Post) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_Draft
+}
+
+func (x *Post) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Post) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Post) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Post) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_Markdown
+}
+
+func (x *Post) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Post) GetToc() []*TocEntry {
+	if x != nil {
+		return x.Toc
+	}
+	return nil
+}
+
+func (x *Post) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *Post) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Post) GetReactions() map[string]int64 {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Post) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Post) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_Public
+}
+
+func (x *Post) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
+func (x *Post) GetRole() CollaboratorRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return CollaboratorRole_Viewer
+}
+
+func (x *Post) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// CreatePostRequest 表示创建文章请求
+type CreatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// title 表示博客标题
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// content 表示博客内容
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// tags 表示博客的标签列表
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// publishAt 表示可选的定时发布时间，到期后文章会被自动发布
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=publishAt,proto3" json:"publishAt,omitempty"`
+	// slug 表示可选的自定义 slug，为空时根据标题自动生成
+	Slug *string `protobuf:"bytes,5,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	// contentFormat 表示博客内容的格式，默认为 Markdown
+	ContentFormat ContentFormat `protobuf:"varint,6,opt,name=contentFormat,proto3,enum=v1.ContentFormat" json:"contentFormat,omitempty"`
+	// visibility 表示博客的可见范围，默认为 Public
+	Visibility PostVisibility `protobuf:"varint,7,opt,name=visibility,proto3,enum=v1.PostVisibility" json:"visibility,omitempty"`
+	// sharedWith 表示要分享给的用户 ID 列表，仅在 visibility 为 Shared 时有效
+	SharedWith []string `protobuf:"bytes,8,rep,name=sharedWith,proto3" json:"sharedWith,omitempty"`
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_Markdown
+}
+
+func (x *CreatePostRequest) GetVisibility() PostVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return PostVisibility_Public
+}
+
+func (x *CreatePostRequest) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
+// CreatePostResponse 表示创建文章响应
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示创建的文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePostResponse) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// UpdatePostRequest 表示更新文章请求
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示要更新的文章 ID，对应 {postID}
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// title 表示更新后的博客标题
+	Title *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// content 表示更新后的博客内容
+	Content *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// tags 表示更新后的博客标签列表，不为空时会替换原有标签
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// slug 表示更新后的 slug. 未指定 slug 但修改了标题时，会根据新标题重新生成 slug.
+	// slug 变更后，旧的 slug 仍然可以访问到该文章
+	Slug *string `protobuf:"bytes,5,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	// contentFormat 表示更新后的博客内容格式
+	ContentFormat *ContentFormat `protobuf:"varint,6,opt,name=contentFormat,proto3,enum=v1.ContentFormat,oneof" json:"contentFormat,omitempty"`
+	// visibility 表示更新后的博客可见范围，已有的分享记录会被保留
+	Visibility *PostVisibility `protobuf:"varint,7,opt,name=visibility,proto3,enum=v1.PostVisibility,oneof" json:"visibility,omitempty"`
+	// version 表示客户端读取到的博客版本号，与当前版本号不一致时更新失败.
+	// 通过 HTTP 调用时也可以使用 If-Match 请求头指定，两者必须指定其一
+	Version *int64 `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// updateMask 表示要更新的字段，可选值为 title、content、tags、slug、contentFormat 和 visibility.
+	// 未指定时只更新请求中设置了的字段；指定时只更新列出的字段，列出但未设置的字段会被清空：
+	// tags 清空为空列表，slug 根据标题重新生成，title、content、contentFormat 和 visibility 不能清空
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetContentFormat() ContentFormat {
+	if x != nil && x.ContentFormat != nil {
+		return *x.ContentFormat
+	}
+	return ContentFormat_Markdown
+}
+
+func (x *UpdatePostRequest) GetVisibility() PostVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return PostVisibility_Public
+}
+
+func (x *UpdatePostRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdatePostResponse 表示更新文章响应
+type UpdatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResponse) ProtoMessage() {}
+
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{5}
+}
+
+// DeletePostRequest 表示删除文章请求，删除的文章会先移入回收站
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postIDs 表示要删除的文章 ID 列表
+	PostIDs []string `protobuf:"bytes,1,rep,name=postIDs,proto3" json:"postIDs,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePostRequest) GetPostIDs() []string {
+	if x != nil {
+		return x.PostIDs
+	}
+	return nil
+}
+
+// DeletePostResponse 表示删除文章响应
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{7}
+}
+
+// GetPostRequest 表示获取文章请求
+type GetPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// postID 表示要获取的文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// GetPostResponse 表示获取文章响应
+type GetPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// post 表示返回的文章信息
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	// series 表示文章在所属系列中的导航信息，文章不属于任何系列时为空
+	Series *SeriesNavigation `protobuf:"bytes,2,opt,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *GetPostResponse) Reset() {
+	*x = GetPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostResponse) ProtoMessage() {}
+
+func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
+func (*GetPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *GetPostResponse) GetSeries() *SeriesNavigation {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// ListPostRequest 表示获取文章列表请求
+type ListPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// title 表示可选的标题过滤
+	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// tags 表示可选的标签过滤，返回包含其中任意一个标签的文章
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// status 表示可选的文章状态过滤
+	Status *PostStatus `protobuf:"varint,5,opt,name=status,proto3,enum=v1.PostStatus,oneof" json:"status,omitempty"`
+	// userID 表示可选的作者用户 ID，指定为其他用户时只返回当前用户有权查看的文章
+	UserID *string `protobuf:"bytes,6,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	// sharedWithMe 表示只返回其他用户分享给当前用户的文章
+	SharedWithMe bool `protobuf:"varint,7,opt,name=sharedWithMe,proto3" json:"sharedWithMe,omitempty"`
+	// collaborating 表示只返回当前用户作为协作者参与的其他用户的文章
+	Collaborating bool `protobuf:"varint,8,opt,name=collaborating,proto3" json:"collaborating,omitempty"`
+}
+
+func (x *ListPostRequest) Reset() {
+	*x = ListPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRequest) ProtoMessage() {}
+
+func (x *ListPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPostRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPostRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPostRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ListPostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListPostRequest) GetStatus() PostStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PostStatus_Draft
+}
+
+func (x *ListPostRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *ListPostRequest) GetSharedWithMe() bool {
+	if x != nil {
+		return x.SharedWithMe
+	}
+	return false
+}
+
+func (x *ListPostRequest) GetCollaborating() bool {
+	if x != nil {
+		return x.Collaborating
+	}
+	return false
+}
+
+// ListPostResponse 表示获取文章列表响应
+type ListPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_count 表示总文章数
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// posts 表示文章列表
+	Posts []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *ListPostResponse) Reset() {
+	*x = ListPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostResponse) ProtoMessage() {}
+
+func (x *ListPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostResponse.ProtoReflect.Descriptor instead.
+func (*ListPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPostResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPostResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// Tag 表示博客标签
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name 表示标签名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// postCount 表示使用该标签的文章数量
+	PostCount int64 `protobuf:"varint,2,opt,name=postCount,proto3" json:"postCount,omitempty"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+// ListTagsRequest 表示获取标签列表请求
+type ListTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Post) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[0]
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -84,82 +1337,87 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Post) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// ListTagsResponse 表示获取标签列表响应
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tags 表示标签列表，按文章数量倒序排列
+	Tags []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *Post) GetUserID() string {
-	if x != nil {
-		return x.UserID
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetContent() string {
-	if x != nil {
-		return x.Content
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListTagsResponse) GetTags() []*Tag {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Tags
 	}
 	return nil
 }
 
-// CreatePostRequest 表示创建文章请求
-type CreatePostRequest struct {
+// PublishPostRequest 表示发布文章请求
+type PublishPostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// title 表示博客标题
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// content 表示博客内容
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// postID 表示要发布的文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[1]
+		mi := &file_apiserver_v1_post_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *PublishPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*PublishPostRequest) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[1]
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,52 +1428,42 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreatePostRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreatePostRequest) GetContent() string {
+func (x *PublishPostRequest) GetPostID() string {
 	if x != nil {
-		return x.Content
+		return x.PostID
 	}
 	return ""
 }
 
-// CreatePostResponse 表示创建文章响应
-type CreatePostResponse struct {
+// PublishPostResponse 表示发布文章响应
+type PublishPostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// postID 表示创建的文章 ID
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
+func (x *PublishPostResponse) Reset() {
+	*x = PublishPostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[2]
+		mi := &file_apiserver_v1_post_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *PublishPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*PublishPostResponse) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[2]
+func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,49 +1474,38 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *CreatePostResponse) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use PublishPostResponse.ProtoReflect.Descriptor instead.
+func (*PublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{16}
 }
 
-// UpdatePostRequest 表示更新文章请求
-type UpdatePostRequest struct {
+// UnpublishPostRequest 表示撤回已发布文章的请求
+type UnpublishPostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// postID 表示要更新的文章 ID，对应 {postID}
+	// postID 表示要撤回的文章 ID
 	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
-	// title 表示更新后的博客标题
-	Title *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	// content 表示更新后的博客内容
-	Content *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
+func (x *UnpublishPostRequest) Reset() {
+	*x = UnpublishPostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[3]
+		mi := &file_apiserver_v1_post_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *UnpublishPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*UnpublishPostRequest) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[3]
+func (x *UnpublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,56 +1516,42 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UnpublishPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdatePostRequest) GetPostID() string {
+func (x *UnpublishPostRequest) GetPostID() string {
 	if x != nil {
 		return x.PostID
 	}
 	return ""
 }
 
-func (x *UpdatePostRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdatePostRequest) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
-	}
-	return ""
-}
-
-// UpdatePostResponse 表示更新文章响应
-type UpdatePostResponse struct {
+// UnpublishPostResponse 表示撤回已发布文章的响应
+type UnpublishPostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdatePostResponse) Reset() {
-	*x = UpdatePostResponse{}
+func (x *UnpublishPostResponse) Reset() {
+	*x = UnpublishPostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[4]
+		mi := &file_apiserver_v1_post_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdatePostResponse) String() string {
+func (x *UnpublishPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostResponse) ProtoMessage() {}
+func (*UnpublishPostResponse) ProtoMessage() {}
 
-func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[4]
+func (x *UnpublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,38 +1562,38 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UnpublishPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{18}
 }
 
-// DeletePostRequest 表示删除文章请求
-type DeletePostRequest struct {
+// ArchivePostRequest 表示归档文章请求
+type ArchivePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// postIDs 表示要删除的文章 ID 列表
-	PostIDs []string `protobuf:"bytes,1,rep,name=postIDs,proto3" json:"postIDs,omitempty"`
+	// postID 表示要归档的文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
+func (x *ArchivePostRequest) Reset() {
+	*x = ArchivePostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[5]
+		mi := &file_apiserver_v1_post_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *ArchivePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*ArchivePostRequest) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[5]
+func (x *ArchivePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,42 +1604,42 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ArchivePostRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeletePostRequest) GetPostIDs() []string {
+func (x *ArchivePostRequest) GetPostID() string {
 	if x != nil {
-		return x.PostIDs
+		return x.PostID
 	}
-	return nil
+	return ""
 }
 
-// DeletePostResponse 表示删除文章响应
-type DeletePostResponse struct {
+// ArchivePostResponse 表示归档文章响应
+type ArchivePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
+func (x *ArchivePostResponse) Reset() {
+	*x = ArchivePostResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[6]
+		mi := &file_apiserver_v1_post_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *ArchivePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*ArchivePostResponse) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[6]
+func (x *ArchivePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,38 +1650,40 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ArchivePostResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{20}
 }
 
-// GetPostRequest 表示获取文章请求
-type GetPostRequest struct {
+// SchedulePostRequest 表示设置文章定时发布的请求
+type SchedulePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// postID 表示要获取的文章 ID
+	// postID 表示要定时发布的文章 ID
 	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// publishAt 表示定时发布时间，为空时取消定时发布
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publishAt,proto3" json:"publishAt,omitempty"`
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
+func (x *SchedulePostRequest) Reset() {
+	*x = SchedulePostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[7]
+		mi := &file_apiserver_v1_post_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostRequest) String() string {
+func (x *SchedulePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*SchedulePostRequest) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[7]
+func (x *SchedulePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,45 +1694,93 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SchedulePostRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetPostRequest) GetPostID() string {
+func (x *SchedulePostRequest) GetPostID() string {
 	if x != nil {
 		return x.PostID
 	}
 	return ""
 }
 
-// GetPostResponse 表示获取文章响应
-type GetPostResponse struct {
+func (x *SchedulePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// SchedulePostResponse 表示设置文章定时发布的响应
+type SchedulePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// post 表示返回的文章信息
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+func (x *SchedulePostResponse) Reset() {
+	*x = SchedulePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *GetPostResponse) Reset() {
-	*x = GetPostResponse{}
+func (x *SchedulePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePostResponse) ProtoMessage() {}
+
+func (x *SchedulePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePostResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{22}
+}
+
+// ListTrashedPostsRequest 表示列出回收站中文章的请求
+type ListTrashedPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTrashedPostsRequest) Reset() {
+	*x = ListTrashedPostsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[8]
+		mi := &file_apiserver_v1_post_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostResponse) String() string {
+func (x *ListTrashedPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostResponse) ProtoMessage() {}
+func (*ListTrashedPostsRequest) ProtoMessage() {}
 
-func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[8]
+func (x *ListTrashedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,49 +1791,54 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
-func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListTrashedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetPostResponse) GetPost() *Post {
+func (x *ListTrashedPostsRequest) GetOffset() int64 {
 	if x != nil {
-		return x.Post
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-// ListPostRequest 表示获取文章列表请求
-type ListPostRequest struct {
+func (x *ListTrashedPostsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListTrashedPostsResponse 表示列出回收站中文章的响应
+type ListTrashedPostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// offset 表示偏移量
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	// limit 表示每页数量
-	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// title 表示可选的标题过滤
-	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// total_count 表示回收站中的文章总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// posts 表示回收站中的文章列表，按删除时间倒序排列
+	Posts []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
-func (x *ListPostRequest) Reset() {
-	*x = ListPostRequest{}
+func (x *ListTrashedPostsResponse) Reset() {
+	*x = ListTrashedPostsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[9]
+		mi := &file_apiserver_v1_post_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListPostRequest) String() string {
+func (x *ListTrashedPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostRequest) ProtoMessage() {}
+func (*ListTrashedPostsResponse) ProtoMessage() {}
 
-func (x *ListPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[9]
+func (x *ListTrashedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,61 +1849,52 @@ func (x *ListPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostRequest.ProtoReflect.Descriptor instead.
-func (*ListPostRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListTrashedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListPostRequest) GetOffset() int64 {
+func (x *ListTrashedPostsResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.Offset
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *ListPostRequest) GetLimit() int64 {
+func (x *ListTrashedPostsResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListPostRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+		return x.Posts
 	}
-	return ""
+	return nil
 }
 
-// ListPostResponse 表示获取文章列表响应
-type ListPostResponse struct {
+// RestorePostRequest 表示从回收站恢复文章的请求
+type RestorePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// total_count 表示总文章数
-	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// posts 表示文章列表
-	Posts []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+	// postID 表示要恢复的文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
 }
 
-func (x *ListPostResponse) Reset() {
-	*x = ListPostResponse{}
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apiserver_v1_post_proto_msgTypes[10]
+		mi := &file_apiserver_v1_post_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListPostResponse) String() string {
+func (x *RestorePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPostResponse) ProtoMessage() {}
+func (*RestorePostRequest) ProtoMessage() {}
 
-func (x *ListPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_post_proto_msgTypes[10]
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,88 +1905,281 @@ func (x *ListPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPostResponse.ProtoReflect.Descriptor instead.
-func (*ListPostResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListPostResponse) GetTotalCount() int64 {
+func (x *RestorePostRequest) GetPostID() string {
 	if x != nil {
-		return x.TotalCount
+		return x.PostID
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPostResponse) GetPosts() []*Post {
-	if x != nil {
-		return x.Posts
+// RestorePostResponse 表示从回收站恢复文章的响应
+type RestorePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apiserver_v1_post_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
+}
+
+func (x *RestorePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostResponse) ProtoMessage() {}
+
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{26}
 }
 
 var File_apiserver_v1_post_proto protoreflect.FileDescriptor
 
 var file_apiserver_v1_post_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda,
-	0x01, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x2c, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x7b,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73,
-	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x22, 0x2f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x08, 0x54,
+	0x6f, 0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xaa, 0x07, 0x0a, 0x04,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x37, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x48, 0x74, 0x6d, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x1e, 0x0a, 0x03, 0x74,
+	0x6f, 0x63, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x74, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78,
+	0x63, 0x65, 0x72, 0x70, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x32,
+	0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x2c, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0xb0, 0x03, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x48, 0x04, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x73, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x22, 0x5d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0xa2, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
 	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
 	0x69, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x05,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x30, 0x5a, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x77, 0x63, 0x65, 0x6e,
-	0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x03, 0x54, 0x61,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x14,
+	0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x17, 0x0a, 0x15,
+	0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x41, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x5b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x22, 0x2c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
+	0x15, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x34, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x10, 0x02, 0x2a, 0x43, 0x0a, 0x0e,
+	0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a,
+	0x0a, 0x06, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x6e,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x10,
+	0x03, 0x2a, 0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x10, 0x01, 0x2a, 0x32, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0c,
+	0x0a, 0x08, 0x4d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x50, 0x6c, 0x61, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x4d, 0x4c, 0x10,
+	0x02, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6a, 0x77, 0x63, 0x65, 0x6e, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -732,31 +2194,75 @@ func file_apiserver_v1_post_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_post_proto_rawDescData
 }
 
-var file_apiserver_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_apiserver_v1_post_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_apiserver_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_apiserver_v1_post_proto_goTypes = []any{
-	(*Post)(nil),                  // 0: v1.Post
-	(*CreatePostRequest)(nil),     // 1: v1.CreatePostRequest
-	(*CreatePostResponse)(nil),    // 2: v1.CreatePostResponse
-	(*UpdatePostRequest)(nil),     // 3: v1.UpdatePostRequest
-	(*UpdatePostResponse)(nil),    // 4: v1.UpdatePostResponse
-	(*DeletePostRequest)(nil),     // 5: v1.DeletePostRequest
-	(*DeletePostResponse)(nil),    // 6: v1.DeletePostResponse
-	(*GetPostRequest)(nil),        // 7: v1.GetPostRequest
-	(*GetPostResponse)(nil),       // 8: v1.GetPostResponse
-	(*ListPostRequest)(nil),       // 9: v1.ListPostRequest
-	(*ListPostResponse)(nil),      // 10: v1.ListPostResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(PostStatus)(0),                  // 0: v1.PostStatus
+	(PostVisibility)(0),              // 1: v1.PostVisibility
+	(CollaboratorRole)(0),            // 2: v1.CollaboratorRole
+	(ContentFormat)(0),               // 3: v1.ContentFormat
+	(*TocEntry)(nil),                 // 4: v1.TocEntry
+	(*Post)(nil),                     // 5: v1.Post
+	(*CreatePostRequest)(nil),        // 6: v1.CreatePostRequest
+	(*CreatePostResponse)(nil),       // 7: v1.CreatePostResponse
+	(*UpdatePostRequest)(nil),        // 8: v1.UpdatePostRequest
+	(*UpdatePostResponse)(nil),       // 9: v1.UpdatePostResponse
+	(*DeletePostRequest)(nil),        // 10: v1.DeletePostRequest
+	(*DeletePostResponse)(nil),       // 11: v1.DeletePostResponse
+	(*GetPostRequest)(nil),           // 12: v1.GetPostRequest
+	(*GetPostResponse)(nil),          // 13: v1.GetPostResponse
+	(*ListPostRequest)(nil),          // 14: v1.ListPostRequest
+	(*ListPostResponse)(nil),         // 15: v1.ListPostResponse
+	(*Tag)(nil),                      // 16: v1.Tag
+	(*ListTagsRequest)(nil),          // 17: v1.ListTagsRequest
+	(*ListTagsResponse)(nil),         // 18: v1.ListTagsResponse
+	(*PublishPostRequest)(nil),       // 19: v1.PublishPostRequest
+	(*PublishPostResponse)(nil),      // 20: v1.PublishPostResponse
+	(*UnpublishPostRequest)(nil),     // 21: v1.UnpublishPostRequest
+	(*UnpublishPostResponse)(nil),    // 22: v1.UnpublishPostResponse
+	(*ArchivePostRequest)(nil),       // 23: v1.ArchivePostRequest
+	(*ArchivePostResponse)(nil),      // 24: v1.ArchivePostResponse
+	(*SchedulePostRequest)(nil),      // 25: v1.SchedulePostRequest
+	(*SchedulePostResponse)(nil),     // 26: v1.SchedulePostResponse
+	(*ListTrashedPostsRequest)(nil),  // 27: v1.ListTrashedPostsRequest
+	(*ListTrashedPostsResponse)(nil), // 28: v1.ListTrashedPostsResponse
+	(*RestorePostRequest)(nil),       // 29: v1.RestorePostRequest
+	(*RestorePostResponse)(nil),      // 30: v1.RestorePostResponse
+	nil,                              // 31: v1.Post.ReactionsEntry
+	(*timestamppb.Timestamp)(nil),    // 32: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 33: google.protobuf.FieldMask
+	(*SeriesNavigation)(nil),         // 34: v1.SeriesNavigation
 }
 var file_apiserver_v1_post_proto_depIdxs = []int32{
-	11, // 0: v1.Post.createdAt:type_name -> google.protobuf.Timestamp
-	11, // 1: v1.Post.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 2: v1.GetPostResponse.post:type_name -> v1.Post
-	0,  // 3: v1.ListPostResponse.posts:type_name -> v1.Post
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	32, // 0: v1.Post.createdAt:type_name -> google.protobuf.Timestamp
+	32, // 1: v1.Post.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 2: v1.Post.status:type_name -> v1.PostStatus
+	32, // 3: v1.Post.publishedAt:type_name -> google.protobuf.Timestamp
+	32, // 4: v1.Post.publishAt:type_name -> google.protobuf.Timestamp
+	3,  // 5: v1.Post.contentFormat:type_name -> v1.ContentFormat
+	4,  // 6: v1.Post.toc:type_name -> v1.TocEntry
+	31, // 7: v1.Post.reactions:type_name -> v1.Post.ReactionsEntry
+	32, // 8: v1.Post.deletedAt:type_name -> google.protobuf.Timestamp
+	1,  // 9: v1.Post.visibility:type_name -> v1.PostVisibility
+	2,  // 10: v1.Post.role:type_name -> v1.CollaboratorRole
+	32, // 11: v1.CreatePostRequest.publishAt:type_name -> google.protobuf.Timestamp
+	3,  // 12: v1.CreatePostRequest.contentFormat:type_name -> v1.ContentFormat
+	1,  // 13: v1.CreatePostRequest.visibility:type_name -> v1.PostVisibility
+	3,  // 14: v1.UpdatePostRequest.contentFormat:type_name -> v1.ContentFormat
+	1,  // 15: v1.UpdatePostRequest.visibility:type_name -> v1.PostVisibility
+	33, // 16: v1.UpdatePostRequest.updateMask:type_name -> google.protobuf.FieldMask
+	5,  // 17: v1.GetPostResponse.post:type_name -> v1.Post
+	34, // 18: v1.GetPostResponse.series:type_name -> v1.SeriesNavigation
+	0,  // 19: v1.ListPostRequest.status:type_name -> v1.PostStatus
+	5,  // 20: v1.ListPostResponse.posts:type_name -> v1.Post
+	16, // 21: v1.ListTagsResponse.tags:type_name -> v1.Tag
+	32, // 22: v1.SchedulePostRequest.publishAt:type_name -> google.protobuf.Timestamp
+	5,  // 23: v1.ListTrashedPostsResponse.posts:type_name -> v1.Post
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_post_proto_init() }
@@ -764,9 +2270,10 @@ func file_apiserver_v1_post_proto_init() {
 	if File_apiserver_v1_post_proto != nil {
 		return
 	}
+	file_apiserver_v1_series_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_apiserver_v1_post_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Post); i {
+			switch v := v.(*TocEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -778,7 +2285,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePostRequest); i {
+			switch v := v.(*Post); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -790,7 +2297,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePostResponse); i {
+			switch v := v.(*CreatePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -802,7 +2309,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePostRequest); i {
+			switch v := v.(*CreatePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -814,7 +2321,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdatePostResponse); i {
+			switch v := v.(*UpdatePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -826,7 +2333,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePostRequest); i {
+			switch v := v.(*UpdatePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -838,7 +2345,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePostResponse); i {
+			switch v := v.(*DeletePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -850,7 +2357,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostRequest); i {
+			switch v := v.(*DeletePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -862,7 +2369,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostResponse); i {
+			switch v := v.(*GetPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -874,7 +2381,7 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ListPostRequest); i {
+			switch v := v.(*GetPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,6 +2393,18 @@ func file_apiserver_v1_post_proto_init() {
 			}
 		}
 		file_apiserver_v1_post_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*ListPostResponse); i {
 			case 0:
 				return &v.state
@@ -897,21 +2416,204 @@ func file_apiserver_v1_post_proto_init() {
 				return nil
 			}
 		}
+		file_apiserver_v1_post_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*UnpublishPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*UnpublishPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ArchivePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ArchivePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*SchedulePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SchedulePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTrashedPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTrashedPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*RestorePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apiserver_v1_post_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*RestorePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_apiserver_v1_post_proto_msgTypes[3].OneofWrappers = []any{}
-	file_apiserver_v1_post_proto_msgTypes[9].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[1].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[2].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[4].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apiserver_v1_post_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_apiserver_v1_post_proto_goTypes,
 		DependencyIndexes: file_apiserver_v1_post_proto_depIdxs,
+		EnumInfos:         file_apiserver_v1_post_proto_enumTypes,
 		MessageInfos:      file_apiserver_v1_post_proto_msgTypes,
 	}.Build()
 	File_apiserver_v1_post_proto = out.File